@@ -15,13 +15,173 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 )
 
+var _ protoreflect.List = (*_Params_9_list)(nil)
+
+type _Params_9_list struct {
+	list *[]string
+}
+
+func (x *_Params_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_9_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field AllowedCodeHashes as it is not of Message kind"))
+}
+
+func (x *_Params_9_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_9_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_10_list)(nil)
+
+type _Params_10_list struct {
+	list *[]string
+}
+
+func (x *_Params_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_10_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field DeniedCodeHashes as it is not of Message kind"))
+}
+
+func (x *_Params_10_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_10_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_11_list)(nil)
+
+type _Params_11_list struct {
+	list *[]string
+}
+
+func (x *_Params_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_11_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field AllowedDeployers as it is not of Message kind"))
+}
+
+func (x *_Params_11_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_11_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_11_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params protoreflect.MessageDescriptor
+	md_Params                        protoreflect.MessageDescriptor
+	fd_Params_max_account_bindings   protoreflect.FieldDescriptor
+	fd_Params_max_aspect_bindings    protoreflect.FieldDescriptor
+	fd_Params_max_properties         protoreflect.FieldDescriptor
+	fd_Params_max_code_size          protoreflect.FieldDescriptor
+	fd_Params_storage_load_cost      protoreflect.FieldDescriptor
+	fd_Params_storage_store_cost     protoreflect.FieldDescriptor
+	fd_Params_storage_save_code_cost protoreflect.FieldDescriptor
+	fd_Params_storage_update_cost    protoreflect.FieldDescriptor
+	fd_Params_allowed_code_hashes    protoreflect.FieldDescriptor
+	fd_Params_denied_code_hashes     protoreflect.FieldDescriptor
+	fd_Params_allowed_deployers      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_artela_aspect_params_proto_init()
 	md_Params = File_artela_aspect_params_proto.Messages().ByName("Params")
+	fd_Params_max_account_bindings = md_Params.Fields().ByName("max_account_bindings")
+	fd_Params_max_aspect_bindings = md_Params.Fields().ByName("max_aspect_bindings")
+	fd_Params_max_properties = md_Params.Fields().ByName("max_properties")
+	fd_Params_max_code_size = md_Params.Fields().ByName("max_code_size")
+	fd_Params_storage_load_cost = md_Params.Fields().ByName("storage_load_cost")
+	fd_Params_storage_store_cost = md_Params.Fields().ByName("storage_store_cost")
+	fd_Params_storage_save_code_cost = md_Params.Fields().ByName("storage_save_code_cost")
+	fd_Params_storage_update_cost = md_Params.Fields().ByName("storage_update_cost")
+	fd_Params_allowed_code_hashes = md_Params.Fields().ByName("allowed_code_hashes")
+	fd_Params_denied_code_hashes = md_Params.Fields().ByName("denied_code_hashes")
+	fd_Params_allowed_deployers = md_Params.Fields().ByName("allowed_deployers")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -89,6 +249,72 @@ func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
 func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MaxAccountBindings != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxAccountBindings)
+		if !f(fd_Params_max_account_bindings, value) {
+			return
+		}
+	}
+	if x.MaxAspectBindings != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxAspectBindings)
+		if !f(fd_Params_max_aspect_bindings, value) {
+			return
+		}
+	}
+	if x.MaxProperties != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxProperties)
+		if !f(fd_Params_max_properties, value) {
+			return
+		}
+	}
+	if x.MaxCodeSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxCodeSize)
+		if !f(fd_Params_max_code_size, value) {
+			return
+		}
+	}
+	if x.StorageLoadCost != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StorageLoadCost)
+		if !f(fd_Params_storage_load_cost, value) {
+			return
+		}
+	}
+	if x.StorageStoreCost != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StorageStoreCost)
+		if !f(fd_Params_storage_store_cost, value) {
+			return
+		}
+	}
+	if x.StorageSaveCodeCost != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StorageSaveCodeCost)
+		if !f(fd_Params_storage_save_code_cost, value) {
+			return
+		}
+	}
+	if x.StorageUpdateCost != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StorageUpdateCost)
+		if !f(fd_Params_storage_update_cost, value) {
+			return
+		}
+	}
+	if len(x.AllowedCodeHashes) != 0 {
+		value := protoreflect.ValueOfList(&_Params_9_list{list: &x.AllowedCodeHashes})
+		if !f(fd_Params_allowed_code_hashes, value) {
+			return
+		}
+	}
+	if len(x.DeniedCodeHashes) != 0 {
+		value := protoreflect.ValueOfList(&_Params_10_list{list: &x.DeniedCodeHashes})
+		if !f(fd_Params_denied_code_hashes, value) {
+			return
+		}
+	}
+	if len(x.AllowedDeployers) != 0 {
+		value := protoreflect.ValueOfList(&_Params_11_list{list: &x.AllowedDeployers})
+		if !f(fd_Params_allowed_deployers, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -104,6 +330,28 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 // a repeated field is populated if it is non-empty.
 func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "artela.aspect.Params.max_account_bindings":
+		return x.MaxAccountBindings != uint32(0)
+	case "artela.aspect.Params.max_aspect_bindings":
+		return x.MaxAspectBindings != uint32(0)
+	case "artela.aspect.Params.max_properties":
+		return x.MaxProperties != uint32(0)
+	case "artela.aspect.Params.max_code_size":
+		return x.MaxCodeSize != uint64(0)
+	case "artela.aspect.Params.storage_load_cost":
+		return x.StorageLoadCost != uint64(0)
+	case "artela.aspect.Params.storage_store_cost":
+		return x.StorageStoreCost != uint64(0)
+	case "artela.aspect.Params.storage_save_code_cost":
+		return x.StorageSaveCodeCost != uint64(0)
+	case "artela.aspect.Params.storage_update_cost":
+		return x.StorageUpdateCost != uint64(0)
+	case "artela.aspect.Params.allowed_code_hashes":
+		return len(x.AllowedCodeHashes) != 0
+	case "artela.aspect.Params.denied_code_hashes":
+		return len(x.DeniedCodeHashes) != 0
+	case "artela.aspect.Params.allowed_deployers":
+		return len(x.AllowedDeployers) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: artela.aspect.Params"))
@@ -120,6 +368,28 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 // Clear is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "artela.aspect.Params.max_account_bindings":
+		x.MaxAccountBindings = uint32(0)
+	case "artela.aspect.Params.max_aspect_bindings":
+		x.MaxAspectBindings = uint32(0)
+	case "artela.aspect.Params.max_properties":
+		x.MaxProperties = uint32(0)
+	case "artela.aspect.Params.max_code_size":
+		x.MaxCodeSize = uint64(0)
+	case "artela.aspect.Params.storage_load_cost":
+		x.StorageLoadCost = uint64(0)
+	case "artela.aspect.Params.storage_store_cost":
+		x.StorageStoreCost = uint64(0)
+	case "artela.aspect.Params.storage_save_code_cost":
+		x.StorageSaveCodeCost = uint64(0)
+	case "artela.aspect.Params.storage_update_cost":
+		x.StorageUpdateCost = uint64(0)
+	case "artela.aspect.Params.allowed_code_hashes":
+		x.AllowedCodeHashes = nil
+	case "artela.aspect.Params.denied_code_hashes":
+		x.DeniedCodeHashes = nil
+	case "artela.aspect.Params.allowed_deployers":
+		x.AllowedDeployers = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: artela.aspect.Params"))
@@ -136,6 +406,48 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // of the value; to obtain a mutable reference, use Mutable.
 func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "artela.aspect.Params.max_account_bindings":
+		value := x.MaxAccountBindings
+		return protoreflect.ValueOfUint32(value)
+	case "artela.aspect.Params.max_aspect_bindings":
+		value := x.MaxAspectBindings
+		return protoreflect.ValueOfUint32(value)
+	case "artela.aspect.Params.max_properties":
+		value := x.MaxProperties
+		return protoreflect.ValueOfUint32(value)
+	case "artela.aspect.Params.max_code_size":
+		value := x.MaxCodeSize
+		return protoreflect.ValueOfUint64(value)
+	case "artela.aspect.Params.storage_load_cost":
+		value := x.StorageLoadCost
+		return protoreflect.ValueOfUint64(value)
+	case "artela.aspect.Params.storage_store_cost":
+		value := x.StorageStoreCost
+		return protoreflect.ValueOfUint64(value)
+	case "artela.aspect.Params.storage_save_code_cost":
+		value := x.StorageSaveCodeCost
+		return protoreflect.ValueOfUint64(value)
+	case "artela.aspect.Params.storage_update_cost":
+		value := x.StorageUpdateCost
+		return protoreflect.ValueOfUint64(value)
+	case "artela.aspect.Params.allowed_code_hashes":
+		if len(x.AllowedCodeHashes) == 0 {
+			return protoreflect.ValueOfList(&_Params_9_list{})
+		}
+		listValue := &_Params_9_list{list: &x.AllowedCodeHashes}
+		return protoreflect.ValueOfList(listValue)
+	case "artela.aspect.Params.denied_code_hashes":
+		if len(x.DeniedCodeHashes) == 0 {
+			return protoreflect.ValueOfList(&_Params_10_list{})
+		}
+		listValue := &_Params_10_list{list: &x.DeniedCodeHashes}
+		return protoreflect.ValueOfList(listValue)
+	case "artela.aspect.Params.allowed_deployers":
+		if len(x.AllowedDeployers) == 0 {
+			return protoreflect.ValueOfList(&_Params_11_list{})
+		}
+		listValue := &_Params_11_list{list: &x.AllowedDeployers}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: artela.aspect.Params"))
@@ -156,6 +468,34 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // Set is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "artela.aspect.Params.max_account_bindings":
+		x.MaxAccountBindings = uint32(value.Uint())
+	case "artela.aspect.Params.max_aspect_bindings":
+		x.MaxAspectBindings = uint32(value.Uint())
+	case "artela.aspect.Params.max_properties":
+		x.MaxProperties = uint32(value.Uint())
+	case "artela.aspect.Params.max_code_size":
+		x.MaxCodeSize = value.Uint()
+	case "artela.aspect.Params.storage_load_cost":
+		x.StorageLoadCost = value.Uint()
+	case "artela.aspect.Params.storage_store_cost":
+		x.StorageStoreCost = value.Uint()
+	case "artela.aspect.Params.storage_save_code_cost":
+		x.StorageSaveCodeCost = value.Uint()
+	case "artela.aspect.Params.storage_update_cost":
+		x.StorageUpdateCost = value.Uint()
+	case "artela.aspect.Params.allowed_code_hashes":
+		lv := value.List()
+		clv := lv.(*_Params_9_list)
+		x.AllowedCodeHashes = *clv.list
+	case "artela.aspect.Params.denied_code_hashes":
+		lv := value.List()
+		clv := lv.(*_Params_10_list)
+		x.DeniedCodeHashes = *clv.list
+	case "artela.aspect.Params.allowed_deployers":
+		lv := value.List()
+		clv := lv.(*_Params_11_list)
+		x.AllowedDeployers = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: artela.aspect.Params"))
@@ -176,6 +516,40 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "artela.aspect.Params.allowed_code_hashes":
+		if x.AllowedCodeHashes == nil {
+			x.AllowedCodeHashes = []string{}
+		}
+		value := &_Params_9_list{list: &x.AllowedCodeHashes}
+		return protoreflect.ValueOfList(value)
+	case "artela.aspect.Params.denied_code_hashes":
+		if x.DeniedCodeHashes == nil {
+			x.DeniedCodeHashes = []string{}
+		}
+		value := &_Params_10_list{list: &x.DeniedCodeHashes}
+		return protoreflect.ValueOfList(value)
+	case "artela.aspect.Params.allowed_deployers":
+		if x.AllowedDeployers == nil {
+			x.AllowedDeployers = []string{}
+		}
+		value := &_Params_11_list{list: &x.AllowedDeployers}
+		return protoreflect.ValueOfList(value)
+	case "artela.aspect.Params.max_account_bindings":
+		panic(fmt.Errorf("field max_account_bindings of message artela.aspect.Params is not mutable"))
+	case "artela.aspect.Params.max_aspect_bindings":
+		panic(fmt.Errorf("field max_aspect_bindings of message artela.aspect.Params is not mutable"))
+	case "artela.aspect.Params.max_properties":
+		panic(fmt.Errorf("field max_properties of message artela.aspect.Params is not mutable"))
+	case "artela.aspect.Params.max_code_size":
+		panic(fmt.Errorf("field max_code_size of message artela.aspect.Params is not mutable"))
+	case "artela.aspect.Params.storage_load_cost":
+		panic(fmt.Errorf("field storage_load_cost of message artela.aspect.Params is not mutable"))
+	case "artela.aspect.Params.storage_store_cost":
+		panic(fmt.Errorf("field storage_store_cost of message artela.aspect.Params is not mutable"))
+	case "artela.aspect.Params.storage_save_code_cost":
+		panic(fmt.Errorf("field storage_save_code_cost of message artela.aspect.Params is not mutable"))
+	case "artela.aspect.Params.storage_update_cost":
+		panic(fmt.Errorf("field storage_update_cost of message artela.aspect.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: artela.aspect.Params"))
@@ -189,6 +563,31 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 // For lists, maps, and messages, this returns a new, empty, mutable value.
 func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "artela.aspect.Params.max_account_bindings":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "artela.aspect.Params.max_aspect_bindings":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "artela.aspect.Params.max_properties":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "artela.aspect.Params.max_code_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "artela.aspect.Params.storage_load_cost":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "artela.aspect.Params.storage_store_cost":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "artela.aspect.Params.storage_save_code_cost":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "artela.aspect.Params.storage_update_cost":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "artela.aspect.Params.allowed_code_hashes":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_9_list{list: &list})
+	case "artela.aspect.Params.denied_code_hashes":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_10_list{list: &list})
+	case "artela.aspect.Params.allowed_deployers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_11_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: artela.aspect.Params"))
@@ -258,6 +657,48 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		if x.MaxAccountBindings != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxAccountBindings))
+		}
+		if x.MaxAspectBindings != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxAspectBindings))
+		}
+		if x.MaxProperties != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxProperties))
+		}
+		if x.MaxCodeSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxCodeSize))
+		}
+		if x.StorageLoadCost != 0 {
+			n += 1 + runtime.Sov(uint64(x.StorageLoadCost))
+		}
+		if x.StorageStoreCost != 0 {
+			n += 1 + runtime.Sov(uint64(x.StorageStoreCost))
+		}
+		if x.StorageSaveCodeCost != 0 {
+			n += 1 + runtime.Sov(uint64(x.StorageSaveCodeCost))
+		}
+		if x.StorageUpdateCost != 0 {
+			n += 1 + runtime.Sov(uint64(x.StorageUpdateCost))
+		}
+		if len(x.AllowedCodeHashes) > 0 {
+			for _, s := range x.AllowedCodeHashes {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DeniedCodeHashes) > 0 {
+			for _, s := range x.DeniedCodeHashes {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AllowedDeployers) > 0 {
+			for _, s := range x.AllowedDeployers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -287,6 +728,73 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.AllowedDeployers) > 0 {
+			for iNdEx := len(x.AllowedDeployers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedDeployers[iNdEx])
+				copy(dAtA[i:], x.AllowedDeployers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedDeployers[iNdEx])))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if len(x.DeniedCodeHashes) > 0 {
+			for iNdEx := len(x.DeniedCodeHashes) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.DeniedCodeHashes[iNdEx])
+				copy(dAtA[i:], x.DeniedCodeHashes[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DeniedCodeHashes[iNdEx])))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.AllowedCodeHashes) > 0 {
+			for iNdEx := len(x.AllowedCodeHashes) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedCodeHashes[iNdEx])
+				copy(dAtA[i:], x.AllowedCodeHashes[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedCodeHashes[iNdEx])))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if x.StorageUpdateCost != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StorageUpdateCost))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.StorageSaveCodeCost != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StorageSaveCodeCost))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.StorageStoreCost != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StorageStoreCost))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.StorageLoadCost != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StorageLoadCost))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.MaxCodeSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxCodeSize))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.MaxProperties != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxProperties))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.MaxAspectBindings != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxAspectBindings))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.MaxAccountBindings != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxAccountBindings))
+			i--
+			dAtA[i] = 0x8
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -336,6 +844,254 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxAccountBindings", wireType)
+				}
+				x.MaxAccountBindings = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxAccountBindings |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxAspectBindings", wireType)
+				}
+				x.MaxAspectBindings = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxAspectBindings |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxProperties", wireType)
+				}
+				x.MaxProperties = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxProperties |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxCodeSize", wireType)
+				}
+				x.MaxCodeSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxCodeSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StorageLoadCost", wireType)
+				}
+				x.StorageLoadCost = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StorageLoadCost |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StorageStoreCost", wireType)
+				}
+				x.StorageStoreCost = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StorageStoreCost |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StorageSaveCodeCost", wireType)
+				}
+				x.StorageSaveCodeCost = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StorageSaveCodeCost |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StorageUpdateCost", wireType)
+				}
+				x.StorageUpdateCost = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StorageUpdateCost |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedCodeHashes", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedCodeHashes = append(x.AllowedCodeHashes, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeniedCodeHashes", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DeniedCodeHashes = append(x.DeniedCodeHashes, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedDeployers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedDeployers = append(x.AllowedDeployers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -389,6 +1145,33 @@ type Params struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// max_account_bindings is the maximum number of aspects an account can be bound with.
+	MaxAccountBindings uint32 `protobuf:"varint,1,opt,name=max_account_bindings,json=maxAccountBindings,proto3" json:"max_account_bindings,omitempty"`
+	// max_aspect_bindings is the maximum number of accounts an aspect can be bound with.
+	MaxAspectBindings uint32 `protobuf:"varint,2,opt,name=max_aspect_bindings,json=maxAspectBindings,proto3" json:"max_aspect_bindings,omitempty"`
+	// max_properties is the maximum number of properties an aspect version can have.
+	MaxProperties uint32 `protobuf:"varint,3,opt,name=max_properties,json=maxProperties,proto3" json:"max_properties,omitempty"`
+	// max_code_size is the maximum size of aspect code in bytes, checked on deployment and upgrade.
+	MaxCodeSize uint64 `protobuf:"varint,4,opt,name=max_code_size,json=maxCodeSize,proto3" json:"max_code_size,omitempty"`
+	// storage_load_cost is the gas charged per 32 bytes loaded from aspect store.
+	StorageLoadCost uint64 `protobuf:"varint,5,opt,name=storage_load_cost,json=storageLoadCost,proto3" json:"storage_load_cost,omitempty"`
+	// storage_store_cost is the gas charged per 32 bytes stored to aspect store.
+	StorageStoreCost uint64 `protobuf:"varint,6,opt,name=storage_store_cost,json=storageStoreCost,proto3" json:"storage_store_cost,omitempty"`
+	// storage_save_code_cost is the gas charged per 32 bytes of aspect code saved.
+	StorageSaveCodeCost uint64 `protobuf:"varint,7,opt,name=storage_save_code_cost,json=storageSaveCodeCost,proto3" json:"storage_save_code_cost,omitempty"`
+	// storage_update_cost is the gas charged per 32 bytes updated in aspect store.
+	StorageUpdateCost uint64 `protobuf:"varint,8,opt,name=storage_update_cost,json=storageUpdateCost,proto3" json:"storage_update_cost,omitempty"`
+	// allowed_code_hashes is the list of hex-formatted aspect code hashes allowed to be deployed, upgraded to and bound.
+	// Any code hash is allowed if empty. A code hash is the keccak256 of the decompressed wasm bytecode, not of
+	// the raw deploy input, the same as the code_hash returned by the AspectCode query.
+	AllowedCodeHashes []string `protobuf:"bytes,9,rep,name=allowed_code_hashes,json=allowedCodeHashes,proto3" json:"allowed_code_hashes,omitempty"`
+	// denied_code_hashes is the list of hex-formatted aspect code hashes rejected on deployment, upgrade and binding,
+	// hashed the same way as allowed_code_hashes.
+	DeniedCodeHashes []string `protobuf:"bytes,10,rep,name=denied_code_hashes,json=deniedCodeHashes,proto3" json:"denied_code_hashes,omitempty"`
+	// allowed_deployers is the list of hex addresses allowed to deploy and upgrade aspects.
+	// Any address is allowed if empty.
+	AllowedDeployers []string `protobuf:"bytes,11,rep,name=allowed_deployers,json=allowedDeployers,proto3" json:"allowed_deployers,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -411,6 +1194,83 @@ func (*Params) Descriptor() ([]byte, []int) {
 	return file_artela_aspect_params_proto_rawDescGZIP(), []int{0}
 }
 
+func (x *Params) GetMaxAccountBindings() uint32 {
+	if x != nil {
+		return x.MaxAccountBindings
+	}
+	return 0
+}
+
+func (x *Params) GetMaxAspectBindings() uint32 {
+	if x != nil {
+		return x.MaxAspectBindings
+	}
+	return 0
+}
+
+func (x *Params) GetMaxProperties() uint32 {
+	if x != nil {
+		return x.MaxProperties
+	}
+	return 0
+}
+
+func (x *Params) GetMaxCodeSize() uint64 {
+	if x != nil {
+		return x.MaxCodeSize
+	}
+	return 0
+}
+
+func (x *Params) GetStorageLoadCost() uint64 {
+	if x != nil {
+		return x.StorageLoadCost
+	}
+	return 0
+}
+
+func (x *Params) GetStorageStoreCost() uint64 {
+	if x != nil {
+		return x.StorageStoreCost
+	}
+	return 0
+}
+
+func (x *Params) GetStorageSaveCodeCost() uint64 {
+	if x != nil {
+		return x.StorageSaveCodeCost
+	}
+	return 0
+}
+
+func (x *Params) GetStorageUpdateCost() uint64 {
+	if x != nil {
+		return x.StorageUpdateCost
+	}
+	return 0
+}
+
+func (x *Params) GetAllowedCodeHashes() []string {
+	if x != nil {
+		return x.AllowedCodeHashes
+	}
+	return nil
+}
+
+func (x *Params) GetDeniedCodeHashes() []string {
+	if x != nil {
+		return x.DeniedCodeHashes
+	}
+	return nil
+}
+
+func (x *Params) GetAllowedDeployers() []string {
+	if x != nil {
+		return x.AllowedDeployers
+	}
+	return nil
+}
+
 var File_artela_aspect_params_proto protoreflect.FileDescriptor
 
 var file_artela_aspect_params_proto_rawDesc = []byte{
@@ -419,21 +1279,52 @@ var file_artela_aspect_params_proto_rawDesc = []byte{
 	0x74, 0x65, 0x6c, 0x61, 0x2e, 0x61, 0x73, 0x70, 0x65, 0x63, 0x74, 0x1a, 0x11, 0x61, 0x6d, 0x69,
 	0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14,
 	0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x29, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x3a, 0x1f,
-	0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x16, 0x61, 0x72, 0x74, 0x65, 0x6c, 0x61, 0x2f,
-	0x78, 0x2f, 0x61, 0x73, 0x70, 0x65, 0x63, 0x74, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42,
-	0xa9, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x72, 0x74, 0x65, 0x6c, 0x61, 0x2e, 0x61,
-	0x73, 0x70, 0x65, 0x63, 0x74, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x61, 0x72, 0x74, 0x65, 0x6c, 0x61, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f,
-	0x61, 0x72, 0x74, 0x65, 0x6c, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x72, 0x74, 0x65, 0x6c,
-	0x61, 0x2f, 0x61, 0x73, 0x70, 0x65, 0x63, 0x74, 0xa2, 0x02, 0x03, 0x41, 0x41, 0x58, 0xaa, 0x02,
-	0x0d, 0x41, 0x72, 0x74, 0x65, 0x6c, 0x61, 0x2e, 0x41, 0x73, 0x70, 0x65, 0x63, 0x74, 0xca, 0x02,
-	0x0d, 0x41, 0x72, 0x74, 0x65, 0x6c, 0x61, 0x5c, 0x41, 0x73, 0x70, 0x65, 0x63, 0x74, 0xe2, 0x02,
-	0x19, 0x41, 0x72, 0x74, 0x65, 0x6c, 0x61, 0x5c, 0x41, 0x73, 0x70, 0x65, 0x63, 0x74, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x41, 0x72, 0x74,
-	0x65, 0x6c, 0x61, 0x3a, 0x3a, 0x41, 0x73, 0x70, 0x65, 0x63, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa0, 0x04, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x30, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x62,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x6d,
+	0x61, 0x78, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x73, 0x70, 0x65, 0x63, 0x74, 0x5f,
+	0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11,
+	0x6d, 0x61, 0x78, 0x41, 0x73, 0x70, 0x65, 0x63, 0x74, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
+	0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x50, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x64, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2a, 0x0a, 0x11,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x63, 0x6f, 0x73,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x16, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x61, 0x76, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x73, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53,
+	0x61, 0x76, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6f,
+	0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x64,
+	0x65, 0x6e, 0x69, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65,
+	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x64, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x43,
+	0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x72, 0x73, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x44, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x65, 0x72, 0x73, 0x3a, 0x1f, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a,
+	0x16, 0x61, 0x72, 0x74, 0x65, 0x6c, 0x61, 0x2f, 0x78, 0x2f, 0x61, 0x73, 0x70, 0x65, 0x63, 0x74,
+	0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xa9, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e,
+	0x61, 0x72, 0x74, 0x65, 0x6c, 0x61, 0x2e, 0x61, 0x73, 0x70, 0x65, 0x63, 0x74, 0x42, 0x0b, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x32, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x74, 0x65, 0x6c, 0x61, 0x2d,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x61, 0x72, 0x74, 0x65, 0x6c, 0x61, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x61, 0x72, 0x74, 0x65, 0x6c, 0x61, 0x2f, 0x61, 0x73, 0x70, 0x65, 0x63, 0x74,
+	0xa2, 0x02, 0x03, 0x41, 0x41, 0x58, 0xaa, 0x02, 0x0d, 0x41, 0x72, 0x74, 0x65, 0x6c, 0x61, 0x2e,
+	0x41, 0x73, 0x70, 0x65, 0x63, 0x74, 0xca, 0x02, 0x0d, 0x41, 0x72, 0x74, 0x65, 0x6c, 0x61, 0x5c,
+	0x41, 0x73, 0x70, 0x65, 0x63, 0x74, 0xe2, 0x02, 0x19, 0x41, 0x72, 0x74, 0x65, 0x6c, 0x61, 0x5c,
+	0x41, 0x73, 0x70, 0x65, 0x63, 0x74, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x0e, 0x41, 0x72, 0x74, 0x65, 0x6c, 0x61, 0x3a, 0x3a, 0x41, 0x73, 0x70,
+	0x65, 0x63, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -20,7 +20,14 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName = "/artela.aspect.Query/Params"
+	Query_Params_FullMethodName           = "/artela.aspect.Query/Params"
+	Query_Aspect_FullMethodName           = "/artela.aspect.Query/Aspect"
+	Query_AspectCode_FullMethodName       = "/artela.aspect.Query/AspectCode"
+	Query_AspectProperties_FullMethodName = "/artela.aspect.Query/AspectProperties"
+	Query_AspectBindings_FullMethodName   = "/artela.aspect.Query/AspectBindings"
+	Query_AccountBindings_FullMethodName  = "/artela.aspect.Query/AccountBindings"
+	Query_Aspects_FullMethodName          = "/artela.aspect.Query/Aspects"
+	Query_AspectState_FullMethodName      = "/artela.aspect.Query/AspectState"
 )
 
 // QueryClient is the client API for Query service.
@@ -29,6 +36,20 @@ const (
 type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Aspect queries the metadata and all versions of an aspect.
+	Aspect(ctx context.Context, in *QueryAspectRequest, opts ...grpc.CallOption) (*QueryAspectResponse, error)
+	// AspectCode queries the code of the given version of an aspect.
+	AspectCode(ctx context.Context, in *QueryAspectCodeRequest, opts ...grpc.CallOption) (*QueryAspectCodeResponse, error)
+	// AspectProperties queries the properties of the given version of an aspect.
+	AspectProperties(ctx context.Context, in *QueryAspectPropertiesRequest, opts ...grpc.CallOption) (*QueryAspectPropertiesResponse, error)
+	// AspectBindings queries the accounts bound to an aspect.
+	AspectBindings(ctx context.Context, in *QueryAspectBindingsRequest, opts ...grpc.CallOption) (*QueryAspectBindingsResponse, error)
+	// AccountBindings queries the aspects bound to an account.
+	AccountBindings(ctx context.Context, in *QueryAccountBindingsRequest, opts ...grpc.CallOption) (*QueryAccountBindingsResponse, error)
+	// Aspects queries all deployed aspects.
+	Aspects(ctx context.Context, in *QueryAspectsRequest, opts ...grpc.CallOption) (*QueryAspectsResponse, error)
+	// AspectState queries a value in the state of an aspect.
+	AspectState(ctx context.Context, in *QueryAspectStateRequest, opts ...grpc.CallOption) (*QueryAspectStateResponse, error)
 }
 
 type queryClient struct {
@@ -48,12 +69,89 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) Aspect(ctx context.Context, in *QueryAspectRequest, opts ...grpc.CallOption) (*QueryAspectResponse, error) {
+	out := new(QueryAspectResponse)
+	err := c.cc.Invoke(ctx, Query_Aspect_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AspectCode(ctx context.Context, in *QueryAspectCodeRequest, opts ...grpc.CallOption) (*QueryAspectCodeResponse, error) {
+	out := new(QueryAspectCodeResponse)
+	err := c.cc.Invoke(ctx, Query_AspectCode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AspectProperties(ctx context.Context, in *QueryAspectPropertiesRequest, opts ...grpc.CallOption) (*QueryAspectPropertiesResponse, error) {
+	out := new(QueryAspectPropertiesResponse)
+	err := c.cc.Invoke(ctx, Query_AspectProperties_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AspectBindings(ctx context.Context, in *QueryAspectBindingsRequest, opts ...grpc.CallOption) (*QueryAspectBindingsResponse, error) {
+	out := new(QueryAspectBindingsResponse)
+	err := c.cc.Invoke(ctx, Query_AspectBindings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountBindings(ctx context.Context, in *QueryAccountBindingsRequest, opts ...grpc.CallOption) (*QueryAccountBindingsResponse, error) {
+	out := new(QueryAccountBindingsResponse)
+	err := c.cc.Invoke(ctx, Query_AccountBindings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Aspects(ctx context.Context, in *QueryAspectsRequest, opts ...grpc.CallOption) (*QueryAspectsResponse, error) {
+	out := new(QueryAspectsResponse)
+	err := c.cc.Invoke(ctx, Query_Aspects_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AspectState(ctx context.Context, in *QueryAspectStateRequest, opts ...grpc.CallOption) (*QueryAspectStateResponse, error) {
+	out := new(QueryAspectStateResponse)
+	err := c.cc.Invoke(ctx, Query_AspectState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Aspect queries the metadata and all versions of an aspect.
+	Aspect(context.Context, *QueryAspectRequest) (*QueryAspectResponse, error)
+	// AspectCode queries the code of the given version of an aspect.
+	AspectCode(context.Context, *QueryAspectCodeRequest) (*QueryAspectCodeResponse, error)
+	// AspectProperties queries the properties of the given version of an aspect.
+	AspectProperties(context.Context, *QueryAspectPropertiesRequest) (*QueryAspectPropertiesResponse, error)
+	// AspectBindings queries the accounts bound to an aspect.
+	AspectBindings(context.Context, *QueryAspectBindingsRequest) (*QueryAspectBindingsResponse, error)
+	// AccountBindings queries the aspects bound to an account.
+	AccountBindings(context.Context, *QueryAccountBindingsRequest) (*QueryAccountBindingsResponse, error)
+	// Aspects queries all deployed aspects.
+	Aspects(context.Context, *QueryAspectsRequest) (*QueryAspectsResponse, error)
+	// AspectState queries a value in the state of an aspect.
+	AspectState(context.Context, *QueryAspectStateRequest) (*QueryAspectStateResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -64,6 +162,27 @@ type UnimplementedQueryServer struct {
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) Aspect(context.Context, *QueryAspectRequest) (*QueryAspectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Aspect not implemented")
+}
+func (UnimplementedQueryServer) AspectCode(context.Context, *QueryAspectCodeRequest) (*QueryAspectCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AspectCode not implemented")
+}
+func (UnimplementedQueryServer) AspectProperties(context.Context, *QueryAspectPropertiesRequest) (*QueryAspectPropertiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AspectProperties not implemented")
+}
+func (UnimplementedQueryServer) AspectBindings(context.Context, *QueryAspectBindingsRequest) (*QueryAspectBindingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AspectBindings not implemented")
+}
+func (UnimplementedQueryServer) AccountBindings(context.Context, *QueryAccountBindingsRequest) (*QueryAccountBindingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountBindings not implemented")
+}
+func (UnimplementedQueryServer) Aspects(context.Context, *QueryAspectsRequest) (*QueryAspectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Aspects not implemented")
+}
+func (UnimplementedQueryServer) AspectState(context.Context, *QueryAspectStateRequest) (*QueryAspectStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AspectState not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -95,6 +214,132 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Aspect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAspectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Aspect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Aspect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Aspect(ctx, req.(*QueryAspectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AspectCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAspectCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AspectCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AspectCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AspectCode(ctx, req.(*QueryAspectCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AspectProperties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAspectPropertiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AspectProperties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AspectProperties_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AspectProperties(ctx, req.(*QueryAspectPropertiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AspectBindings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAspectBindingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AspectBindings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AspectBindings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AspectBindings(ctx, req.(*QueryAspectBindingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountBindings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountBindingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountBindings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountBindings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountBindings(ctx, req.(*QueryAccountBindingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Aspects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAspectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Aspects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Aspects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Aspects(ctx, req.(*QueryAspectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AspectState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAspectStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AspectState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AspectState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AspectState(ctx, req.(*QueryAspectStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -106,6 +351,34 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "Aspect",
+			Handler:    _Query_Aspect_Handler,
+		},
+		{
+			MethodName: "AspectCode",
+			Handler:    _Query_AspectCode_Handler,
+		},
+		{
+			MethodName: "AspectProperties",
+			Handler:    _Query_AspectProperties_Handler,
+		},
+		{
+			MethodName: "AspectBindings",
+			Handler:    _Query_AspectBindings_Handler,
+		},
+		{
+			MethodName: "AccountBindings",
+			Handler:    _Query_AccountBindings_Handler,
+		},
+		{
+			MethodName: "Aspects",
+			Handler:    _Query_Aspects_Handler,
+		},
+		{
+			MethodName: "AspectState",
+			Handler:    _Query_AspectState_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "artela/aspect/query.proto",
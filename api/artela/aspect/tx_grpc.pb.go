@@ -20,7 +20,8 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName = "/artela.aspect.Msg/UpdateParams"
+	Msg_UpdateParams_FullMethodName    = "/artela.aspect.Msg/UpdateParams"
+	Msg_SetAspectStatus_FullMethodName = "/artela.aspect.Msg/SetAspectStatus"
 )
 
 // MsgClient is the client API for Msg service.
@@ -30,6 +31,9 @@ type MsgClient interface {
 	// UpdateParams defines a (governance) operation for updating the module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetAspectStatus defines a (governance) operation for changing the execution
+	// status of an aspect, e.g. pausing a buggy aspect chain-wide.
+	SetAspectStatus(ctx context.Context, in *MsgSetAspectStatus, opts ...grpc.CallOption) (*MsgSetAspectStatusResponse, error)
 }
 
 type msgClient struct {
@@ -49,6 +53,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetAspectStatus(ctx context.Context, in *MsgSetAspectStatus, opts ...grpc.CallOption) (*MsgSetAspectStatusResponse, error) {
+	out := new(MsgSetAspectStatusResponse)
+	err := c.cc.Invoke(ctx, Msg_SetAspectStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -56,6 +69,9 @@ type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetAspectStatus defines a (governance) operation for changing the execution
+	// status of an aspect, e.g. pausing a buggy aspect chain-wide.
+	SetAspectStatus(context.Context, *MsgSetAspectStatus) (*MsgSetAspectStatusResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -66,6 +82,9 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) SetAspectStatus(context.Context, *MsgSetAspectStatus) (*MsgSetAspectStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAspectStatus not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -97,6 +116,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetAspectStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAspectStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAspectStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetAspectStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAspectStatus(ctx, req.(*MsgSetAspectStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -108,6 +145,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetAspectStatus",
+			Handler:    _Msg_SetAspectStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "artela/aspect/tx.proto",
@@ -19,9 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName   = "/artela.fee.Query/Params"
-	Query_BaseFee_FullMethodName  = "/artela.fee.Query/BaseFee"
-	Query_BlockGas_FullMethodName = "/artela.fee.Query/BlockGas"
+	Query_Params_FullMethodName          = "/artela.fee.Query/Params"
+	Query_BaseFee_FullMethodName         = "/artela.fee.Query/BaseFee"
+	Query_BlockGas_FullMethodName        = "/artela.fee.Query/BlockGas"
+	Query_BlockFees_FullMethodName       = "/artela.fee.Query/BlockFees"
+	Query_BaseFeeAtHeight_FullMethodName = "/artela.fee.Query/BaseFeeAtHeight"
+	Query_FeeHistory_FullMethodName      = "/artela.fee.Query/FeeHistory"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +37,13 @@ type QueryClient interface {
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error)
+	// BlockFees queries the fees collected in the last block and how they are distributed,
+	// the fees of a historical block can be queried at its height.
+	BlockFees(ctx context.Context, in *QueryBlockFeesRequest, opts ...grpc.CallOption) (*QueryBlockFeesResponse, error)
+	// BaseFeeAtHeight queries the base fee of a block kept in the fee history.
+	BaseFeeAtHeight(ctx context.Context, in *QueryBaseFeeAtHeightRequest, opts ...grpc.CallOption) (*QueryBaseFeeAtHeightResponse, error)
+	// FeeHistory queries the base fee and the gas of the consecutive blocks kept in the fee history.
+	FeeHistory(ctx context.Context, in *QueryFeeHistoryRequest, opts ...grpc.CallOption) (*QueryFeeHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +81,33 @@ func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, op
 	return out, nil
 }
 
+func (c *queryClient) BlockFees(ctx context.Context, in *QueryBlockFeesRequest, opts ...grpc.CallOption) (*QueryBlockFeesResponse, error) {
+	out := new(QueryBlockFeesResponse)
+	err := c.cc.Invoke(ctx, Query_BlockFees_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BaseFeeAtHeight(ctx context.Context, in *QueryBaseFeeAtHeightRequest, opts ...grpc.CallOption) (*QueryBaseFeeAtHeightResponse, error) {
+	out := new(QueryBaseFeeAtHeightResponse)
+	err := c.cc.Invoke(ctx, Query_BaseFeeAtHeight_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeHistory(ctx context.Context, in *QueryFeeHistoryRequest, opts ...grpc.CallOption) (*QueryFeeHistoryResponse, error) {
+	out := new(QueryFeeHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_FeeHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +118,13 @@ type QueryServer interface {
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error)
+	// BlockFees queries the fees collected in the last block and how they are distributed,
+	// the fees of a historical block can be queried at its height.
+	BlockFees(context.Context, *QueryBlockFeesRequest) (*QueryBlockFeesResponse, error)
+	// BaseFeeAtHeight queries the base fee of a block kept in the fee history.
+	BaseFeeAtHeight(context.Context, *QueryBaseFeeAtHeightRequest) (*QueryBaseFeeAtHeightResponse, error)
+	// FeeHistory queries the base fee and the gas of the consecutive blocks kept in the fee history.
+	FeeHistory(context.Context, *QueryFeeHistoryRequest) (*QueryFeeHistoryResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +141,15 @@ func (UnimplementedQueryServer) BaseFee(context.Context, *QueryBaseFeeRequest) (
 func (UnimplementedQueryServer) BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockGas not implemented")
 }
+func (UnimplementedQueryServer) BlockFees(context.Context, *QueryBlockFeesRequest) (*QueryBlockFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockFees not implemented")
+}
+func (UnimplementedQueryServer) BaseFeeAtHeight(context.Context, *QueryBaseFeeAtHeightRequest) (*QueryBaseFeeAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseFeeAtHeight not implemented")
+}
+func (UnimplementedQueryServer) FeeHistory(context.Context, *QueryFeeHistoryRequest) (*QueryFeeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeHistory not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +217,60 @@ func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BlockFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BlockFees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockFees(ctx, req.(*QueryBlockFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BaseFeeAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseFeeAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseFeeAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BaseFeeAtHeight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseFeeAtHeight(ctx, req.(*QueryBaseFeeAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeeHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeHistory(ctx, req.(*QueryFeeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +290,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BlockGas",
 			Handler:    _Query_BlockGas_Handler,
 		},
+		{
+			MethodName: "BlockFees",
+			Handler:    _Query_BlockFees_Handler,
+		},
+		{
+			MethodName: "BaseFeeAtHeight",
+			Handler:    _Query_BaseFeeAtHeight_Handler,
+		},
+		{
+			MethodName: "FeeHistory",
+			Handler:    _Query_FeeHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "artela/fee/query.proto",
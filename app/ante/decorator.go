@@ -107,7 +107,9 @@ func newEVMAnteHandler(app *baseapp.BaseApp, options AnteDecorators) cosmos.Ante
 	)
 }
 
-// newCosmosAnteHandler creates the default ante handler for Cosmos transactions
+// newCosmosAnteHandler creates the default ante handler for Cosmos transactions.
+// The fees of Cosmos transactions are not deducted, so they are not added to the block fees
+// distributed by the fee module either, only the fees of Ethereum transactions are.
 func newCosmosAnteHandler(options AnteDecorators) cosmos.AnteHandler {
 	return cosmos.ChainAnteDecorators(
 		cosmosante.RejectMessagesDecorator{}, // reject MsgEthereumTxs
@@ -135,7 +137,8 @@ func newCosmosAnteHandler(options AnteDecorators) cosmos.AnteHandler {
 	)
 }
 
-// newCosmosAnteHandlerEip712 creates the ante handler for transactions signed with EIP712
+// newCosmosAnteHandlerEip712 creates the ante handler for transactions signed with EIP712.
+// As for the other Cosmos transactions, the fees are not deducted nor added to the block fees.
 func newLegacyCosmosAnteHandlerEip712(options AnteDecorators) cosmos.AnteHandler {
 	return cosmos.ChainAnteDecorators(
 		cosmosante.RejectMessagesDecorator{}, // reject MsgEthereumTxs
@@ -49,6 +49,11 @@ func (avd EthAccountVerificationDecorator) AnteHandle(
 		return next(ctx, tx, simulate)
 	}
 
+	_, payWithToken, err := getFeeToken(ctx, avd.evmKeeper, tx)
+	if err != nil {
+		return ctx, errorsmod.Wrap(err, "failed to resolve the fee token")
+	}
+
 	for i, msg := range tx.GetMsgs() {
 		msgEthTx, ok := msg.(*evmmodule.MsgEthereumTx)
 		if !ok {
@@ -79,7 +84,19 @@ func (avd EthAccountVerificationDecorator) AnteHandle(
 				"the sender is not EOA: address %s, codeHash <%s>", fromAddr, acct.CodeHash)
 		}
 
-		if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(acct.Balance), txData); err != nil {
+		// the fees of the sponsored txs are paid by the paymaster, and the fees paid in a fee token are
+		// deducted from the balance of the fee token, so the sender only needs to afford the value
+		payer, _, err := avd.evmKeeper.GetFeePayer(ctx, msgEthTx.AsTransaction(), fromAddr)
+		if err != nil {
+			return ctx, errorsmod.Wrap(err, "failed to resolve the fee payer")
+		}
+
+		if payer != fromAddr || payWithToken {
+			if acct.Balance.Cmp(txData.GetValue()) < 0 {
+				return ctx, errorsmod.Wrapf(errortypes.ErrInsufficientFunds,
+					"failed to check sender balance: sender balance < tx value (%s < %s)", acct.Balance, txData.GetValue())
+			}
+		} else if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(acct.Balance), txData); err != nil {
 			return ctx, errorsmod.Wrap(err, "failed to check sender balance")
 		}
 	}
@@ -127,6 +144,8 @@ func NewEthGasConsumeDecorator(
 // - sender account cannot be found
 // - transaction's gas limit is lower than the intrinsic gas
 // - user has neither enough balance nor staking rewards to deduct the transaction fees (gas_limit * gas_price)
+// - the paymaster sponsoring the fees has neither enough balance nor spending limit left
+// - the fee token is not whitelisted, or is combined with a paymaster
 // - transaction or block gas meter runs out of gas
 // - sets the gas meter limit
 // - gas limit is greater than the block gas meter limit
@@ -158,6 +177,11 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx cosmos.Context, tx cosmos.Tx,
 	minPriority := int64(math.MaxInt64)
 	baseFee := egcd.evmKeeper.GetBaseFee(ctx, ethCfg)
 
+	feeToken, payWithToken, err := getFeeToken(ctx, egcd.evmKeeper, tx)
+	if err != nil {
+		return ctx, errorsmod.Wrap(err, "failed to resolve the fee token")
+	}
+
 	for _, msg := range tx.GetMsgs() {
 		msgEthTx, ok := msg.(*evmmodule.MsgEthereumTx)
 		if !ok {
@@ -193,18 +217,42 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx cosmos.Context, tx cosmos.Tx,
 		// if err != nil {
 		// 	return ctx, err
 		// }
-		fromAddr := msgEthTx.From
-		err = egcd.evmKeeper.DeductTxCostsFromUserBalance(ctx, fees, common.HexToAddress(fromAddr))
+		fromAddr := common.HexToAddress(msgEthTx.From)
+		payer, sponsor, err := egcd.evmKeeper.GetFeePayer(ctx, msgEthTx.AsTransaction(), fromAddr)
 		if err != nil {
-			return ctx, errorsmod.Wrapf(err, "failed to deduct transaction costs from user balance")
+			return ctx, errorsmod.Wrap(err, "failed to resolve the fee payer")
 		}
 
-		events = append(events,
-			cosmos.NewEvent(
-				cosmos.EventTypeTx,
+		var feeAttrs []cosmos.Attribute
+		switch {
+		case payer != fromAddr && payWithToken:
+			return ctx, errorsmod.Wrapf(evmmodule.ErrInvalidFeeToken,
+				"fees sponsored by paymaster %s cannot be paid in fee token %s", payer, feeToken.Denom)
+		case payer != fromAddr:
+			// the fees of the aspect verified tx are sponsored by the paymaster of the verifier aspect
+			err = egcd.evmKeeper.DeductTxCostsFromPaymaster(ctx, fees, payer, sponsor)
+			if err != nil {
+				return ctx, errorsmod.Wrapf(err, "failed to deduct transaction costs from paymaster balance")
+			}
+			feeAttrs = append(feeAttrs,
 				cosmos.NewAttribute(cosmos.AttributeKeyFee, fees.String()),
-			),
-		)
+				cosmos.NewAttribute(cosmos.AttributeKeyFeePayer, payer.Hex()))
+		case payWithToken:
+			// the fees are converted to the fee token, and refunded in the same denom after the execution
+			tokenFees, err := egcd.evmKeeper.DeductTxCostsInFeeToken(ctx, fees, feeToken, fromAddr)
+			if err != nil {
+				return ctx, errorsmod.Wrapf(err, "failed to deduct transaction costs in fee token %s", feeToken.Denom)
+			}
+			feeAttrs = append(feeAttrs, cosmos.NewAttribute(cosmos.AttributeKeyFee, tokenFees.String()))
+		default:
+			err = egcd.evmKeeper.DeductTxCostsFromUserBalance(ctx, fees, fromAddr)
+			if err != nil {
+				return ctx, errorsmod.Wrapf(err, "failed to deduct transaction costs from user balance")
+			}
+			feeAttrs = append(feeAttrs, cosmos.NewAttribute(cosmos.AttributeKeyFee, fees.String()))
+		}
+
+		events = append(events, cosmos.NewEvent(cosmos.EventTypeTx, feeAttrs...))
 
 		priority := evmmodule.GetTxPriority(txData, baseFee)
 
@@ -241,6 +289,11 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx cosmos.Context, tx cosmos.Tx,
 		WithGasMeter(artela.NewInfiniteGasMeterWithLimit(gasWanted)).
 		WithPriority(minPriority)
 
+	// the fee token is kept in the context, so the leftover gas is refunded in the same denom
+	if payWithToken {
+		newCtx = newCtx.WithValue(evmmodule.FeeTokenContextKey, feeToken)
+	}
+
 	// we know that we have enough gas on the pool to cover the intrinsic gas
 	return next(newCtx, tx, simulate)
 }
@@ -0,0 +1,3 @@
+package evm
+
+var GetFeeToken = getFeeToken
@@ -0,0 +1,72 @@
+package evm_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	asptypes "github.com/artela-network/aspect-core/types"
+
+	"github.com/artela-network/artela-rollkit/app/ante/evm"
+	keepertest "github.com/artela-network/artela-rollkit/testutil/keeper"
+	aspectmoduletypes "github.com/artela-network/artela-rollkit/x/aspect/types"
+	feetypes "github.com/artela-network/artela-rollkit/x/fee/types"
+)
+
+func TestEthMinGasPriceDecoratorFeePolicy(t *testing.T) {
+	artelaApp, ctx := setupFeeToken(t)
+	decorator := evm.NewEthMinGasPriceDecorator(artelaApp.FeeKeeper, artelaApp.EvmKeeper)
+
+	noPolicy := common.HexToAddress("0x00000000000000000000000000000000000000b2")
+	pausedContract := common.HexToAddress("0x00000000000000000000000000000000000000c2")
+	pausedAspect := common.HexToAddress("0x0000000000000000000000000000000000000a52")
+	keepertest.BindAspect(t, artelaApp, ctx, testContract, testAspect,
+		asptypes.JoinPointRunType_PreContractCall, &aspectmoduletypes.AspectMeta{})
+	keepertest.BindAspect(t, artelaApp, ctx, pausedContract, pausedAspect,
+		asptypes.JoinPointRunType_PreContractCall, &aspectmoduletypes.AspectMeta{Status: aspectmoduletypes.AspectStatus_ASPECT_STATUS_PAUSED})
+
+	params := artelaApp.FeeKeeper.GetParams(ctx)
+	params.MinGasPrice = sdkmath.LegacyNewDec(5)
+	for _, contract := range []common.Address{testRecipient, testContract, pausedContract} {
+		params.FeePolicies = append(params.FeePolicies, feetypes.FeePolicy{
+			Contract:        contract.Hex(),
+			MinGasPrice:     sdkmath.LegacyNewDec(10),
+			AspectSurcharge: sdkmath.LegacyNewDec(20),
+			RebateRatio:     sdkmath.LegacyZeroDec(),
+		})
+	}
+	require.NoError(t, artelaApp.FeeKeeper.SetParams(ctx, params))
+
+	testCases := []struct {
+		name     string
+		to       common.Address
+		gasPrice int64
+		err      error
+	}{
+		{"global min gas price", noPolicy, 5, nil},
+		{"below global min gas price", noPolicy, 4, errortypes.ErrInsufficientFee},
+		{"contract floor", testRecipient, 10, nil},
+		{"below contract floor", testRecipient, 9, errortypes.ErrInsufficientFee},
+		// the floor of the aspect bound contract is raised by the surcharge
+		{"aspect surcharge", testContract, 30, nil},
+		{"below aspect surcharge", testContract, 29, errortypes.ErrInsufficientFee},
+		// the paused aspects are not executed, so the txs are not surcharged
+		{"paused aspect", pausedContract, 10, nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx := newTx(t, artelaApp, &ethtypes.LegacyTx{To: &tc.to, Gas: 100_000, GasPrice: big.NewInt(tc.gasPrice)}, "")
+			_, err := decorator.AnteHandle(ctx, tx, false, nextHandler)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package evm
+
+import (
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	"github.com/artela-network/artela-rollkit/app/interfaces"
+	evmmodule "github.com/artela-network/artela-rollkit/x/evm/types"
+	feemodule "github.com/artela-network/artela-rollkit/x/fee/types"
+)
+
+// getFeeToken returns the whitelisted fee token paying the gas of the eth tx, which is selected by the
+// fee denom of the ethereum tx extension option. Returns false if the gas is paid in the evm denom.
+func getFeeToken(ctx cosmos.Context, evmKeeper interfaces.EVMKeeper, tx cosmos.Tx) (feemodule.FeeToken, bool, error) {
+	hasExtOptsTx, ok := tx.(authante.HasExtensionOptionsTx)
+	if !ok {
+		return feemodule.FeeToken{}, false, nil
+	}
+
+	var feeDenom string
+	for _, opt := range hasExtOptsTx.GetExtensionOptions() {
+		if extOpt, ok := opt.GetCachedValue().(*evmmodule.ExtensionOptionsEthereumTx); ok {
+			feeDenom = extOpt.FeeDenom
+			break
+		}
+	}
+
+	if feeDenom == "" || feeDenom == evmKeeper.GetParams(ctx).EvmDenom {
+		return feemodule.FeeToken{}, false, nil
+	}
+
+	token, err := evmKeeper.GetFeeToken(ctx, feeDenom)
+	if err != nil {
+		return feemodule.FeeToken{}, false, err
+	}
+	return token, true, nil
+}
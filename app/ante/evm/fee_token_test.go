@@ -0,0 +1,192 @@
+package evm_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela-rollkit/app"
+	"github.com/artela-network/artela-rollkit/app/ante/evm"
+	keepertest "github.com/artela-network/artela-rollkit/testutil/keeper"
+	evmtypes "github.com/artela-network/artela-rollkit/x/evm/types"
+	feetypes "github.com/artela-network/artela-rollkit/x/fee/types"
+)
+
+const testFeeDenom = "ibc/usdc"
+
+var (
+	testSender    = common.HexToAddress("0x00000000000000000000000000000000000000a1")
+	testRecipient = common.HexToAddress("0x00000000000000000000000000000000000000b1")
+	testContract  = common.HexToAddress("0x00000000000000000000000000000000000000c1")
+	testAspect    = common.HexToAddress("0x0000000000000000000000000000000000000a51")
+	testPayMaster = common.HexToAddress("0x00000000000000000000000000000000000000f1")
+)
+
+func nextHandler(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+	return ctx, nil
+}
+
+// setupFeeToken returns an app with testFeeDenom whitelisted as fee token, and the sender funded in both
+// the evm denom and the fee token. One aart is paid with two units of the fee token, whose min gas price is 10.
+func setupFeeToken(t *testing.T) (*app.App, sdk.Context) {
+	artelaApp, ctx := keepertest.ArtelaApp(t, banktypes.Balance{
+		Address: sdk.AccAddress(testSender.Bytes()).String(),
+		Coins: sdk.NewCoins(
+			sdk.NewInt64Coin(evmtypes.DefaultEVMDenom, 1_000_000_000),
+			sdk.NewInt64Coin(testFeeDenom, 1_000_000_000),
+		),
+	})
+
+	params := artelaApp.FeeKeeper.GetParams(ctx)
+	params.NoBaseFee = true
+	params.MinGasPrice = sdkmath.LegacyZeroDec()
+	params.FeeTokens = []feetypes.FeeToken{{
+		Denom:          testFeeDenom,
+		ConversionRate: sdkmath.LegacyNewDec(2),
+		MinGasPrice:    sdkmath.LegacyNewDec(10),
+	}}
+	require.NoError(t, artelaApp.FeeKeeper.SetParams(ctx, params))
+
+	return artelaApp, ctx.WithBlockGasMeter(storetypes.NewGasMeter(10_000_000))
+}
+
+// newTx wraps an eth tx of the sender into a cosmos tx, whose gas is paid in the given fee denom.
+func newTx(t *testing.T, artelaApp *app.App, txData ethtypes.TxData, feeDenom string) sdk.Tx {
+	msg := &evmtypes.MsgEthereumTx{}
+	require.NoError(t, msg.FromEthereumTx(ethtypes.NewTx(txData)))
+	msg.From = testSender.Hex()
+
+	builder := authtx.NewTxConfig(artelaApp.AppCodec(), authtx.DefaultSignModes).NewTxBuilder()
+	_, err := msg.BuildTx(builder, evmtypes.DefaultEVMDenom)
+	require.NoError(t, err)
+
+	option, err := codectypes.NewAnyWithValue(&evmtypes.ExtensionOptionsEthereumTx{FeeDenom: feeDenom})
+	require.NoError(t, err)
+	builder.(authtx.ExtensionOptionsTxBuilder).SetExtensionOptions(option)
+	return builder.GetTx()
+}
+
+// transferTx returns a transfer of the sender with the given gas price, whose gas is paid in the given fee denom.
+func transferTx(t *testing.T, artelaApp *app.App, gasPrice int64, feeDenom string) sdk.Tx {
+	return newTx(t, artelaApp, &ethtypes.LegacyTx{To: &testRecipient, Gas: 100_000, GasPrice: big.NewInt(gasPrice)}, feeDenom)
+}
+
+func balance(artelaApp *app.App, ctx sdk.Context, denom string) int64 {
+	return artelaApp.BankKeeper.GetBalance(ctx, sdk.AccAddress(testSender.Bytes()), denom).Amount.Int64()
+}
+
+func TestGetFeeToken(t *testing.T) {
+	artelaApp, ctx := setupFeeToken(t)
+
+	testCases := []struct {
+		name         string
+		feeDenom     string
+		payWithToken bool
+		err          error
+	}{
+		{"no fee denom", "", false, nil},
+		{"evm denom", evmtypes.DefaultEVMDenom, false, nil},
+		{"whitelisted", testFeeDenom, true, nil},
+		{"not whitelisted", "ibc/unknown", false, evmtypes.ErrInvalidFeeToken},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			token, payWithToken, err := evm.GetFeeToken(ctx, artelaApp.EvmKeeper, transferTx(t, artelaApp, 5, tc.feeDenom))
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.payWithToken, payWithToken)
+			if payWithToken {
+				require.Equal(t, testFeeDenom, token.Denom)
+			}
+		})
+	}
+}
+
+func TestEthMinGasPriceDecoratorFeeToken(t *testing.T) {
+	artelaApp, ctx := setupFeeToken(t)
+	decorator := evm.NewEthMinGasPriceDecorator(artelaApp.FeeKeeper, artelaApp.EvmKeeper)
+
+	testCases := []struct {
+		name     string
+		gasPrice int64
+		feeDenom string
+		err      error
+	}{
+		// the global min gas price is zero, so only the min gas price of the fee token applies
+		{"evm denom", 1, evmtypes.DefaultEVMDenom, nil},
+		{"fee token", 5, testFeeDenom, nil},
+		{"fee token below min gas price", 4, testFeeDenom, errortypes.ErrInsufficientFee},
+		{"not whitelisted", 5, "ibc/unknown", evmtypes.ErrInvalidFeeToken},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := decorator.AnteHandle(ctx, transferTx(t, artelaApp, tc.gasPrice, tc.feeDenom), false, nextHandler)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestEthGasConsumeDecoratorFeeToken(t *testing.T) {
+	artelaApp, ctx := setupFeeToken(t)
+	decorator := evm.NewEthGasConsumeDecorator(artelaApp.BankKeeper, nil, artelaApp.EvmKeeper, nil, 0)
+
+	t.Run("fee token", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		newCtx, err := decorator.AnteHandle(ctx, transferTx(t, artelaApp, 5, testFeeDenom), false, nextHandler)
+		require.NoError(t, err)
+
+		// the fees of 500000aart are paid with 1000000 units of the fee token
+		require.Equal(t, int64(1_000_000_000), balance(artelaApp, newCtx, evmtypes.DefaultEVMDenom))
+		require.Equal(t, int64(999_000_000), balance(artelaApp, newCtx, testFeeDenom))
+		token, ok := evmtypes.FeeTokenFromContext(newCtx)
+		require.True(t, ok)
+		require.Equal(t, testFeeDenom, token.Denom)
+
+		// the leftover gas is refunded in the fee token
+		msg := &core.Message{From: testSender, GasPrice: big.NewInt(5)}
+		require.NoError(t, artelaApp.EvmKeeper.RefundGas(newCtx, msg, testSender, common.Address{}, 40_000, evmtypes.DefaultEVMDenom))
+		require.Equal(t, int64(1_000_000_000), balance(artelaApp, newCtx, evmtypes.DefaultEVMDenom))
+		require.Equal(t, int64(999_400_000), balance(artelaApp, newCtx, testFeeDenom))
+	})
+
+	t.Run("not whitelisted", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		_, err := decorator.AnteHandle(ctx, transferTx(t, artelaApp, 5, "ibc/unknown"), false, nextHandler)
+		require.ErrorIs(t, err, evmtypes.ErrInvalidFeeToken)
+	})
+
+	t.Run("sponsored by paymaster", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		keepertest.BindVerifierAspect(t, artelaApp, ctx, testContract, testAspect, testPayMaster)
+		keepertest.SetPaymasterLimit(t, artelaApp, ctx, testPayMaster, testAspect, 1_000_000_000)
+
+		// the unsigned tx to testContract, whose sender is verified by the aspect
+		tx := newTx(t, artelaApp, &ethtypes.LegacyTx{
+			To:       &testContract,
+			Gas:      100_000,
+			GasPrice: big.NewInt(5),
+			Data:     keepertest.AspectVerifiedTxData([]byte("verification")),
+		}, testFeeDenom)
+		_, err := decorator.AnteHandle(ctx, tx, false, nextHandler)
+		require.ErrorIs(t, err, evmtypes.ErrInvalidFeeToken)
+		require.Equal(t, int64(1_000_000_000), balance(artelaApp, ctx, testFeeDenom))
+	})
+}
@@ -51,10 +51,15 @@ func NewEthMempoolFeeDecorator(ek interfaces.EVMKeeper) EthMempoolFeeDecorator {
 // AnteHandle ensures that the effective fee from the transaction is greater than the
 // minimum global fee, which is defined by the  MinGasPrice (parameter) * GasLimit (tx argument).
 func (empd EthMinGasPriceDecorator) AnteHandle(ctx cosmos.Context, tx cosmos.Tx, simulate bool, next cosmos.AnteHandler) (newCtx cosmos.Context, err error) {
-	minGasPrice := empd.feesKeeper.GetParams(ctx).MinGasPrice
+	feeParams := empd.feesKeeper.GetParams(ctx)
+	minGasPrice := feeParams.MinGasPrice
+	feeToken, payWithToken, err := getFeeToken(ctx, empd.evmKeeper, tx)
+	if err != nil {
+		return ctx, errorsmod.Wrap(err, "failed to resolve the fee token")
+	}
 
-	// short-circuit if min gas price is 0
-	if minGasPrice.IsZero() {
+	// short-circuit if min gas price is 0, and no other floor applies
+	if minGasPrice.IsZero() && (!payWithToken || feeToken.MinGasPrice.IsZero()) && len(feeParams.FeePolicies) == 0 {
 		return next(ctx, tx, simulate)
 	}
 
@@ -105,6 +110,41 @@ func (empd EthMinGasPriceDecorator) AnteHandle(ctx cosmos.Context, tx cosmos.Tx,
 				fee.TruncateInt().Int64(), requiredFee.TruncateInt().Int64(),
 			)
 		}
+
+		// the txs calling a contract with a fee policy are also bounded by the gas price floor of the contract,
+		// only the called contract is checked, so the inner calls into aspect bound contracts are not surcharged
+		if to := txData.GetTo(); to != nil {
+			if policy, found := feeParams.GetFeePolicy(*to); found {
+				aspectBound, err := empd.evmKeeper.HasExecutionAspects(ctx, *to)
+				if err != nil {
+					return ctx, errorsmod.Wrapf(err, "failed to load the aspects bound to contract %s", to)
+				}
+
+				policyRequiredFee := policy.RequiredGasPrice(minGasPrice, aspectBound).Mul(gasLimit)
+				if fee.LT(policyRequiredFee) {
+					return ctx, errorsmod.Wrapf(
+						errortypes.ErrInsufficientFee,
+						"provided fee < minimum fee of contract %s (%s < %s). Please increase the priority tip (for EIP-1559 txs) or the gas prices (for access list or legacy txs)", //nolint:lll
+						to, fee.TruncateInt(), policyRequiredFee.TruncateInt(),
+					)
+				}
+			}
+		}
+
+		if !payWithToken {
+			continue
+		}
+
+		// the fees paid in a fee token are also bounded by the min gas price of the fee token
+		tokenFee := sdkmath.LegacyNewDecFromInt(feeToken.ConvertFees(sdkmath.NewIntFromBigInt(feeAmt)))
+		tokenRequiredFee := feeToken.MinGasPrice.Mul(gasLimit)
+		if tokenFee.LT(tokenRequiredFee) {
+			return ctx, errorsmod.Wrapf(
+				errortypes.ErrInsufficientFee,
+				"provided fee < minimum fee of fee token %s (%s < %s). Please increase the gas prices",
+				feeToken.Denom, tokenFee.TruncateInt(), tokenRequiredFee.TruncateInt(),
+			)
+		}
 	}
 
 	return next(ctx, tx, simulate)
@@ -0,0 +1,48 @@
+package ante_test
+
+import (
+	"math/rand"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/artela-network/artela-rollkit/testutil/keeper"
+	evmtypes "github.com/artela-network/artela-rollkit/x/evm/types"
+)
+
+// TestCosmosTxFeesExcludedFromBlockFees checks that the fees of Cosmos txs are neither deducted
+// nor added to the block fees, which only collect the fees of Ethereum txs.
+func TestCosmosTxFeesExcludedFromBlockFees(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	sender := sdk.AccAddress(priv.PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin(evmtypes.DefaultEVMDenom, 1_000_000_000))
+	artelaApp, ctx := keepertest.ArtelaApp(t, banktypes.Balance{Address: sender.String(), Coins: coins})
+	ctx = ctx.WithBlockGasMeter(storetypes.NewGasMeter(10_000_000))
+
+	params := artelaApp.FeeKeeper.GetParams(ctx)
+	params.MinGasPrice = sdkmath.LegacyZeroDec()
+	require.NoError(t, artelaApp.FeeKeeper.SetParams(ctx, params))
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin(evmtypes.DefaultEVMDenom, 1_000_000))
+	msg := banktypes.NewMsgSend(sender, sdk.AccAddress("recipient___________"), sdk.NewCoins(sdk.NewInt64Coin(evmtypes.DefaultEVMDenom, 1)))
+	tx, err := simtestutil.GenSignedMockTx(rand.New(rand.NewSource(1)),
+		authtx.NewTxConfig(artelaApp.AppCodec(), authtx.DefaultSignModes),
+		[]sdk.Msg{msg}, fees, 200_000, keepertest.TestChainID,
+		[]uint64{artelaApp.AccountKeeper.GetAccount(ctx, sender).GetAccountNumber()}, []uint64{0}, priv)
+	require.NoError(t, err)
+
+	_, err = artelaApp.AnteHandler()(ctx, tx, false)
+	require.NoError(t, err)
+
+	require.Equal(t, coins, artelaApp.BankKeeper.GetAllBalances(ctx, sender))
+	blockFees := artelaApp.FeeKeeper.GetTransientBlockFees(ctx)
+	require.True(t, blockFees.BaseFees.IsZero())
+	require.True(t, blockFees.Tips.IsZero())
+}
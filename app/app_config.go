@@ -197,6 +197,7 @@ var (
 		{Account: icatypes.ModuleName},
 		// this line is used by starport scaffolding # stargate/app/maccPerms
 		{Account: evmmoduletypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
+		{Account: feemoduletypes.ModuleName, Permissions: []string{authtypes.Burner}},
 	}
 
 	// blocked account addresses
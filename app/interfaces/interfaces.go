@@ -25,6 +25,11 @@ type EVMKeeper interface {
 
 	NewEVM(ctx cosmos.Context, msg *core.Message, cfg *states.EVMConfig, tracer vm.EVMLogger, stateDB vm.StateDB) *vm.EVM
 	DeductTxCostsFromUserBalance(ctx cosmos.Context, fees cosmos.Coins, from common.Address) error
+	DeductTxCostsFromPaymaster(ctx cosmos.Context, fees cosmos.Coins, paymaster, aspectID common.Address) error
+	GetFeePayer(ctx cosmos.Context, tx *ethereum.Transaction, sender common.Address) (payer, aspectID common.Address, err error)
+	GetFeeToken(ctx cosmos.Context, denom string) (feemodule.FeeToken, error)
+	DeductTxCostsInFeeToken(ctx cosmos.Context, fees cosmos.Coins, token feemodule.FeeToken, from common.Address) (cosmos.Coins, error)
+	HasExecutionAspects(ctx cosmos.Context, contract common.Address) (bool, error)
 	GetBalance(ctx cosmos.Context, addr common.Address) *big.Int
 	ResetTransientGasUsed(ctx cosmos.Context)
 	GetTxIndexTransient(ctx cosmos.Context) uint64
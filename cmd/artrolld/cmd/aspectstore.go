@@ -0,0 +1,585 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"cosmossdk.io/store/iavl"
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/cobra"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+
+	"github.com/artela-network/artela-rollkit/app"
+	"github.com/artela-network/artela-rollkit/x/aspect/store"
+	aspecttypes "github.com/artela-network/artela-rollkit/x/aspect/types"
+)
+
+const (
+	flagAspectStoreHeight = "height"
+	flagAspectStoreFix    = "fix"
+)
+
+// errReadOnlyDB is returned on any write to the application db opened read-only by the aspect-store commands.
+var errReadOnlyDB = errors.New("application db is opened read-only")
+
+// AspectStoreCmd returns the aspect-store cobra Command, which inspects and repairs
+// the aspect data in the application db of a stopped node.
+func AspectStoreCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aspect-store",
+		Short: "Inspect and repair the aspect store offline",
+		Long: `Inspect and repair the aspect store in the application db of the node.
+The node must be stopped before running these commands, the db is opened read-only
+unless a repair is requested with --fix.`,
+	}
+
+	cmd.PersistentFlags().Int64(flagAspectStoreHeight, 0, "Height of the application state to inspect, the latest height if not set")
+	cmd.AddCommand(
+		aspectStoreAspectCmd(),
+		aspectStoreAccountCmd(),
+		aspectStoreCheckFiltersCmd(),
+	)
+
+	return cmd
+}
+
+func aspectStoreAspectCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "aspect [aspect_id_hex]",
+		Short: "Print the decoded store of an aspect",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			aspectID, err := parseHexAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			artelaApp, ctx, closeDB, err := openAspectStoreApp(cmd, false)
+			if err != nil {
+				return err
+			}
+			defer closeDB()
+
+			report, err := inspectAspect(artelaApp, ctx, aspectID)
+			if err != nil {
+				return err
+			}
+
+			return printAspectStoreReport(cmd, report)
+		},
+	}
+}
+
+func aspectStoreAccountCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "account [account_hex]",
+		Short: "Print the decoded aspect bindings of an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			account, err := parseHexAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			artelaApp, ctx, closeDB, err := openAspectStoreApp(cmd, false)
+			if err != nil {
+				return err
+			}
+			defer closeDB()
+
+			report, err := inspectAccount(artelaApp, ctx, account)
+			if err != nil {
+				return err
+			}
+
+			return printAspectStoreReport(cmd, report)
+		},
+	}
+}
+
+func aspectStoreCheckFiltersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-filters [aspect_id_hex...]",
+		Short: "Verify the binding filters of aspects against their binding data",
+		Long: `Verify the binding filters of the given aspects, or all the deployed aspects if none is given,
+against their binding data. The check always runs on a read-only db. With --fix, the db is then reopened
+writable, and the filters of the inconsistent aspects are rebuilt and written as the state of the latest
+height, which changes the app hash of that height. Only repair when every node of the network applies
+the same repair at the same halted height.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fix, err := cmd.Flags().GetBool(flagAspectStoreFix)
+			if err != nil {
+				return err
+			}
+			height, err := cmd.Flags().GetInt64(flagAspectStoreHeight)
+			if err != nil {
+				return err
+			}
+			if fix && height != 0 {
+				return errors.New("repair is only allowed on the latest height")
+			}
+
+			aspectIDs := make([]common.Address, 0, len(args))
+			for _, arg := range args {
+				aspectID, err := parseHexAddress(arg)
+				if err != nil {
+					return err
+				}
+				aspectIDs = append(aspectIDs, aspectID)
+			}
+
+			checked, reports, err := checkAspectFilters(cmd, aspectIDs)
+			if err != nil {
+				return err
+			}
+
+			if fix && len(reports) > 0 {
+				if err := repairAspectFilters(cmd, reports); err != nil {
+					return err
+				}
+				for i := range reports {
+					reports[i].Fixed = true
+				}
+			}
+
+			return printAspectStoreReport(cmd, map[string]interface{}{
+				"checked":      checked,
+				"inconsistent": reports,
+			})
+		},
+	}
+
+	cmd.Flags().Bool(flagAspectStoreFix, false, "Rebuild the binding filters of the inconsistent aspects, the db is reopened writable")
+	return cmd
+}
+
+// checkAspectFilters verifies the binding filters of the given aspects, or all the deployed aspects if none is given,
+// on the read-only db. Returns the number of checked aspects and the reports of the inconsistent ones.
+func checkAspectFilters(cmd *cobra.Command, aspectIDs []common.Address) (int, []aspectFilterReport, error) {
+	artelaApp, ctx, closeDB, err := openAspectStoreApp(cmd, false)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer closeDB()
+
+	if len(aspectIDs) == 0 {
+		if err := artelaApp.AspectKeeper.IterateAspectIDs(ctx, func(aspectID common.Address) (bool, error) {
+			aspectIDs = append(aspectIDs, aspectID)
+			return false, nil
+		}); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	reports := make([]aspectFilterReport, 0)
+	for _, aspectID := range aspectIDs {
+		issues, err := artelaApp.AspectKeeper.CheckBindingFilters(ctx, aspectID, false)
+		if err != nil {
+			return 0, nil, fmt.Errorf("check aspect %s: %w", aspectID.Hex(), err)
+		}
+		if len(issues) == 0 {
+			continue
+		}
+
+		report := aspectFilterReport{
+			AspectID: aspectID.Hex(),
+			Issues:   make([]bindingFilterIssue, 0, len(issues)),
+		}
+		for _, issue := range issues {
+			report.Issues = append(report.Issues, bindingFilterIssue{
+				Account: issue.Account.Hex(),
+				Slot:    issue.Slot,
+				Filter:  issue.Filter,
+				Reason:  issue.Reason,
+			})
+		}
+		reports = append(reports, report)
+	}
+
+	return len(aspectIDs), reports, nil
+}
+
+// repairAspectFilters reopens the db writable, and rebuilds the binding filters of the reported aspects.
+// The repairs are written to a cached store first, and only persisted once all aspects are rebuilt.
+func repairAspectFilters(cmd *cobra.Command, reports []aspectFilterReport) error {
+	artelaApp, ctx, closeDB, err := openAspectStoreApp(cmd, true)
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	cacheCtx, _ := ctx.CacheContext()
+	for _, report := range reports {
+		aspectID := common.HexToAddress(report.AspectID)
+		if _, err := artelaApp.AspectKeeper.CheckBindingFilters(cacheCtx, aspectID, true); err != nil {
+			return fmt.Errorf("repair aspect %s: %w", report.AspectID, err)
+		}
+	}
+
+	aspectKey := artelaApp.GetKey(aspecttypes.StoreKey)
+	if err := overwriteLatestStore(artelaApp.CommitMultiStore(), aspectKey, cacheCtx.KVStore(aspectKey), ctx.BlockHeight()); err != nil {
+		return fmt.Errorf("persist repaired filters: %w", err)
+	}
+	return nil
+}
+
+type aspectStoreReport struct {
+	AspectID        string                `json:"aspect_id"`
+	ProtocolVersion uint16                `json:"protocol_version"`
+	MetaVersion     uint16                `json:"meta_version"`
+	StateVersion    uint16                `json:"state_version"`
+	StoreVersion    uint16                `json:"store_version"`
+	PendingMigrate  bool                  `json:"pending_migration"`
+	LatestVersion   uint64                `json:"latest_version"`
+	PayMaster       string                `json:"paymaster"`
+	Proof           string                `json:"proof"`
+	Owner           string                `json:"owner,omitempty"`
+	Status          string                `json:"status"`
+	Versions        []aspectVersionReport `json:"versions"`
+	Bindings        []bindingReport       `json:"bindings"`
+	FilterIssues    []bindingFilterIssue  `json:"filter_issues,omitempty"`
+}
+
+type aspectVersionReport struct {
+	Version    uint64            `json:"version"`
+	JoinPoint  uint64            `json:"join_point"`
+	CodeHash   string            `json:"code_hash"`
+	CodeSize   int               `json:"code_size"`
+	Properties map[string]string `json:"properties"`
+}
+
+type accountStoreReport struct {
+	Account         string          `json:"account"`
+	ProtocolVersion uint16          `json:"protocol_version"`
+	StoreVersion    uint16          `json:"store_version"`
+	PendingMigrate  bool            `json:"pending_migration"`
+	Bindings        []bindingReport `json:"bindings"`
+}
+
+type bindingReport struct {
+	Address   string `json:"address"`
+	Version   uint64 `json:"version,omitempty"`
+	Priority  int8   `json:"priority"`
+	JoinPoint uint16 `json:"join_point,omitempty"`
+}
+
+type aspectFilterReport struct {
+	AspectID string               `json:"aspect_id"`
+	Issues   []bindingFilterIssue `json:"issues"`
+	Fixed    bool                 `json:"fixed"`
+}
+
+type bindingFilterIssue struct {
+	Account string `json:"account"`
+	Slot    uint64 `json:"slot"`
+	Filter  uint8  `json:"filter"`
+	Reason  string `json:"reason"`
+}
+
+// openAspectStoreApp opens the application db of the node and loads the state at the height given by flag.
+// The db is opened read-only unless writable is set, writing is only allowed on the latest height.
+func openAspectStoreApp(cmd *cobra.Command, writable bool) (*app.App, sdk.Context, func(), error) {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+
+	height, err := cmd.Flags().GetInt64(flagAspectStoreHeight)
+	if err != nil {
+		return nil, sdk.Context{}, nil, err
+	}
+	if writable && height != 0 {
+		return nil, sdk.Context{}, nil, errors.New("repair is only allowed on the latest height")
+	}
+
+	db, err := openApplicationDB(serverCtx.Config.RootDir, server.GetAppDBBackend(serverCtx.Viper), !writable)
+	if err != nil {
+		return nil, sdk.Context{}, nil, err
+	}
+
+	artelaApp, err := app.New(serverCtx.Logger, db, nil, height == 0, serverCtx.Viper)
+	if err != nil {
+		_ = db.Close()
+		return nil, sdk.Context{}, nil, err
+	}
+	if height != 0 {
+		if err := artelaApp.LoadHeight(height); err != nil {
+			_ = db.Close()
+			return nil, sdk.Context{}, nil, err
+		}
+	}
+
+	header := cmtproto.Header{Height: artelaApp.LastBlockHeight()}
+	ctx := sdk.NewContext(artelaApp.CommitMultiStore(), header, false, serverCtx.Logger)
+	return artelaApp, ctx, func() { _ = db.Close() }, nil
+}
+
+// openApplicationDB opens the application db under the data dir of the node. A read-only goleveldb is
+// opened in its native read-only mode, other backends do not expose one, so every write to them is
+// rejected by readOnlyDB. The db is only opened writable for a requested repair.
+func openApplicationDB(rootDir string, backend dbm.BackendType, readOnly bool) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	if !readOnly {
+		return dbm.NewDB("application", backend, dataDir)
+	}
+	if backend == dbm.GoLevelDBBackend {
+		return dbm.NewGoLevelDBWithOpts("application", dataDir, &opt.Options{ReadOnly: true})
+	}
+
+	db, err := dbm.NewDB("application", backend, dataDir)
+	if err != nil {
+		return nil, err
+	}
+	return readOnlyDB{DB: db}, nil
+}
+
+// readOnlyDB wraps a db and rejects all writes to it.
+type readOnlyDB struct {
+	dbm.DB
+}
+
+func (db readOnlyDB) Set([]byte, []byte) error       { return errReadOnlyDB }
+func (db readOnlyDB) SetSync([]byte, []byte) error   { return errReadOnlyDB }
+func (db readOnlyDB) Delete([]byte) error            { return errReadOnlyDB }
+func (db readOnlyDB) DeleteSync([]byte) error        { return errReadOnlyDB }
+func (db readOnlyDB) NewBatch() dbm.Batch            { return readOnlyBatch{} }
+func (db readOnlyDB) NewBatchWithSize(int) dbm.Batch { return readOnlyBatch{} }
+
+// readOnlyBatch is the batch of readOnlyDB, which can never be written.
+type readOnlyBatch struct{}
+
+func (readOnlyBatch) Set([]byte, []byte) error  { return errReadOnlyDB }
+func (readOnlyBatch) Delete([]byte) error       { return errReadOnlyDB }
+func (readOnlyBatch) Write() error              { return errReadOnlyDB }
+func (readOnlyBatch) WriteSync() error          { return errReadOnlyDB }
+func (readOnlyBatch) Close() error              { return nil }
+func (readOnlyBatch) GetByteSize() (int, error) { return 0, nil }
+
+func inspectAspect(artelaApp *app.App, ctx sdk.Context, aspectID common.Address) (*aspectStoreReport, error) {
+	k := artelaApp.AspectKeeper
+	storeCtx := &aspecttypes.AspectStoreContext{
+		StoreContext: aspecttypes.NewGasFreeStoreContext(ctx, k.GetEVMStoreService(), k.GetStoreService()),
+		AspectID:     aspectID,
+	}
+
+	protocolVersion, aspectInfo, err := store.GetProtocolInfo(storeCtx)
+	if err != nil {
+		return nil, err
+	}
+	metaStore, newStore, err := store.GetAspectMetaStore(storeCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	latestVersion, err := metaStore.GetLatestVersion()
+	if err != nil {
+		return nil, err
+	}
+	if latestVersion == 0 {
+		return nil, fmt.Errorf("aspect %s not deployed", aspectID.Hex())
+	}
+
+	meta, err := metaStore.GetMeta()
+	if err != nil {
+		return nil, err
+	}
+
+	report := &aspectStoreReport{
+		AspectID:        aspectID.Hex(),
+		ProtocolVersion: uint16(protocolVersion),
+		MetaVersion:     uint16(aspectInfo.MetaVersion),
+		StateVersion:    uint16(aspectInfo.StateVersion),
+		StoreVersion:    uint16(metaStore.Version()),
+		PendingMigrate:  newStore != nil,
+		LatestVersion:   latestVersion,
+		PayMaster:       meta.PayMaster.Hex(),
+		Proof:           hexutil.Encode(meta.Proof),
+		Status:          meta.Status.String(),
+		Versions:        make([]aspectVersionReport, 0, latestVersion),
+	}
+	if meta.Owner != nil {
+		report.Owner = meta.Owner.Hex()
+	}
+
+	for version := uint64(1); version <= latestVersion; version++ {
+		versionMeta, err := metaStore.GetVersionMeta(version)
+		if err != nil {
+			return nil, err
+		}
+		code, err := metaStore.GetCode(version)
+		if err != nil {
+			return nil, err
+		}
+		properties, err := metaStore.GetProperties(version)
+		if err != nil {
+			return nil, err
+		}
+
+		versionReport := aspectVersionReport{
+			Version:    version,
+			JoinPoint:  versionMeta.JoinPoint,
+			CodeHash:   crypto.Keccak256Hash(code).Hex(),
+			CodeSize:   len(code),
+			Properties: make(map[string]string, len(properties)),
+		}
+		for _, property := range properties {
+			versionReport.Properties[property.Key] = hexutil.Encode(property.Value)
+		}
+		report.Versions = append(report.Versions, versionReport)
+	}
+
+	bindings, err := metaStore.LoadAspectBoundAccounts()
+	if err != nil {
+		return nil, err
+	}
+	report.Bindings = toBindingReports(bindings)
+
+	if filterStore, ok := metaStore.(store.BindingFilterStore); ok {
+		issues, err := filterStore.VerifyBindingFilters()
+		if err != nil {
+			return nil, err
+		}
+		for _, issue := range issues {
+			report.FilterIssues = append(report.FilterIssues, bindingFilterIssue{
+				Account: issue.Account.Hex(),
+				Slot:    issue.Slot,
+				Filter:  issue.Filter,
+				Reason:  issue.Reason,
+			})
+		}
+	}
+
+	return report, nil
+}
+
+func inspectAccount(artelaApp *app.App, ctx sdk.Context, account common.Address) (*accountStoreReport, error) {
+	k := artelaApp.AspectKeeper
+	storeCtx := &aspecttypes.AccountStoreContext{
+		StoreContext: aspecttypes.NewGasFreeStoreContext(ctx, k.GetEVMStoreService(), k.GetStoreService()),
+		Account:      account,
+	}
+
+	protocolVersion, _, err := store.GetProtocolInfo(storeCtx)
+	if err != nil {
+		return nil, err
+	}
+	accountStore, newStore, err := store.GetAccountStore(storeCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	bindings, err := accountStore.LoadAccountBoundAspects(aspecttypes.BindingFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountStoreReport{
+		Account:         account.Hex(),
+		ProtocolVersion: uint16(protocolVersion),
+		StoreVersion:    uint16(accountStore.Version()),
+		PendingMigrate:  newStore != nil,
+		Bindings:        toBindingReports(bindings),
+	}, nil
+}
+
+// overwriteLatestStore replaces the latest version of the given store with the repaired one.
+// The store is rolled back to the previous height and only the keys differing from it are written,
+// so the tree nodes untouched by the repair keep their versions. The commit info of the latest height
+// is rebuilt afterward, so the app hash of the latest height reflects the repair.
+func overwriteLatestStore(cms storetypes.CommitMultiStore, key storetypes.StoreKey, repaired storetypes.KVStore, height int64) error {
+	if height <= 1 {
+		return fmt.Errorf("cannot repair the state at height %d", height)
+	}
+
+	iavlStore, ok := cms.GetCommitKVStore(key).(*iavl.Store)
+	if !ok {
+		return fmt.Errorf("unexpected store type of %s", key.Name())
+	}
+	previous, err := iavlStore.GetImmutable(height - 1)
+	if err != nil {
+		return fmt.Errorf("load height %d, the previous height must not be pruned: %w", height-1, err)
+	}
+
+	var (
+		keys    = make([]string, 0)
+		values  = make(map[string][]byte)
+		deletes = make([]string, 0)
+	)
+	iter := repaired.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		k, v := string(iter.Key()), iter.Value()
+		values[k] = v
+		if !previous.Has(iter.Key()) || !bytes.Equal(previous.Get(iter.Key()), v) {
+			keys = append(keys, k)
+		}
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	prevIter := previous.Iterator(nil, nil)
+	for ; prevIter.Valid(); prevIter.Next() {
+		if _, ok := values[string(prevIter.Key())]; !ok {
+			deletes = append(deletes, string(prevIter.Key()))
+		}
+	}
+	if err := prevIter.Close(); err != nil {
+		return err
+	}
+
+	if err := iavlStore.LoadVersionForOverwriting(height - 1); err != nil {
+		return err
+	}
+
+	// write in key order, so the repair results in the same tree on every node
+	sort.Strings(keys)
+	for _, k := range keys {
+		iavlStore.Set([]byte(k), values[k])
+	}
+	for _, k := range deletes {
+		iavlStore.Delete([]byte(k))
+	}
+	if commitID := iavlStore.Commit(); commitID.Version != height {
+		return fmt.Errorf("unexpected version %d after repair, expected %d", commitID.Version, height)
+	}
+
+	return cms.RollbackToVersion(height)
+}
+
+func toBindingReports(bindings []aspecttypes.Binding) []bindingReport {
+	reports := make([]bindingReport, 0, len(bindings))
+	for _, binding := range bindings {
+		reports = append(reports, bindingReport{
+			Address:   binding.Account.Hex(),
+			Version:   binding.Version,
+			Priority:  binding.Priority,
+			JoinPoint: binding.JoinPoint,
+		})
+	}
+	return reports
+}
+
+func parseHexAddress(address string) (common.Address, error) {
+	if !common.IsHexAddress(address) {
+		return common.Address{}, fmt.Errorf("invalid address %s, please input a valid ethereum format address", address)
+	}
+	return common.HexToAddress(address), nil
+}
+
+func printAspectStoreReport(cmd *cobra.Command, report interface{}) error {
+	out, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	cmd.Println(string(out))
+	return nil
+}
@@ -59,6 +59,8 @@ func initRootCmd(
 		AddGenesisAccountCmd(app.DefaultNodeHome),
 		AddGenesisContractCmd(app.DefaultNodeHome),
 		KeyInfoCmd(),
+		AspectStoreCmd(),
+		IndexEthTxCmd(),
 	)
 
 	server.AddCommandsWithStartCmdOptions(
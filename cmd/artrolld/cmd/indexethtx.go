@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+
+	"github.com/artela-network/artela-rollkit/ethereum/indexer"
+	rollserv "github.com/artela-network/artela-rollkit/ethereum/server"
+)
+
+// IndexEthTxCmd returns the index-eth-tx cobra Command, which rebuilds the evm tx indexer db
+// of the node for a range of blocks.
+func IndexEthTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "index-eth-tx [start-height] [end-height]",
+		Short: "Index the eth txs of a range of blocks into the evm tx indexer db",
+		Long: `Index the eth txs of the blocks in [start-height, end-height] into the evm tx indexer db
+under the node home, the end height defaults to the latest block. The blocks and their results are
+fetched from the node given by --node. The indexer db is locked by a node running with
+json-rpc.enable-indexer, so stop the local node, or run it with the indexer disabled, before
+reindexing.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			if clientCtx.Client == nil {
+				return fmt.Errorf("no node client, please set --%s", flags.FlagNode)
+			}
+
+			startHeight, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil || startHeight < 1 {
+				return fmt.Errorf("invalid start height %s", args[0])
+			}
+
+			var endHeight int64
+			if len(args) > 1 {
+				endHeight, err = strconv.ParseInt(args[1], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid end height %s", args[1])
+				}
+			} else {
+				status, err := clientCtx.Client.Status(cmd.Context())
+				if err != nil {
+					return err
+				}
+				endHeight = status.SyncInfo.LatestBlockHeight
+			}
+			if endHeight < startHeight {
+				return fmt.Errorf("end height %d is lower than start height %d", endHeight, startHeight)
+			}
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			idxDB, err := rollserv.OpenIndexerDB(serverCtx.Config.RootDir, server.GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+			defer idxDB.Close()
+
+			idxer := indexer.NewKVIndexer(idxDB, serverCtx.Logger.With("indexer", "evm"), clientCtx)
+			for height := startHeight; height <= endHeight; height++ {
+				block, err := clientCtx.Client.Block(cmd.Context(), &height)
+				if err != nil {
+					return fmt.Errorf("failed to fetch block %d, %w", height, err)
+				}
+				blockResult, err := clientCtx.Client.BlockResults(cmd.Context(), &height)
+				if err != nil {
+					return fmt.Errorf("failed to fetch block result %d, %w", height, err)
+				}
+				if err := idxer.IndexBlock(block.Block, blockResult.TxsResults); err != nil {
+					return err
+				}
+			}
+
+			cmd.Printf("indexed blocks %d to %d\n", startHeight, endHeight)
+			return nil
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -81,6 +81,33 @@ var methods = map[string]abi.Method{
 		{Name: "contract", Type: Address, Indexed: false},
 		{Name: "version", Type: Uint64, Indexed: false},
 	}, nil),
+	"transferOwnership": abi.NewMethod("transferOwnership", "transferOwnership", abi.Function, "", false, false, []abi.Argument{
+		{Name: "aspectId", Type: Address, Indexed: false},
+		{Name: "newOwner", Type: Address, Indexed: false},
+	}, nil),
+	"setPaymaster": abi.NewMethod("setPaymaster", "setPaymaster", abi.Function, "", false, false, []abi.Argument{
+		{Name: "aspectId", Type: Address, Indexed: false},
+		{Name: "paymaster", Type: Address, Indexed: false},
+		{Name: "proof", Type: Bytes, Indexed: false},
+	}, nil),
+	"setPaymasterLimit": abi.NewMethod("setPaymasterLimit", "setPaymasterLimit", abi.Function, "", false, false, []abi.Argument{
+		{Name: "aspectId", Type: Address, Indexed: false},
+		{Name: "limit", Type: Uint256, Indexed: false},
+	}, nil),
+	"paymasterLimitOf": abi.NewMethod("paymasterLimitOf", "paymasterLimitOf", abi.Function, "", false, false, []abi.Argument{
+		{Name: "paymaster", Type: Address, Indexed: false},
+		{Name: "aspectId", Type: Address, Indexed: false},
+	}, []abi.Argument{
+		{Name: "limit", Type: Uint256, Indexed: false},
+		{Name: "spent", Type: Uint256, Indexed: false},
+	}),
+	"renounce": abi.NewMethod("renounce", "renounce", abi.Function, "", false, false, []abi.Argument{
+		{Name: "aspectId", Type: Address, Indexed: false},
+	}, nil),
+	"setStatus": abi.NewMethod("setStatus", "setStatus", abi.Function, "", false, false, []abi.Argument{
+		{Name: "aspectId", Type: Address, Indexed: false},
+		{Name: "status", Type: Uint8, Indexed: false},
+	}, nil),
 	"versionOf": abi.NewMethod("versionOf", "versionOf", abi.Function, "", false, false, []abi.Argument{
 		{Name: "aspectId", Type: Address, Indexed: false},
 	}, []abi.Argument{
@@ -96,6 +123,24 @@ var methods = map[string]abi.Method{
 	}, []abi.Argument{
 		{Name: "account", Type: AddressArr, Indexed: false},
 	}),
+	"aspectsOfPaged": abi.NewMethod("aspectsOfPaged", "aspectsOfPaged", abi.Function, "", false, false, []abi.Argument{
+		{Name: "contract", Type: Address, Indexed: false},
+		{Name: "joinPoint", Type: String, Indexed: false},
+		{Name: "cursor", Type: Uint64, Indexed: false},
+		{Name: "limit", Type: Uint64, Indexed: false},
+	}, []abi.Argument{
+		{Name: "aspectBoundInfo", Type: AspectBoundInfoArr, Indexed: false},
+		{Name: "nextCursor", Type: Uint64, Indexed: false},
+	}),
+	"boundAddressesOfPaged": abi.NewMethod("boundAddressesOfPaged", "boundAddressesOfPaged", abi.Function, "", false, false, []abi.Argument{
+		{Name: "aspectId", Type: Address, Indexed: false},
+		{Name: "joinPoint", Type: String, Indexed: false},
+		{Name: "cursor", Type: Uint64, Indexed: false},
+		{Name: "limit", Type: Uint64, Indexed: false},
+	}, []abi.Argument{
+		{Name: "account", Type: AddressArr, Indexed: false},
+		{Name: "nextCursor", Type: Uint64, Indexed: false},
+	}),
 	"entrypoint": abi.NewMethod("entrypoint", "entrypoint", abi.Function, "", false, false, []abi.Argument{
 		{Name: "aspectId", Type: Address, Indexed: false},
 		{Name: "optArgs", Type: Bytes, Indexed: false},
@@ -104,6 +149,69 @@ var methods = map[string]abi.Method{
 	}),
 }
 
+// aspect lifecycle events, emitted as logs from the aspect system contract
+const (
+	EventAspectDeployed       = "AspectDeployed"
+	EventAspectUpgraded       = "AspectUpgraded"
+	EventAspectBound          = "AspectBound"
+	EventAspectUnbound        = "AspectUnbound"
+	EventAspectVersionChanged = "AspectVersionChanged"
+
+	EventAspectOwnershipTransferred = "AspectOwnershipTransferred"
+	EventAspectPayMasterUpdated     = "AspectPayMasterUpdated"
+	EventAspectStatusChanged        = "AspectStatusChanged"
+	EventPaymasterLimitUpdated      = "PaymasterLimitUpdated"
+)
+
+var events = map[string]abi.Event{
+	EventAspectDeployed: abi.NewEvent(EventAspectDeployed, EventAspectDeployed, false, abi.Arguments{
+		{Name: "aspectId", Type: Address, Indexed: true},
+		{Name: "deployer", Type: Address, Indexed: true},
+		{Name: "version", Type: Uint64, Indexed: false},
+		{Name: "joinPoints", Type: Uint256, Indexed: false},
+	}),
+	EventAspectUpgraded: abi.NewEvent(EventAspectUpgraded, EventAspectUpgraded, false, abi.Arguments{
+		{Name: "aspectId", Type: Address, Indexed: true},
+		{Name: "version", Type: Uint64, Indexed: false},
+		{Name: "joinPoints", Type: Uint256, Indexed: false},
+	}),
+	EventAspectBound: abi.NewEvent(EventAspectBound, EventAspectBound, false, abi.Arguments{
+		{Name: "aspectId", Type: Address, Indexed: true},
+		{Name: "account", Type: Address, Indexed: true},
+		{Name: "version", Type: Uint64, Indexed: false},
+		{Name: "priority", Type: Int8, Indexed: false},
+	}),
+	EventAspectUnbound: abi.NewEvent(EventAspectUnbound, EventAspectUnbound, false, abi.Arguments{
+		{Name: "aspectId", Type: Address, Indexed: true},
+		{Name: "account", Type: Address, Indexed: true},
+	}),
+	EventAspectVersionChanged: abi.NewEvent(EventAspectVersionChanged, EventAspectVersionChanged, false, abi.Arguments{
+		{Name: "aspectId", Type: Address, Indexed: true},
+		{Name: "account", Type: Address, Indexed: true},
+		{Name: "oldVersion", Type: Uint64, Indexed: false},
+		{Name: "newVersion", Type: Uint64, Indexed: false},
+	}),
+	EventAspectOwnershipTransferred: abi.NewEvent(EventAspectOwnershipTransferred, EventAspectOwnershipTransferred, false, abi.Arguments{
+		{Name: "aspectId", Type: Address, Indexed: true},
+		{Name: "previousOwner", Type: Address, Indexed: true},
+		{Name: "newOwner", Type: Address, Indexed: true},
+	}),
+	EventAspectPayMasterUpdated: abi.NewEvent(EventAspectPayMasterUpdated, EventAspectPayMasterUpdated, false, abi.Arguments{
+		{Name: "aspectId", Type: Address, Indexed: true},
+		{Name: "paymaster", Type: Address, Indexed: true},
+	}),
+	EventAspectStatusChanged: abi.NewEvent(EventAspectStatusChanged, EventAspectStatusChanged, false, abi.Arguments{
+		{Name: "aspectId", Type: Address, Indexed: true},
+		{Name: "previousStatus", Type: Uint8, Indexed: false},
+		{Name: "status", Type: Uint8, Indexed: false},
+	}),
+	EventPaymasterLimitUpdated: abi.NewEvent(EventPaymasterLimitUpdated, EventPaymasterLimitUpdated, false, abi.Arguments{
+		{Name: "paymaster", Type: Address, Indexed: true},
+		{Name: "aspectId", Type: Address, Indexed: true},
+		{Name: "limit", Type: Uint256, Indexed: false},
+	}),
+}
+
 var methodsLookup = AbiMap()
 
 var AbiMap = func() map[string]string {
@@ -167,3 +275,47 @@ func IsAspectDeploy(to *common.Address, callData []byte) bool {
 	methodName, err := GetMethodName(callData)
 	return err == nil && methodName == "deploy"
 }
+
+// GetEvent returns the abi of the given aspect lifecycle event.
+func GetEvent(name string) (*abi.Event, error) {
+	event, ok := events[name]
+	if !ok {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "event %s not found", name)
+	}
+	return &event, nil
+}
+
+// PackEvent packs the arguments of the given event into log topics and data,
+// arguments must be given in the order of the event inputs.
+func PackEvent(name string, args ...interface{}) ([]common.Hash, []byte, error) {
+	event, err := GetEvent(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(args) != len(event.Inputs) {
+		return nil, nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest,
+			"event %s expects %d arguments, got %d", name, len(event.Inputs), len(args))
+	}
+
+	topics := []common.Hash{event.ID}
+	nonIndexed := make([]interface{}, 0, len(args))
+	for i, input := range event.Inputs {
+		if !input.Indexed {
+			nonIndexed = append(nonIndexed, args[i])
+			continue
+		}
+
+		topic, err := abi.MakeTopics([]interface{}{args[i]})
+		if err != nil {
+			return nil, nil, err
+		}
+		topics = append(topics, topic[0][0])
+	}
+
+	data, err := event.Inputs.NonIndexed().Pack(nonIndexed...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return topics, data, nil
+}
@@ -128,3 +128,41 @@ func TestContractOfPack(t *testing.T) {
 	fmt.Println(aspects)
 	// mock response
 }
+
+func TestPackEvent(t *testing.T) {
+	aspectID := common.HexToAddress("0x0000000000000000000000000000000000000a01")
+	account := common.HexToAddress("0x00000000000000000000000000000000000000c1")
+
+	topics, data, err := PackEvent(EventAspectBound, aspectID, account, uint64(2), int8(-1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedTopics := []common.Hash{
+		crypto.Keccak256Hash([]byte("AspectBound(address,address,uint64,int8)")),
+		common.BytesToHash(aspectID.Bytes()),
+		common.BytesToHash(account.Bytes()),
+	}
+	if !reflect.DeepEqual(topics, expectedTopics) {
+		t.Errorf("got topics %v, expected %v", topics, expectedTopics)
+	}
+
+	event, err := GetEvent(EventAspectBound)
+	if err != nil {
+		t.Fatal(err)
+	}
+	values, err := event.Inputs.NonIndexed().Unpack(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(values, []interface{}{uint64(2), int8(-1)}) {
+		t.Errorf("got values %v", values)
+	}
+
+	if _, _, err := PackEvent(EventAspectUnbound, aspectID); err == nil {
+		t.Error("expected error for missing arguments")
+	}
+	if _, _, err := PackEvent("Unknown"); err == nil {
+		t.Error("expected error for unknown event")
+	}
+}
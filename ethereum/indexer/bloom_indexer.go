@@ -0,0 +1,143 @@
+package indexer
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common/bitutil"
+	"github.com/ethereum/go-ethereum/core/bloombits"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	KeyPrefixBloomBits = 1
+	KeyPrefixBloomMeta = 2
+)
+
+var (
+	// KeyBloomSections is the key of the section next to the last indexed bloombits section
+	KeyBloomSections = []byte{KeyPrefixBloomMeta, 0x01}
+	// KeyBloomFirstSection is the key of the first indexed bloombits section
+	KeyBloomFirstSection = []byte{KeyPrefixBloomMeta, 0x02}
+)
+
+// BloomIndexer stores the bloombits of the block blooms by sections, so that the log filters
+// can skip the blocks not matching without fetching the block results one by one.
+// The section i covers the blocks [i*sectionSize, (i+1)*sectionSize). Only the sections with all
+// their blocks available are indexed, so the indexed sections may start after the genesis on a pruned node.
+type BloomIndexer struct {
+	db          dbm.DB
+	sectionSize uint64
+}
+
+// NewBloomIndexer creates the BloomIndexer, sectionSize must be a multiple of 8.
+func NewBloomIndexer(db dbm.DB, sectionSize uint64) *BloomIndexer {
+	return &BloomIndexer{db: db, sectionSize: sectionSize}
+}
+
+// SectionSize returns the number of blocks in a section
+func (bi *BloomIndexer) SectionSize() uint64 {
+	return bi.sectionSize
+}
+
+// Sections returns the section next to the last indexed section, 0 if no section is indexed
+func (bi *BloomIndexer) Sections() (uint64, error) {
+	return bi.getSection(KeyBloomSections)
+}
+
+// FirstSection returns the first indexed section, the indexed sections are [FirstSection, Sections)
+func (bi *BloomIndexer) FirstSection() (uint64, error) {
+	return bi.getSection(KeyBloomFirstSection)
+}
+
+func (bi *BloomIndexer) getSection(key []byte) (uint64, error) {
+	bz, err := bi.db.Get(key)
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "get section")
+	}
+	if len(bz) == 0 {
+		return 0, nil
+	}
+	return binary.BigEndian.Uint64(bz), nil
+}
+
+// IndexSection rotates the blooms of a section into bloombits and stores them,
+// the first section can be any section, the following sections must be indexed in order.
+func (bi *BloomIndexer) IndexSection(section uint64, blooms []ethtypes.Bloom) error {
+	sections, err := bi.Sections()
+	if err != nil {
+		return err
+	}
+	if sections > 0 && section != sections {
+		return fmt.Errorf("unexpected section %d, next section is %d", section, sections)
+	}
+	if uint64(len(blooms)) != bi.sectionSize {
+		return fmt.Errorf("section %d has %d blooms, expect %d", section, len(blooms), bi.sectionSize)
+	}
+
+	gen, err := bloombits.NewGenerator(uint(bi.sectionSize))
+	if err != nil {
+		return err
+	}
+	for i, bloom := range blooms {
+		if err := gen.AddBloom(uint(i), bloom); err != nil {
+			return errorsmod.Wrapf(err, "section %d", section)
+		}
+	}
+
+	batch := bi.db.NewBatch()
+	defer batch.Close()
+
+	for bit := uint(0); bit < ethtypes.BloomBitLength; bit++ {
+		bits, err := gen.Bitset(bit)
+		if err != nil {
+			return errorsmod.Wrapf(err, "section %d", section)
+		}
+		// the bitset of a bit never set compresses to nothing, store it as an empty value
+		compressed := bitutil.CompressBytes(bits)
+		if compressed == nil {
+			compressed = []byte{}
+		}
+		if err := batch.Set(BloomBitsKey(bit, section), compressed); err != nil {
+			return errorsmod.Wrapf(err, "section %d, set bloombits", section)
+		}
+	}
+
+	if sections == 0 {
+		if err := batch.Set(KeyBloomFirstSection, sdk.Uint64ToBigEndian(section)); err != nil {
+			return errorsmod.Wrapf(err, "section %d, set first section", section)
+		}
+	}
+	if err := batch.Set(KeyBloomSections, sdk.Uint64ToBigEndian(section+1)); err != nil {
+		return errorsmod.Wrapf(err, "section %d, set sections", section)
+	}
+	return batch.Write()
+}
+
+// BloomBits returns the bitset of a bloom bit in a section,
+// the n-th bit of the bitset is set if the bit is set in the bloom of the n-th block of the section.
+func (bi *BloomIndexer) BloomBits(bit uint, section uint64) ([]byte, error) {
+	key := BloomBitsKey(bit, section)
+	bz, err := bi.db.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(bz) == 0 {
+		if has, err := bi.db.Has(key); err != nil || !has {
+			return nil, fmt.Errorf("bloombits of bit %d section %d not found", bit, section)
+		}
+	}
+	return bitutil.DecompressBytes(bz, int(bi.sectionSize/8))
+}
+
+// BloomBitsKey returns the key for db entry: `(bit, section) -> compressed bitset`
+func BloomBitsKey(bit uint, section uint64) []byte {
+	key := make([]byte, 1+2+8)
+	key[0] = KeyPrefixBloomBits
+	binary.BigEndian.PutUint16(key[1:], uint16(bit))
+	binary.BigEndian.PutUint64(key[3:], section)
+	return key
+}
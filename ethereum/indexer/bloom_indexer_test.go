@@ -0,0 +1,78 @@
+package indexer_test
+
+import (
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela-rollkit/ethereum/indexer"
+)
+
+func TestBloomIndexer(t *testing.T) {
+	const sectionSize = 16
+	idxer := indexer.NewBloomIndexer(dbm.NewMemDB(), sectionSize)
+
+	sections, err := idxer.Sections()
+	require.NoError(t, err)
+	require.Zero(t, sections)
+
+	addr := common.HexToAddress("0x0000000000000000000000000000000000001234")
+	blooms := make([]ethtypes.Bloom, sectionSize)
+	blooms[3].Add(addr.Bytes())
+	blooms[9].Add(addr.Bytes())
+
+	// a section must be full
+	require.Error(t, idxer.IndexSection(0, blooms[:8]))
+
+	require.NoError(t, idxer.IndexSection(0, blooms))
+	sections, err = idxer.Sections()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), sections)
+	// sections following the first one must be indexed in order
+	require.Error(t, idxer.IndexSection(2, blooms))
+
+	// every bit set in the bloom of the address is set for the blocks 3 and 9 only
+	var expected ethtypes.Bloom
+	expected.Add(addr.Bytes())
+	for bit := uint(0); bit < ethtypes.BloomBitLength; bit++ {
+		bits, err := idxer.BloomBits(bit, 0)
+		require.NoError(t, err)
+		require.Len(t, bits, sectionSize/8)
+
+		// the bloombits are indexed from the least significant bit of the bloom
+		set := expected[ethtypes.BloomByteLength-1-bit/8]&(1<<(bit%8)) != 0
+		if set {
+			require.Equal(t, []byte{0x10, 0x40}, bits, "bit %d", bit)
+		} else {
+			require.Equal(t, []byte{0x00, 0x00}, bits, "bit %d", bit)
+		}
+	}
+
+	_, err = idxer.BloomBits(0, 1)
+	require.Error(t, err)
+}
+
+func TestBloomIndexerFirstSection(t *testing.T) {
+	const sectionSize = 16
+	idxer := indexer.NewBloomIndexer(dbm.NewMemDB(), sectionSize)
+	blooms := make([]ethtypes.Bloom, sectionSize)
+
+	// a pruned node starts from the first section with all the blocks available
+	require.NoError(t, idxer.IndexSection(3, blooms))
+	require.NoError(t, idxer.IndexSection(4, blooms))
+
+	first, err := idxer.FirstSection()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), first)
+	sections, err := idxer.Sections()
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), sections)
+
+	_, err = idxer.BloomBits(0, 2)
+	require.Error(t, err)
+	_, err = idxer.BloomBits(0, 4)
+	require.NoError(t, err)
+}
@@ -0,0 +1,218 @@
+package indexer
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	rpctypes "github.com/artela-network/artela-rollkit/ethereum/rpc/types"
+	"github.com/artela-network/artela-rollkit/ethereum/types"
+	evmtypes "github.com/artela-network/artela-rollkit/x/evm/types"
+)
+
+const (
+	KeyPrefixTxHash  = 1
+	KeyPrefixTxIndex = 2
+	KeyPrefixMeta    = 3
+
+	// TxIndexKeyLength is the length of tx-index key
+	TxIndexKeyLength = 1 + 8 + 8
+)
+
+// KeyLastIndexedBlock is the key of the last indexed block number,
+// blocks without any eth txs are indexed too, so it can't be derived from the tx-index keys.
+var KeyLastIndexedBlock = []byte{KeyPrefixMeta, 0x01}
+
+var _ types.EVMTxIndexer = &KVIndexer{}
+
+// KVIndexer implements an eth txs indexer on a KV db.
+type KVIndexer struct {
+	db        dbm.DB
+	logger    log.Logger
+	clientCtx client.Context
+}
+
+// NewKVIndexer creates the KVIndexer
+func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVIndexer {
+	return &KVIndexer{db, logger, clientCtx}
+}
+
+// IndexBlock indexes all the eth txs in a block through the following steps:
+// - Iterates over all the txs in block
+// - Parses eth txs infos from cosmos-sdk events for every tx result
+// - Iterates over all the messages of the tx
+// - Builds and stores a TxResult based on parsed events for every eth message
+func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.ExecTxResult) error {
+	height := block.Header.Height
+	if len(txResults) != len(block.Txs) {
+		return fmt.Errorf("IndexBlock %d, %d tx results for %d txs", height, len(txResults), len(block.Txs))
+	}
+
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+
+	// record index of valid eth txs during the iteration,
+	// it's consistent with the txs list returned by eth_getBlock api
+	var ethTxIndex int32
+	for txIndex, txBz := range block.Txs {
+		result := txResults[txIndex]
+		if !rpctypes.TxSuccessOrExceedsBlockGasLimit(result) {
+			continue
+		}
+
+		tx, err := kv.clientCtx.TxConfig.TxDecoder()(txBz)
+		if err != nil {
+			kv.logger.Error("failed to decode tx", "err", err, "block", height, "txIndex", txIndex)
+			continue
+		}
+
+		if !hasEthMsg(tx) {
+			continue
+		}
+
+		txs, err := rpctypes.ParseTxResult(result, tx)
+		if err != nil {
+			kv.logger.Error("failed to parse event", "err", err, "block", height, "txIndex", txIndex)
+			continue
+		}
+
+		var cumulativeGasUsed uint64
+		for msgIndex, msg := range tx.GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				continue
+			}
+			txHash := ethMsg.AsTransaction().Hash()
+
+			txResult := types.TxResult{
+				Height:     height,
+				TxIndex:    uint32(txIndex),  // #nosec G701
+				MsgIndex:   uint32(msgIndex), // #nosec G701
+				EthTxIndex: ethTxIndex,
+			}
+			if result.Code != abci.CodeTypeOK {
+				// exceeds block gas limit scenario, set gas used to gas limit because that's what's charged by ante handler.
+				// some old versions don't emit any events, so workaround here directly.
+				txResult.GasUsed = ethMsg.GetGas()
+				txResult.Failed = true
+			} else {
+				parsedTx := txs.GetTxByHash(txHash)
+				if parsedTx == nil {
+					kv.logger.Error("msg not found in events", "block", height, "txIndex", txIndex, "hash", txHash.Hex())
+					continue
+				}
+				if parsedTx.EthTxIndex >= 0 && parsedTx.EthTxIndex != ethTxIndex {
+					kv.logger.Error("eth tx index don't match", "expect", ethTxIndex, "found", parsedTx.EthTxIndex)
+				}
+				txResult.GasUsed = parsedTx.GasUsed
+				txResult.Failed = parsedTx.Failed
+				txResult.Sender = parsedTx.From.Hex()
+			}
+
+			cumulativeGasUsed += txResult.GasUsed
+			txResult.CumulativeGasUsed = cumulativeGasUsed
+			ethTxIndex++
+
+			if err := saveTxResult(kv.clientCtx.Codec, batch, txHash, &txResult); err != nil {
+				return errorsmod.Wrapf(err, "IndexBlock %d", height)
+			}
+		}
+	}
+
+	// only move the last indexed block forward, so re-indexing an older range doesn't rewind it
+	lastBlock, err := kv.LastIndexedBlock()
+	if err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
+	if height > lastBlock {
+		if err := batch.Set(KeyLastIndexedBlock, sdk.Uint64ToBigEndian(uint64(height))); err != nil { // #nosec G701
+			return errorsmod.Wrapf(err, "IndexBlock %d, set last indexed block", height)
+		}
+	}
+
+	if err := batch.Write(); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", height)
+	}
+	return nil
+}
+
+// LastIndexedBlock returns the latest indexed block number, returns -1 if db is empty
+func (kv *KVIndexer) LastIndexedBlock() (int64, error) {
+	bz, err := kv.db.Get(KeyLastIndexedBlock)
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "LastIndexedBlock")
+	}
+	if len(bz) == 0 {
+		return -1, nil
+	}
+	return int64(sdk.BigEndianToUint64(bz)), nil // #nosec G701
+}
+
+// GetByTxHash finds eth txs by eth txs hash, returns nil if txs not found.
+func (kv *KVIndexer) GetByTxHash(hash common.Hash) (*types.TxResult, error) {
+	bz, err := kv.db.Get(TxHashKey(hash))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByTxHash %s", hash.Hex())
+	}
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var txResult types.TxResult
+	if err := kv.clientCtx.Codec.Unmarshal(bz, &txResult); err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByTxHash %s", hash.Hex())
+	}
+	return &txResult, nil
+}
+
+// GetByBlockAndIndex finds eth txs by block number and eth txs index, returns nil if txs not found.
+func (kv *KVIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*types.TxResult, error) {
+	bz, err := kv.db.Get(TxIndexKey(blockNumber, txIndex))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetByBlockAndIndex %d %d", blockNumber, txIndex)
+	}
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	return kv.GetByTxHash(common.BytesToHash(bz))
+}
+
+// TxHashKey returns the key for db entry: `tx hash -> tx result struct`
+func TxHashKey(hash common.Hash) []byte {
+	return append([]byte{KeyPrefixTxHash}, hash.Bytes()...)
+}
+
+// TxIndexKey returns the key for db entry: `(block number, tx index) -> tx hash`
+func TxIndexKey(blockNumber int64, txIndex int32) []byte {
+	bz1 := sdk.Uint64ToBigEndian(uint64(blockNumber)) // #nosec G701
+	bz2 := sdk.Uint64ToBigEndian(uint64(txIndex))     // #nosec G701
+	return append(append([]byte{KeyPrefixTxIndex}, bz1...), bz2...)
+}
+
+// hasEthMsg returns whether the tx contains any eth message
+func hasEthMsg(tx sdk.Tx) bool {
+	for _, msg := range tx.GetMsgs() {
+		if _, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func saveTxResult(codec codec.Codec, batch dbm.Batch, txHash common.Hash, txResult *types.TxResult) error {
+	bz := codec.MustMarshal(txResult)
+	if err := batch.Set(TxHashKey(txHash), bz); err != nil {
+		return errorsmod.Wrap(err, "set tx-hash key")
+	}
+	if err := batch.Set(TxIndexKey(txResult.Height, txResult.EthTxIndex), txHash.Bytes()); err != nil {
+		return errorsmod.Wrap(err, "set tx-index key")
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package indexer_test
+
+import (
+	"math/big"
+	"strconv"
+	"testing"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela-rollkit/ethereum/indexer"
+	evmtypes "github.com/artela-network/artela-rollkit/x/evm/types"
+)
+
+func newTestIndexer() *indexer.KVIndexer {
+	return indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), newTestClientCtx())
+}
+
+func newTestClientCtx() client.Context {
+	registry := codectypes.NewInterfaceRegistry()
+	evmtypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+	return client.Context{}.
+		WithCodec(cdc).
+		WithTxConfig(authtx.NewTxConfig(cdc, authtx.DefaultSignModes))
+}
+
+// newEthTx returns an encoded cosmos tx wrapping an eth transfer from the given sender,
+// together with the hash of the eth tx.
+func newEthTx(t *testing.T, clientCtx client.Context, from common.Address, nonce uint64) ([]byte, common.Hash) {
+	to := common.HexToAddress("0x00000000000000000000000000000000000000b1")
+	ethTx := ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: nonce, To: &to, Gas: 21000, GasPrice: big.NewInt(1), Value: big.NewInt(1)})
+
+	msg := &evmtypes.MsgEthereumTx{}
+	require.NoError(t, msg.FromEthereumTx(ethTx))
+	msg.From = from.Hex()
+
+	tx, err := msg.BuildTx(clientCtx.TxConfig.NewTxBuilder(), "aart")
+	require.NoError(t, err)
+	bz, err := clientCtx.TxConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+	return bz, ethTx.Hash()
+}
+
+// newEthTxResult returns the result of an eth tx with the events emitted by the evm module.
+func newEthTxResult(from common.Address, hash common.Hash, ethTxIndex int, gasUsed int64) *abci.ExecTxResult {
+	return &abci.ExecTxResult{
+		Code:    abci.CodeTypeOK,
+		GasUsed: gasUsed,
+		Events: []abci.Event{
+			{
+				Type: evmtypes.EventTypeEthereumTx,
+				Attributes: []abci.EventAttribute{
+					{Key: evmtypes.AttributeKeyEthereumTxHash, Value: hash.Hex()},
+					{Key: evmtypes.AttributeKeyTxIndex, Value: strconv.Itoa(ethTxIndex)},
+					{Key: evmtypes.AttributeKeyTxGasUsed, Value: strconv.FormatInt(gasUsed, 10)},
+				},
+			},
+			{
+				Type: sdk.EventTypeMessage,
+				Attributes: []abci.EventAttribute{
+					{Key: sdk.AttributeKeyModule, Value: evmtypes.ModuleName},
+					{Key: sdk.AttributeKeySender, Value: from.Hex()},
+				},
+			},
+		},
+	}
+}
+
+func TestKVIndexerIndexBlock(t *testing.T) {
+	clientCtx := newTestClientCtx()
+	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), clientCtx)
+	from := common.HexToAddress("0x00000000000000000000000000000000000000a1")
+
+	tx0, hash0 := newEthTx(t, clientCtx, from, 0)
+	tx1, hash1 := newEthTx(t, clientCtx, from, 1)
+	block := &tmtypes.Block{
+		Header: tmtypes.Header{Height: 10},
+		// the non eth tx in the middle doesn't take an eth tx index
+		Data: tmtypes.Data{Txs: tmtypes.Txs{tx0, []byte("not a tx"), tx1}},
+	}
+	txResults := []*abci.ExecTxResult{
+		newEthTxResult(from, hash0, 0, 21000),
+		{Code: abci.CodeTypeOK},
+		newEthTxResult(from, hash1, 1, 30000),
+	}
+	require.NoError(t, idxer.IndexBlock(block, txResults))
+
+	res, err := idxer.GetByTxHash(hash1)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, int64(10), res.Height)
+	require.Equal(t, uint32(2), res.TxIndex)
+	require.Equal(t, uint32(0), res.MsgIndex)
+	require.Equal(t, int32(1), res.EthTxIndex)
+	require.Equal(t, uint64(30000), res.GasUsed)
+	require.Equal(t, uint64(30000), res.CumulativeGasUsed)
+	require.Equal(t, from.Hex(), res.Sender)
+	require.False(t, res.Failed)
+
+	byIndex, err := idxer.GetByBlockAndIndex(10, 1)
+	require.NoError(t, err)
+	require.Equal(t, res, byIndex)
+
+	byIndex, err = idxer.GetByBlockAndIndex(10, 0)
+	require.NoError(t, err)
+	require.NotNil(t, byIndex)
+	require.Equal(t, uint32(0), byIndex.TxIndex)
+	require.Equal(t, uint64(21000), byIndex.GasUsed)
+
+	// the results must match the txs of the block
+	require.Error(t, idxer.IndexBlock(block, txResults[:2]))
+}
+
+func TestKVIndexerLastIndexedBlock(t *testing.T) {
+	idxer := newTestIndexer()
+
+	last, err := idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), last)
+
+	// blocks without eth txs are recorded as indexed too
+	block := &tmtypes.Block{Header: tmtypes.Header{Height: 5}}
+	require.NoError(t, idxer.IndexBlock(block, nil))
+	last, err = idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(5), last)
+
+	// reindexing an older block doesn't rewind the last indexed block
+	block = &tmtypes.Block{
+		Header: tmtypes.Header{Height: 3},
+		Data:   tmtypes.Data{Txs: tmtypes.Txs{[]byte("not a tx")}},
+	}
+	require.NoError(t, idxer.IndexBlock(block, []*abci.ExecTxResult{{Code: abci.CodeTypeOK}}))
+	last, err = idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(5), last)
+}
+
+func TestKVIndexerNotFound(t *testing.T) {
+	idxer := newTestIndexer()
+
+	res, err := idxer.GetByTxHash(common.HexToHash("0x01"))
+	require.NoError(t, err)
+	require.Nil(t, res)
+
+	res, err = idxer.GetByBlockAndIndex(1, 0)
+	require.NoError(t, err)
+	require.Nil(t, res)
+}
+
+func TestTxIndexKey(t *testing.T) {
+	key := indexer.TxIndexKey(1, 2)
+	require.Len(t, key, indexer.TxIndexKeyLength)
+	require.Equal(t, byte(indexer.KeyPrefixTxIndex), key[0])
+	// keys are ordered by block number first
+	require.Less(t, string(indexer.TxIndexKey(1, 100)), string(indexer.TxIndexKey(2, 0)))
+}
@@ -0,0 +1,114 @@
+package api
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	rpctypes "github.com/artela-network/artela-rollkit/ethereum/rpc/types"
+	aspecttypes "github.com/artela-network/artela-rollkit/x/aspect/types"
+)
+
+// AspectAPI provides an API to access the deployed aspects and their bindings.
+// The version and the block are optional, default to the latest version and the latest block.
+type AspectAPI struct {
+	b      rpctypes.AspectBackend
+	logger log.Logger
+}
+
+// NewAspectAPI creates a new aspect API instance.
+func NewAspectAPI(b rpctypes.AspectBackend, logger log.Logger) *AspectAPI {
+	return &AspectAPI{b, logger}
+}
+
+// GetCode returns the code of the aspect.
+func (s *AspectAPI) GetCode(_ context.Context, aspectID common.Address, version *hexutil.Uint64, blockNrOrHash *rpc.BlockNumberOrHash) (hexutil.Bytes, error) {
+	res, err := s.b.GetAspectCode(aspectID, versionOrLatest(version), blockOrLatest(blockNrOrHash))
+	if err != nil {
+		return nil, err
+	}
+	return res.Code, nil
+}
+
+// GetVersion returns the latest version of the aspect.
+func (s *AspectAPI) GetVersion(_ context.Context, aspectID common.Address, blockNrOrHash *rpc.BlockNumberOrHash) (hexutil.Uint64, error) {
+	res, err := s.b.GetAspect(aspectID, blockOrLatest(blockNrOrHash))
+	if err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(res.Aspect.LatestVersion), nil
+}
+
+// GetProperties returns the properties of the aspect.
+func (s *AspectAPI) GetProperties(_ context.Context, aspectID common.Address, version *hexutil.Uint64, blockNrOrHash *rpc.BlockNumberOrHash) (map[string]hexutil.Bytes, error) {
+	res, err := s.b.GetAspectProperties(aspectID, versionOrLatest(version), blockOrLatest(blockNrOrHash))
+	if err != nil {
+		return nil, err
+	}
+
+	properties := make(map[string]hexutil.Bytes, len(res.Properties))
+	for _, property := range res.Properties {
+		properties[property.Key] = property.Value
+	}
+	return properties, nil
+}
+
+// GetState returns the value of the aspect state at the given key.
+func (s *AspectAPI) GetState(_ context.Context, aspectID common.Address, key string, blockNrOrHash *rpc.BlockNumberOrHash) (hexutil.Bytes, error) {
+	return s.b.GetAspectState(aspectID, key, blockOrLatest(blockNrOrHash))
+}
+
+// GetBindings returns the aspects bound to the account.
+func (s *AspectAPI) GetBindings(_ context.Context, account common.Address, blockNrOrHash *rpc.BlockNumberOrHash) ([]*rpctypes.AspectBinding, error) {
+	bindings, err := s.b.GetAccountBindings(account, blockOrLatest(blockNrOrHash))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*rpctypes.AspectBinding, 0, len(bindings))
+	for _, binding := range bindings {
+		result = append(result, newRPCAspectBinding(common.HexToAddress(binding.Address), account, binding))
+	}
+	return result, nil
+}
+
+// GetBoundAccounts returns the accounts bound to the aspect.
+func (s *AspectAPI) GetBoundAccounts(_ context.Context, aspectID common.Address, blockNrOrHash *rpc.BlockNumberOrHash) ([]*rpctypes.AspectBinding, error) {
+	bindings, err := s.b.GetAspectBoundAccounts(aspectID, blockOrLatest(blockNrOrHash))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*rpctypes.AspectBinding, 0, len(bindings))
+	for _, binding := range bindings {
+		result = append(result, newRPCAspectBinding(aspectID, common.HexToAddress(binding.Address), binding))
+	}
+	return result, nil
+}
+
+func newRPCAspectBinding(aspectID, account common.Address, binding aspecttypes.AspectBinding) *rpctypes.AspectBinding {
+	return &rpctypes.AspectBinding{
+		Aspect:    aspectID,
+		Account:   account,
+		Version:   hexutil.Uint64(binding.Version),
+		Priority:  binding.Priority,
+		JoinPoint: hexutil.Uint64(binding.JoinPoint),
+	}
+}
+
+func versionOrLatest(version *hexutil.Uint64) uint64 {
+	if version == nil {
+		return 0
+	}
+	return uint64(*version)
+}
+
+func blockOrLatest(blockNrOrHash *rpc.BlockNumberOrHash) rpc.BlockNumberOrHash {
+	if blockNrOrHash == nil {
+		return rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+	}
+	return *blockNrOrHash
+}
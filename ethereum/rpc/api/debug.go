@@ -232,6 +232,25 @@ func (a *DebugAPI) TraceBlockByHash(hash common.Hash, config evmtypes.TraceConfi
 	return a.b.TraceBlock(rpc.BlockNumber(resBlock.Block.Height), &config, resBlock)
 }
 
+// TraceBlock returns the structured logs created during the execution of EVM
+// over the RLP-encoded block, on top of the state of its parent block.
+func (a *DebugAPI) TraceBlock(blob hexutil.Bytes, config evmtypes.TraceConfig) ([]*evmtxs.TxTraceResult, error) {
+	block := new(types.Block)
+	if err := rlp.DecodeBytes(blob, block); err != nil {
+		return nil, fmt.Errorf("could not decode block: %w", err)
+	}
+	a.logger.Debug("debug_traceBlock", "number", block.Number(), "hash", block.Hash())
+	return a.b.TraceEthBlock(block, &config)
+}
+
+// TraceCall lets you trace a given eth_call. It collects the structured logs
+// created during the execution of EVM if the given transaction was added on
+// top of the provided block and returns them as a JSON object.
+func (a *DebugAPI) TraceCall(args rpctypes.TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash, config *rpctypes.TraceCallConfig) (interface{}, error) {
+	a.logger.Debug("debug_traceCall", "block number or hash", blockNrOrHash)
+	return a.b.TraceCall(args, blockNrOrHash, config)
+}
+
 // BlockProfile turns on goroutine profiling for nsec seconds and writes profile data to
 // file. It uses a profile rate of 1 for most accurate information. If a different rate is
 // desired, set the rate and write the profile manually.
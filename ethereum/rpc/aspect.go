@@ -0,0 +1,122 @@
+package rpc
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	rpctypes "github.com/artela-network/artela-rollkit/ethereum/rpc/types"
+	aspecttypes "github.com/artela-network/artela-rollkit/x/aspect/types"
+)
+
+// GetAspect returns the metadata and the versions of an aspect.
+func (b *BackendImpl) GetAspect(aspectID common.Address, blockNrOrHash rpc.BlockNumberOrHash) (*aspecttypes.QueryAspectResponse, error) {
+	blockNum, err := b.blockNumberFromCosmos(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &aspecttypes.QueryAspectRequest{
+		AspectId: aspectID.Hex(),
+	}
+	return b.queryClient.Aspect.Aspect(rpctypes.ContextWithHeight(blockNum.Int64()), req)
+}
+
+// GetAspectCode returns the code of the given version of an aspect.
+func (b *BackendImpl) GetAspectCode(aspectID common.Address, version uint64, blockNrOrHash rpc.BlockNumberOrHash) (*aspecttypes.QueryAspectCodeResponse, error) {
+	blockNum, err := b.blockNumberFromCosmos(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &aspecttypes.QueryAspectCodeRequest{
+		AspectId: aspectID.Hex(),
+		Version:  version,
+	}
+	return b.queryClient.Aspect.AspectCode(rpctypes.ContextWithHeight(blockNum.Int64()), req)
+}
+
+// GetAspectProperties returns the properties of the given version of an aspect.
+func (b *BackendImpl) GetAspectProperties(aspectID common.Address, version uint64, blockNrOrHash rpc.BlockNumberOrHash) (*aspecttypes.QueryAspectPropertiesResponse, error) {
+	blockNum, err := b.blockNumberFromCosmos(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &aspecttypes.QueryAspectPropertiesRequest{
+		AspectId: aspectID.Hex(),
+		Version:  version,
+	}
+	return b.queryClient.Aspect.AspectProperties(rpctypes.ContextWithHeight(blockNum.Int64()), req)
+}
+
+// GetAspectState returns the state value of an aspect at the given key.
+func (b *BackendImpl) GetAspectState(aspectID common.Address, key string, blockNrOrHash rpc.BlockNumberOrHash) (hexutil.Bytes, error) {
+	blockNum, err := b.blockNumberFromCosmos(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &aspecttypes.QueryAspectStateRequest{
+		AspectId: aspectID.Hex(),
+		Key:      key,
+	}
+	res, err := b.queryClient.Aspect.AspectState(rpctypes.ContextWithHeight(blockNum.Int64()), req)
+	if err != nil {
+		return nil, err
+	}
+	return res.Value, nil
+}
+
+// GetAspectBoundAccounts returns all the accounts bound to an aspect.
+func (b *BackendImpl) GetAspectBoundAccounts(aspectID common.Address, blockNrOrHash rpc.BlockNumberOrHash) ([]aspecttypes.AspectBinding, error) {
+	blockNum, err := b.blockNumberFromCosmos(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := rpctypes.ContextWithHeight(blockNum.Int64())
+	bindings := make([]aspecttypes.AspectBinding, 0)
+	pageReq := &query.PageRequest{}
+	for {
+		res, err := b.queryClient.Aspect.AspectBindings(ctx, &aspecttypes.QueryAspectBindingsRequest{
+			AspectId:   aspectID.Hex(),
+			Pagination: pageReq,
+		})
+		if err != nil {
+			return nil, err
+		}
+		bindings = append(bindings, res.Bindings...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return bindings, nil
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+}
+
+// GetAccountBindings returns all the aspects bound to an account.
+func (b *BackendImpl) GetAccountBindings(account common.Address, blockNrOrHash rpc.BlockNumberOrHash) ([]aspecttypes.AspectBinding, error) {
+	blockNum, err := b.blockNumberFromCosmos(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := rpctypes.ContextWithHeight(blockNum.Int64())
+	bindings := make([]aspecttypes.AspectBinding, 0)
+	pageReq := &query.PageRequest{}
+	for {
+		res, err := b.queryClient.Aspect.AccountBindings(ctx, &aspecttypes.QueryAccountBindingsRequest{
+			Account:    account.Hex(),
+			Pagination: pageReq,
+		})
+		if err != nil {
+			return nil, err
+		}
+		bindings = append(bindings, res.Bindings...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return bindings, nil
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+}
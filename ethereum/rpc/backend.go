@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math/big"
 	"strconv"
+	"sync"
 	"time"
 
 	sdkmath "cosmossdk.io/math"
@@ -26,6 +27,7 @@ import (
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 
+	ethindexer "github.com/artela-network/artela-rollkit/ethereum/indexer"
 	"github.com/artela-network/artela-rollkit/ethereum/rpc/filters"
 	rpctypes "github.com/artela-network/artela-rollkit/ethereum/rpc/types"
 	"github.com/artela-network/artela-rollkit/ethereum/server/config"
@@ -72,7 +74,15 @@ type BackendImpl struct {
 	clientCtx   client.Context
 	queryClient *rpctypes.QueryClient
 
-	db db.DB
+	db      db.DB
+	indexer ethereumtypes.EVMTxIndexer
+
+	bloomIndexer  *ethindexer.BloomIndexer
+	bloomRequests chan chan *bloombits.Retrieval
+
+	pendingMu        sync.Mutex
+	pending          *pendingState
+	pendingLogsScope event.SubscriptionScope
 }
 
 // NewBackend create the backend implements
@@ -84,6 +94,8 @@ func NewBackend(
 	cfg *Config,
 	logger log.Logger,
 	db db.DB,
+	indexer ethereumtypes.EVMTxIndexer,
+	bloomIndexer *ethindexer.BloomIndexer,
 ) *BackendImpl {
 	b := &BackendImpl{
 		ctx:           context.Background(),
@@ -94,8 +106,12 @@ func NewBackend(
 		clientCtx:     clientCtx,
 		queryClient:   rpctypes.NewQueryClient(clientCtx),
 
-		scope: event.SubscriptionScope{},
-		db:    db,
+		scope:   event.SubscriptionScope{},
+		db:      db,
+		indexer: indexer,
+
+		bloomIndexer:  bloomIndexer,
+		bloomRequests: make(chan chan *bloombits.Retrieval),
 	}
 
 	var err error
@@ -113,6 +129,11 @@ func NewBackend(
 		panic("cfg.GPO.Default is nil")
 	}
 	b.gpo = gasprice.NewOracle(b, *cfg.GPO)
+
+	if bloomIndexer != nil {
+		b.startBloomHandlers()
+	}
+	go b.pendingLoop()
 	return b
 }
 
@@ -182,6 +203,14 @@ func (b *BackendImpl) GetBalance(address common.Address, blockNrOrHash rpc.Block
 }
 
 func (b *BackendImpl) ArtBlockByNumber(ctx context.Context, number rpc.BlockNumber) (*rpctypes.Block, error) {
+	if number == rpc.PendingBlockNumber {
+		state, err := b.pendingState()
+		if err != nil {
+			return nil, fmt.Errorf("build pending block failed, %w", err)
+		}
+		return state.block, nil
+	}
+
 	resBlock, err := b.CosmosBlockByNumber(number)
 	if err != nil || resBlock == nil {
 		return nil, fmt.Errorf("query block failed, block number %d, %w", number, err)
@@ -292,17 +321,45 @@ func (b *BackendImpl) SubscribeLogsEvent(ch chan<- []*ethtypes.Log) event.Subscr
 }
 
 func (b *BackendImpl) SubscribePendingLogsEvent(ch chan<- []*ethtypes.Log) event.Subscription {
-	return b.scope.Track(b.pendingLogsFeed.Subscribe(ch))
+	return b.pendingLogsScope.Track(b.pendingLogsFeed.Subscribe(ch))
 }
 
-func (b *BackendImpl) BloomStatus() (uint64, uint64) {
-	return 0, 0
+// BloomStatus returns the section size of the bloombits and the range [first, sections) of the indexed sections
+func (b *BackendImpl) BloomStatus() (uint64, uint64, uint64) {
+	if b.bloomIndexer == nil {
+		return 0, 0, 0
+	}
+	first, err := b.bloomIndexer.FirstSection()
+	if err != nil {
+		b.logger.Error("failed to get first bloom section", "error", err)
+		return 0, 0, 0
+	}
+	sections, err := b.bloomIndexer.Sections()
+	if err != nil {
+		b.logger.Error("failed to get bloom sections", "error", err)
+		return 0, 0, 0
+	}
+	return b.bloomIndexer.SectionSize(), first, sections
 }
 
-func (b *BackendImpl) ServiceFilter(_ context.Context, _ *bloombits.MatcherSession) {
+// ServiceFilter multiplexes the bloombits retrievals of the matcher session to the bloom handlers
+func (b *BackendImpl) ServiceFilter(_ context.Context, session *bloombits.MatcherSession) {
+	for i := 0; i < bloomFilterThreads; i++ {
+		go session.Multiplex(bloomRetrievalBatch, bloomRetrievalWait, b.bloomRequests)
+	}
 }
 
 func (b *BackendImpl) BaseFee(blockRes *tmrpctypes.ResultBlockResults) (*big.Int, error) {
+	// the fee history kept in the fee module is queried from the latest state, so it is
+	// still available when the historical states and block results are pruned
+	historyRes, err := b.queryClient.FeeMarket.BaseFeeAtHeight(b.ctx, &feetypes.QueryBaseFeeAtHeightRequest{Height: blockRes.Height})
+	if err == nil {
+		if historyRes.BaseFee == nil {
+			return nil, nil
+		}
+		return historyRes.BaseFee.BigInt(), nil
+	}
+
 	// return BaseFee if London hard fork is activated and feemarket is enabled
 	res, err := b.queryClient.BaseFee(rpctypes.ContextWithHeight(blockRes.Height), &evmtypes.QueryBaseFeeRequest{})
 	if err != nil || res.BaseFee == nil {
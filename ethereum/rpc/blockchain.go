@@ -2,7 +2,6 @@ package rpc
 
 import (
 	"context"
-	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -125,7 +124,7 @@ func (b *BackendImpl) GetProof(address common.Address, storageKeys []string, blo
 	}, nil
 }
 
-func (b *BackendImpl) DoCall(args rpctypes.TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash) (*evmtypes.MsgEthereumTxResponse, error) {
+func (b *BackendImpl) DoCall(args rpctypes.TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash, overrides *rpctypes.StateOverride, blockOverrides *rpctypes.BlockOverrides) (*evmtypes.MsgEthereumTxResponse, error) {
 	blockNum, err := b.blockNumberFromCosmos(blockNrOrHash)
 	if err != nil {
 		return nil, err
@@ -135,6 +134,14 @@ func (b *BackendImpl) DoCall(args rpctypes.TransactionArgs, blockNrOrHash rpc.Bl
 	if err != nil {
 		return nil, err
 	}
+	overridesBz, err := marshalOverrides(overrides)
+	if err != nil {
+		return nil, err
+	}
+	blockOverridesBz, err := marshalOverrides(blockOverrides)
+	if err != nil {
+		return nil, err
+	}
 	header, err := b.CosmosBlockByNumber(blockNum)
 	if err != nil {
 		// the error message imitates geth behavior
@@ -146,6 +153,17 @@ func (b *BackendImpl) DoCall(args rpctypes.TransactionArgs, blockNrOrHash rpc.Bl
 		GasCap:          b.RPCGasCap(),
 		ProposerAddress: sdktypes.ConsAddress(header.Block.ProposerAddress),
 		ChainId:         b.chainID.Int64(),
+		Overrides:       overridesBz,
+		BlockOverrides:  blockOverridesBz,
+	}
+
+	// the call at pending is applied on top of the latest state with the pending txs
+	if blockNum == rpc.PendingBlockNumber {
+		state, err := b.pendingState()
+		if err != nil {
+			return nil, err
+		}
+		req.PendingTxs = state.msgs
 	}
 
 	// From ContextWithHeight: if the provided height is 0,
@@ -182,7 +200,7 @@ func (b *BackendImpl) DoCall(args rpctypes.TransactionArgs, blockNrOrHash rpc.Bl
 	return res, nil
 }
 
-func (b *BackendImpl) EstimateGas(ctx context.Context, args rpctypes.TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash) (hexutil.Uint64, error) {
+func (b *BackendImpl) EstimateGas(ctx context.Context, args rpctypes.TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash, overrides *rpctypes.StateOverride) (hexutil.Uint64, error) {
 	blockNum := rpc.LatestBlockNumber
 	if blockNrOrHash != nil {
 		blockNum, _ = b.blockNumberFromCosmos(*blockNrOrHash)
@@ -192,6 +210,10 @@ func (b *BackendImpl) EstimateGas(ctx context.Context, args rpctypes.Transaction
 	if err != nil {
 		return 0, err
 	}
+	overridesBz, err := marshalOverrides(overrides)
+	if err != nil {
+		return 0, err
+	}
 
 	header, err := b.CosmosBlockByNumber(blockNum)
 	if err != nil {
@@ -204,6 +226,7 @@ func (b *BackendImpl) EstimateGas(ctx context.Context, args rpctypes.Transaction
 		GasCap:          b.RPCGasCap(),
 		ProposerAddress: sdktypes.ConsAddress(header.Block.ProposerAddress),
 		ChainId:         b.chainID.Int64(),
+		Overrides:       overridesBz,
 	}
 
 	// From ContextWithHeight: if the provided height is 0,
@@ -216,6 +239,61 @@ func (b *BackendImpl) EstimateGas(ctx context.Context, args rpctypes.Transaction
 	return hexutil.Uint64(res.Gas), nil
 }
 
+func (b *BackendImpl) CreateAccessList(args rpctypes.TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash) (*evmtypes.CreateAccessListResponse, error) {
+	blockNum := rpc.LatestBlockNumber
+	if blockNrOrHash != nil {
+		var err error
+		if blockNum, err = b.blockNumberFromCosmos(*blockNrOrHash); err != nil {
+			return nil, err
+		}
+	}
+
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := b.CosmosBlockByNumber(blockNum)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	req := evmtypes.EthCallRequest{
+		Args:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdktypes.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+	}
+
+	// From ContextWithHeight: if the provided height is 0,
+	// it will return an empty context and the gRPC query will use
+	// the latest block height for querying.
+	ctx := rpctypes.ContextWithHeight(blockNum.Int64())
+	timeout := b.RPCEVMTimeout()
+
+	// Setup context so it may be canceled the call has completed
+	// or, in case of unmetered gas, setup a context with a timeout.
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	return b.queryClient.CreateAccessList(ctx, &req)
+}
+
+// marshalOverrides encodes the call overrides with the same json format as the json rpc api,
+// nil overrides are encoded as empty bytes.
+func marshalOverrides[T rpctypes.StateOverride | rpctypes.BlockOverrides](overrides *T) ([]byte, error) {
+	if overrides == nil {
+		return nil, nil
+	}
+	return json.Marshal(overrides)
+}
+
 func (b *BackendImpl) HeaderByNumber(_ context.Context, number rpc.BlockNumber) (*ethtypes.Header, error) {
 	resBlock, err := b.CosmosBlockByNumber(number)
 	if err != nil {
@@ -473,23 +551,7 @@ func (b *BackendImpl) CosmosBlockResultByNumber(height *int64) (*tmrpctypes.Resu
 
 // BlockBloom query block bloom filter from block results
 func (b *BackendImpl) blockBloom(blockRes *tmrpctypes.ResultBlockResults) (ethtypes.Bloom, error) {
-	for _, event := range blockRes.FinalizeBlockEvents {
-		if event.Type != evmtypes.EventTypeBlockBloom {
-			continue
-		}
-
-		for _, attr := range event.Attributes {
-			if attr.Key == evmtypes.AttributeKeyEthereumBloom {
-				encodedBloom, err := base64.StdEncoding.DecodeString(attr.Value)
-				if err != nil {
-					return ethtypes.Bloom{}, err
-				}
-
-				return ethtypes.BytesToBloom(encodedBloom), nil
-			}
-		}
-	}
-	return ethtypes.Bloom{}, errors.New("block bloom event is not found")
+	return utils.BlockBloom(blockRes)
 }
 
 func (b *BackendImpl) BlockFromCosmosBlock(resBlock *tmrpctypes.ResultBlock, blockRes *tmrpctypes.ResultBlockResults) (*rpctypes.Block, error) {
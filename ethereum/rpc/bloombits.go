@@ -0,0 +1,47 @@
+package rpc
+
+// Derived from https://github.com/ethereum/go-ethereum/blob/v1.12.0/eth/bloombits.go
+
+import (
+	"time"
+)
+
+const (
+	// bloomServiceThreads is the number of goroutines used globally by an Ethereum
+	// instance to service bloombits lookups for all running filters.
+	bloomServiceThreads = 16
+
+	// bloomFilterThreads is the number of goroutines used locally per filter to
+	// multiplex requests onto the global servicing goroutines.
+	bloomFilterThreads = 3
+
+	// bloomRetrievalBatch is the maximum number of bloom bit retrievals to service
+	// in a single batch.
+	bloomRetrievalBatch = 16
+
+	// bloomRetrievalWait is the maximum time to wait for enough bloom bit requests
+	// to accumulate request an entire batch (avoiding hysteresis).
+	bloomRetrievalWait = time.Duration(0)
+)
+
+// startBloomHandlers starts a batch of goroutines to accept bloom bit database
+// retrievals from possibly a range of filters and serving the data to satisfy.
+func (b *BackendImpl) startBloomHandlers() {
+	for i := 0; i < bloomServiceThreads; i++ {
+		go func() {
+			for request := range b.bloomRequests {
+				task := <-request
+				task.Bitsets = make([][]byte, len(task.Sections))
+				for i, section := range task.Sections {
+					blob, err := b.bloomIndexer.BloomBits(task.Bit, section)
+					if err != nil {
+						task.Error = err
+						continue
+					}
+					task.Bitsets[i] = blob
+				}
+				request <- task
+			}
+		}()
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"math"
 	"math/big"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -216,6 +217,122 @@ func (b *BackendImpl) TraceBlock(height rpc.BlockNumber,
 	return decodedResults, nil
 }
 
+// TraceEthBlock executes all the transactions of the given ethereum block on top of the state
+// of its parent block, and returns the traces of the transactions. The block is not required
+// to be included in the chain.
+func (b *BackendImpl) TraceEthBlock(block *ethtypes.Block, config *types.TraceConfig) ([]*evmtxs.TxTraceResult, error) {
+	height := block.Number().Int64()
+	if height < 1 {
+		return nil, errors.New("genesis is not traceable")
+	}
+
+	txsMessages := make([]*types.MsgEthereumTx, 0, len(block.Transactions()))
+	for _, tx := range block.Transactions() {
+		ethMessage := &types.MsgEthereumTx{}
+		if err := ethMessage.FromEthereumTx(tx); err != nil {
+			return nil, fmt.Errorf("invalid transaction %s: %w", tx.Hash().Hex(), err)
+		}
+		txsMessages = append(txsMessages, ethMessage)
+	}
+	if len(txsMessages) == 0 {
+		return []*evmtxs.TxTraceResult{}, nil
+	}
+
+	// the block is executed on top of its parent, which must be on chain
+	contextHeight := height - 1
+	if contextHeight < 1 {
+		// 0 is a special value for `ContextWithHeight`.
+		contextHeight = 1
+	}
+	parent, err := b.CosmosBlockByNumber(rpc.BlockNumber(contextHeight))
+	if err != nil {
+		return nil, fmt.Errorf("parent block %d not found: %w", contextHeight, err)
+	}
+
+	nc, ok := b.clientCtx.Client.(tmrpcclient.NetworkClient)
+	if !ok {
+		return nil, errors.New("invalid rpc client")
+	}
+
+	cp, err := nc.ConsensusParams(b.ctx, &parent.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	traceBlockRequest := &types.QueryTraceBlockRequest{
+		Txs:             txsMessages,
+		TraceConfig:     config,
+		BlockNumber:     height,
+		BlockTime:       time.Unix(int64(block.Time()), 0).UTC(), // #nosec G701
+		BlockHash:       common.Bytes2Hex(block.Hash().Bytes()),
+		ProposerAddress: sdk.ConsAddress(parent.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+		BlockMaxGas:     cp.ConsensusParams.Block.MaxGas,
+	}
+
+	res, err := b.queryClient.TraceBlock(rpctypes.ContextWithHeight(contextHeight), traceBlockRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	decodedResults := make([]*evmtxs.TxTraceResult, len(txsMessages))
+	if err := json.Unmarshal(res.Data, &decodedResults); err != nil {
+		return nil, err
+	}
+
+	return decodedResults, nil
+}
+
+// TraceCall lets you trace a given eth_call. It collects the structured logs
+// created during the execution of EVM if the given transaction was added on
+// top of the provided block and returns them as a JSON object.
+func (b *BackendImpl) TraceCall(args rpctypes.TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash, config *rpctypes.TraceCallConfig) (interface{}, error) {
+	blockNum, err := b.blockNumberFromCosmos(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	header, err := b.CosmosBlockByNumber(blockNum)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+
+	req := types.QueryTraceCallRequest{
+		Args:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+	}
+	if config != nil {
+		req.TraceConfig = &config.TraceConfig
+		if req.Overrides, err = marshalOverrides(config.StateOverrides); err != nil {
+			return nil, err
+		}
+		if req.BlockOverrides, err = marshalOverrides(config.BlockOverrides); err != nil {
+			return nil, err
+		}
+	}
+
+	traceResult, err := b.queryClient.TraceCall(rpctypes.ContextWithHeight(blockNum.Int64()), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response format is unknown due to custom tracer config param
+	// More information can be found here https://geth.ethereum.org/docs/dapp/tracing-filtered
+	var decodedResult interface{}
+	if err := json.Unmarshal(traceResult.Data, &decodedResult); err != nil {
+		return nil, err
+	}
+
+	return decodedResult, nil
+}
+
 // GetReceipts get receipts by block hash
 func (b *BackendImpl) GetReceipts(ctx context.Context, hash common.Hash) (ethtypes.Receipts, error) {
 	resBlock, err := b.CosmosBlockByHash(hash)
@@ -223,25 +340,13 @@ func (b *BackendImpl) GetReceipts(ctx context.Context, hash common.Hash) (ethtyp
 		return nil, fmt.Errorf("query block failed, block hash %s, %w", hash.String(), err)
 	}
 
-	blockRes, err := b.CosmosBlockResultByNumber(&resBlock.Block.Height)
+	blockReceipts, err := b.GetBlockReceipts(ctx, rpc.BlockNumberOrHashWithNumber(rpc.BlockNumber(resBlock.Block.Height)))
 	if err != nil {
-		b.logger.Debug("GetTransactionReceipt failed", "error", err)
-		return nil, nil
+		return nil, fmt.Errorf("query receipts failed, block hash %s, %w", hash.String(), err)
 	}
 
-	msgs := b.EthMsgsFromCosmosBlock(resBlock, blockRes)
-
-	receipts := make([]*ethtypes.Receipt, 0, len(msgs))
-	for _, msg := range msgs {
-		receipt, err := b.GetTransactionReceipt(ctx, common.HexToHash(msg.Hash))
-		if err != nil || receipt == nil {
-			errMsg := ""
-			if err != nil {
-				errMsg = err.Error()
-			}
-			b.logger.Error("eth_getReceipts failed", "error", errMsg, "txHash", msg.Hash)
-			continue
-		}
+	receipts := make([]*ethtypes.Receipt, 0, len(blockReceipts))
+	for _, receipt := range blockReceipts {
 		var contractAddress common.Address
 		if receipt["contractAddress"] != nil {
 			contractAddress = receipt["contractAddress"].(common.Address)
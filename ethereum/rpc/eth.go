@@ -7,6 +7,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/misc"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 
 	rpctypes "github.com/artela-network/artela-rollkit/ethereum/rpc/types"
@@ -95,6 +97,14 @@ func (b *BackendImpl) FeeHistory(blockCount uint64, lastBlock rpc.BlockNumber,
 	blockStart := blockEnd + 1 - blocks
 	oldestBlock := (*hexutil.Big)(big.NewInt(blockStart))
 
+	// the rewards are calculated from the txs of each block, otherwise the fee history kept
+	// in the fee module is used directly
+	if len(rewardPercentiles) == 0 {
+		if feeHistory, ok := b.feeHistoryFromStore(blockStart, blockEnd); ok {
+			return feeHistory, nil
+		}
+	}
+
 	reward := make([][]*hexutil.Big, blocks)
 	rewardCount := len(rewardPercentiles)
 	for i := 0; i < int(blocks); i++ {
@@ -158,6 +168,70 @@ func (b *BackendImpl) FeeHistory(blockCount uint64, lastBlock rpc.BlockNumber,
 	return &feeHistory, nil
 }
 
+// feeHistoryFromStore returns the base fees and the gas used ratios of the blocks from the fee history
+// kept in the fee module, returns false if any of the blocks is out of the history.
+func (b *BackendImpl) feeHistoryFromStore(blockStart, blockEnd int64) (*rpctypes.FeeHistoryResult, bool) {
+	blocks := blockEnd + 1 - blockStart
+	res, err := b.queryClient.FeeMarket.FeeHistory(b.ctx, &feetypes.QueryFeeHistoryRequest{
+		LastHeight: blockEnd,
+		BlockCount: uint64(blocks), // #nosec G115
+	})
+	if err != nil || int64(len(res.Records)) != blocks {
+		return nil, false
+	}
+
+	baseFees := make([]*hexutil.Big, blocks+1)
+	gasUsedRatios := make([]float64, blocks)
+	for i, record := range res.Records {
+		baseFees[i] = (*hexutil.Big)(new(big.Int))
+		if record.BaseFee != nil {
+			baseFees[i] = (*hexutil.Big)(record.BaseFee.BigInt())
+		}
+		if record.GasLimit > 0 {
+			gasUsedRatios[i] = float64(record.GasUsed) / float64(record.GasLimit)
+		}
+	}
+
+	nextBaseFee, err := b.nextBaseFee(res.Records[len(res.Records)-1])
+	if err != nil {
+		return nil, false
+	}
+	baseFees[blocks] = (*hexutil.Big)(nextBaseFee)
+
+	return &rpctypes.FeeHistoryResult{
+		OldestBlock:  (*hexutil.Big)(big.NewInt(blockStart)),
+		BaseFee:      baseFees,
+		GasUsedRatio: gasUsedRatios,
+	}, true
+}
+
+// nextBaseFee returns the base fee of the block following the recorded one, it is calculated from
+// the record if the following block is not committed yet.
+func (b *BackendImpl) nextBaseFee(record feetypes.BlockFeeRecord) (*big.Int, error) {
+	res, err := b.queryClient.FeeMarket.BaseFeeAtHeight(b.ctx, &feetypes.QueryBaseFeeAtHeightRequest{Height: record.Height + 1})
+	if err == nil {
+		if res.BaseFee == nil {
+			return new(big.Int), nil
+		}
+		return res.BaseFee.BigInt(), nil
+	}
+
+	cfg, err := b.chainConfig()
+	if err != nil {
+		return nil, err
+	}
+	if record.BaseFee == nil || !cfg.IsLondon(big.NewInt(record.Height+1)) {
+		return new(big.Int), nil
+	}
+
+	return misc.CalcBaseFee(cfg, &ethtypes.Header{
+		Number:   big.NewInt(record.Height),
+		GasLimit: record.GasLimit,
+		GasUsed:  record.GasUsed,
+		BaseFee:  record.BaseFee.BigInt(),
+	}), nil
+}
+
 func (b *BackendImpl) Engine() consensus.Engine {
 	// only for ethereum, pow -> pos
 	b.logger.Error("Engine is not valid")
@@ -11,9 +11,11 @@ import (
 	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/bloombits"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/artela-network/artela-rollkit/ethereum/rpc/types"
@@ -42,7 +44,8 @@ type Backend interface {
 	// GetLogsByHeight(*int64) ([][]*ethtypes.Log, error)
 	BlockBloom(blockRes *coretypes.ResultBlockResults) (ethtypes.Bloom, error)
 
-	BloomStatus() (uint64, uint64)
+	BloomStatus() (uint64, uint64, uint64)
+	ServiceFilter(ctx context.Context, session *bloombits.MatcherSession)
 
 	RPCFilterCap() int32
 	RPCLogsCap() int32
@@ -84,6 +87,13 @@ func NewPublicFilterAPI(logger log.Logger, clientCtx client.Context, tmWSClient
 		events:    NewEventSystem(logger, tmWSClient),
 	}
 
+	// the number of the installed filters is read when the metrics are collected
+	metrics.NewRegisteredFunctionalGauge("rpc/filters", nil, func() int64 {
+		api.filtersMu.Lock()
+		defer api.filtersMu.Unlock()
+		return int64(len(api.filters))
+	})
+
 	go api.timeoutLoop()
 
 	return api
@@ -10,6 +10,7 @@ import (
 
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/bloombits"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/eth/filters"
@@ -33,6 +34,7 @@ type Filter struct {
 	criteria filters.FilterCriteria
 
 	bloomFilters [][]BloomIV // Filter the system is matching for
+	matcher      *bloombits.Matcher
 }
 
 // NewBlockFilter creates a new filter which directly inspects the contents of
@@ -73,7 +75,12 @@ func NewRangeFilter(logger log.Logger, backend Backend, begin, end int64, addres
 		Topics:    topics,
 	}
 
-	return newFilter(logger, backend, criteria, createBloomFilters(filtersBz, logger))
+	filter := newFilter(logger, backend, criteria, createBloomFilters(filtersBz, logger))
+	// only match with the bloombits if there's any address or topic to filter
+	if size, _, _ := backend.BloomStatus(); size > 0 && len(filtersBz) > 0 {
+		filter.matcher = bloombits.NewMatcher(size, filtersBz)
+	}
+	return filter
 }
 
 // newFilter returns a new Filter
@@ -92,7 +99,7 @@ const (
 
 // Logs searches the blockchain for matching log entries, returning all from the
 // first block that contains matches, updating the start of the filter accordingly.
-func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*ethtypes.Log, error) {
+func (f *Filter) Logs(ctx context.Context, logLimit int, blockLimit int64) ([]*ethtypes.Log, error) {
 	logs := []*ethtypes.Log{}
 	var err error
 
@@ -154,6 +161,22 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 	from := f.criteria.FromBlock.Int64()
 	to := f.criteria.ToBlock.Int64()
 
+	// gather the logs of the blocks covered by the bloombits sections first,
+	// and finish with the blocks not indexed yet. Ranges starting before the
+	// first indexed section are not matched with the bloombits.
+	size, first, sections := f.backend.BloomStatus()
+	if indexed := int64(size * sections); f.matcher != nil && from >= int64(size*first) && indexed > from { // #nosec G701
+		end := to
+		if indexed <= end {
+			end = indexed - 1
+		}
+		logs, err = f.indexedLogs(ctx, from, end, logLimit)
+		if err != nil || logs == nil {
+			return logs, err
+		}
+		from = end + 1
+	}
+
 	for height := from; height <= to; height++ {
 		blockRes, err := f.backend.CosmosBlockResultByNumber(&height)
 		if err != nil {
@@ -180,6 +203,59 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 	return logs, nil
 }
 
+// indexedLogs returns the logs matching the filter criteria in the blocks [from, to],
+// which must be covered by the indexed bloombits sections.
+func (f *Filter) indexedLogs(ctx context.Context, from, to int64, logLimit int) ([]*ethtypes.Log, error) {
+	// create a matcher session and request servicing from the backend
+	matches := make(chan uint64, 64)
+	session, err := f.matcher.Start(ctx, uint64(from), uint64(to), matches) // #nosec G701
+	if err != nil {
+		return nil, err
+	}
+	defer session.Close()
+
+	f.backend.ServiceFilter(ctx, session)
+
+	logs := []*ethtypes.Log{}
+	for {
+		select {
+		case number, ok := <-matches:
+			if !ok {
+				if err := session.Error(); err != nil {
+					return nil, err
+				}
+				return logs, nil
+			}
+
+			height := int64(number) // #nosec G701
+			blockRes, err := f.backend.CosmosBlockResultByNumber(&height)
+			if err != nil {
+				f.logger.Debug("failed to fetch block result from cometbft", "height", height, "error", err.Error())
+				return nil, nil
+			}
+
+			bloom, err := f.backend.BlockBloom(blockRes)
+			if err != nil {
+				return nil, err
+			}
+
+			filtered, err := f.blockLogs(blockRes, bloom)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to fetch block by number %d", height)
+			}
+
+			// check logs limit
+			if len(logs)+len(filtered) > logLimit {
+				return nil, fmt.Errorf("query returned more than %d results", logLimit)
+			}
+			logs = append(logs, filtered...)
+
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
 // blockLogs returns the logs matching the filter criteria within a single block.
 func (f *Filter) blockLogs(blockRes *tmrpctypes.ResultBlockResults, bloom ethtypes.Bloom) ([]*ethtypes.Log, error) {
 	if !bloomFilter(bloom, f.criteria.Addresses, f.criteria.Topics) {
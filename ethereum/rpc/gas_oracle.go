@@ -3,7 +3,6 @@ package rpc
 import (
 	"context"
 
-	"github.com/ethereum/go-ethereum/core/types"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 )
@@ -31,7 +30,3 @@ func (b *BackendImpl) BlockByNumberOrHash(ctx context.Context, blockNrOrHash rpc
 	// BEWARE THE HASH OF THIS BLOCK IS NOT MATCH TO WHAT WAS STORED IN COSMOS DB
 	return b.BlockByNumber(ctx, blockNum)
 }
-
-func (b *BackendImpl) PendingBlockAndReceipts() (*types.Block, types.Receipts) {
-	return nil, nil
-}
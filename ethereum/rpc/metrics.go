@@ -0,0 +1,41 @@
+package rpc
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+// The methods served over http are measured by the geth rpc server, which counts the requests and
+// records the serving time histograms of each method under `rpc/duration/<method>/<success|failure>`.
+// The websocket server handles the subscriptions itself, so it's measured separately under `rpc/ws`,
+// the other requests it forwards to the http server are measured there and counted as `forward` here.
+// All the metrics are no-op unless `--metrics` is passed.
+var (
+	wsConnectionGauge   = metrics.NewRegisteredGauge("rpc/ws/connections", nil)
+	wsSubscriptionGauge = metrics.NewRegisteredGauge("rpc/ws/subscriptions", nil)
+)
+
+// wsForwardMethod is the method name of the websocket requests forwarded to the http server,
+// the method names of them are not trusted to keep the number of the metrics bounded.
+const wsForwardMethod = "forward"
+
+// updateWsServeMetrics counts a request of the websocket server and records its serving time.
+func updateWsServeMetrics(method string, success bool, elapsed time.Duration) {
+	if !metrics.Enabled {
+		return
+	}
+
+	note := "success"
+	if !success {
+		note = "failure"
+		metrics.GetOrRegisterCounter(fmt.Sprintf("rpc/ws/failure/%s", method), nil).Inc(1)
+	}
+	metrics.GetOrRegisterCounter(fmt.Sprintf("rpc/ws/requests/%s", method), nil).Inc(1)
+	// construct the sample lazily, it's only needed on the first request of the method
+	histogram := metrics.GetOrRegister(fmt.Sprintf("rpc/ws/duration/%s/%s", method, note), func() metrics.Histogram {
+		return metrics.NewHistogram(metrics.NewExpDecaySample(1028, 0.015))
+	}).(metrics.Histogram)
+	histogram.Update(elapsed.Microseconds())
+}
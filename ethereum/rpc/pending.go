@@ -0,0 +1,234 @@
+package rpc
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/trie"
+
+	rpctypes "github.com/artela-network/artela-rollkit/ethereum/rpc/types"
+	evmtypes "github.com/artela-network/artela-rollkit/x/evm/types"
+)
+
+// pendingRefreshInterval is the interval of rebuilding the pending state for the pending logs subscribers,
+// it is also the minimum interval between two builds of the same height.
+const pendingRefreshInterval = time.Second
+
+// pendingState is the speculative next block, built by applying the EVM txs of the mempool on top
+// of the latest state. It is cached until a new block is committed or the mempool changes.
+type pendingState struct {
+	height int64       // height of the latest block the pending state is built on
+	digest common.Hash // digest of the mempool EVM txs the pending state is built from
+	built  time.Time   // time the pending state is built
+
+	block    *rpctypes.Block
+	receipts ethtypes.Receipts
+	msgs     []*evmtypes.MsgEthereumTx // the applied msgs, in the order of the pending block
+}
+
+// PendingBlockAndReceipts returns the pending block and the receipts of the txs in it.
+func (b *BackendImpl) PendingBlockAndReceipts() (*ethtypes.Block, ethtypes.Receipts) {
+	state, err := b.pendingState()
+	if err != nil {
+		b.logger.Debug("failed to build pending state", "error", err)
+		return nil, nil
+	}
+	return state.block.EthBlock(), state.receipts
+}
+
+// pendingState returns the cached pending state, it is rebuilt if a new block is committed or
+// the mempool changes since the last build. The mempool changes are picked up at most once per
+// pendingRefreshInterval, so the simulation is not repeated on every query of a busy mempool.
+func (b *BackendImpl) pendingState() (*pendingState, error) {
+	latest, err := b.ArtBlockByNumber(b.ctx, rpc.LatestBlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	msgs, err := b.pendingEthMsgs()
+	if err != nil {
+		return nil, err
+	}
+	digest := pendingMsgsDigest(msgs)
+
+	b.pendingMu.Lock()
+	prev := b.pending
+	if prev != nil && prev.height == latest.Number().Int64() &&
+		(prev.digest == digest || time.Since(prev.built) < pendingRefreshInterval) {
+		b.pendingMu.Unlock()
+		return prev, nil
+	}
+
+	state, err := b.simulatePending(latest, msgs)
+	if err != nil {
+		b.pendingMu.Unlock()
+		return nil, err
+	}
+	state.digest = digest
+	state.built = time.Now()
+	b.pending = state
+	b.pendingMu.Unlock()
+
+	// feed the subscribers outside the lock, the send blocks until all the subscribers received
+	if logs := newPendingLogs(prev, state); len(logs) > 0 {
+		b.pendingLogsFeed.Send(logs)
+	}
+	return state, nil
+}
+
+// simulatePending applies the pending msgs on top of the latest block, and assembles the pending block.
+func (b *BackendImpl) simulatePending(latest *rpctypes.Block, msgs []*evmtypes.MsgEthereumTx) (*pendingState, error) {
+	height := latest.Number().Int64()
+	res, err := b.queryClient.SimulatePending(rpctypes.ContextWithHeight(height), &evmtypes.QuerySimulatePendingRequest{
+		Txs:     msgs,
+		ChainId: b.chainID.Int64(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	msgsByHash := make(map[string]*evmtypes.MsgEthereumTx, len(msgs))
+	for _, msg := range msgs {
+		msgsByHash[msg.Hash] = msg
+	}
+
+	number := new(big.Int).Add(latest.Number(), big.NewInt(1))
+	state := &pendingState{
+		height:   height,
+		receipts: make(ethtypes.Receipts, 0, len(res.Results)),
+		msgs:     make([]*evmtypes.MsgEthereumTx, 0, len(res.Results)),
+	}
+	txs := make([]*ethtypes.Transaction, 0, len(res.Results))
+	for _, result := range res.Results {
+		msg, ok := msgsByHash[result.Hash]
+		if !ok {
+			continue
+		}
+		sender, err := b.GetSender(msg, b.chainID)
+		if err != nil {
+			return nil, err
+		}
+
+		tx := msg.AsTransaction()
+		receipt := &ethtypes.Receipt{
+			Type:              tx.Type(),
+			Status:            ethtypes.ReceiptStatusSuccessful,
+			CumulativeGasUsed: result.CumulativeGasUsed,
+			Logs:              evmtypes.LogsToEthereum(result.Logs),
+			TxHash:            tx.Hash(),
+			GasUsed:           result.GasUsed,
+			BlockNumber:       number,
+			TransactionIndex:  uint(len(txs)),
+		}
+		if result.Failed() {
+			receipt.Status = ethtypes.ReceiptStatusFailed
+		}
+		if tx.To() == nil {
+			receipt.ContractAddress = crypto.CreateAddress(sender, tx.Nonce())
+		}
+		// the pending block has no hash yet
+		for _, log := range receipt.Logs {
+			log.BlockNumber = number.Uint64()
+			log.BlockHash = common.Hash{}
+		}
+		receipt.Bloom = ethtypes.CreateBloom(ethtypes.Receipts{receipt})
+
+		txs = append(txs, tx)
+		state.receipts = append(state.receipts, receipt)
+		state.msgs = append(state.msgs, msg)
+	}
+
+	parent := latest.Header()
+	blockTime := uint64(time.Now().Unix())
+	if blockTime <= parent.Time {
+		blockTime = parent.Time + 1
+	}
+	header := &ethtypes.Header{
+		ParentHash: latest.Hash(),
+		UncleHash:  ethtypes.EmptyUncleHash,
+		Coinbase:   parent.Coinbase,
+		Difficulty: big.NewInt(0),
+		Number:     number,
+		GasLimit:   parent.GasLimit,
+		GasUsed:    res.GasUsed,
+		Time:       blockTime,
+		Extra:      []byte{},
+		BaseFee:    parent.BaseFee,
+	}
+
+	// the bloom and the receipt root of the header are derived from the receipts
+	ethBlock := ethtypes.NewBlock(header, txs, nil, state.receipts, trie.NewStackTrie(nil))
+	state.block = rpctypes.EthBlockToBlock(ethBlock)
+	state.block.SetHash(ethBlock.Hash())
+	return state, nil
+}
+
+// pendingEthMsgs returns the EVM msgs in the mempool, in the order of the mempool.
+func (b *BackendImpl) pendingEthMsgs() ([]*evmtypes.MsgEthereumTx, error) {
+	pendingTxs, err := b.PendingTransactions()
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := make([]*evmtypes.MsgEthereumTx, 0, len(pendingTxs))
+	for _, tx := range pendingTxs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// not ethereum tx
+				break
+			}
+			ethMsg.Hash = ethMsg.AsTransaction().Hash().Hex()
+			msgs = append(msgs, ethMsg)
+		}
+	}
+	return msgs, nil
+}
+
+// pendingLoop rebuilds the pending state periodically while there are pending logs subscribers,
+// so that the logs of the new pending txs are delivered even if nobody queries the pending state.
+func (b *BackendImpl) pendingLoop() {
+	ticker := time.NewTicker(pendingRefreshInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if b.pendingLogsScope.Count() == 0 {
+			continue
+		}
+		if _, err := b.pendingState(); err != nil {
+			b.logger.Debug("failed to build pending state", "error", err)
+		}
+	}
+}
+
+// pendingMsgsDigest returns the digest of the msgs, it changes if any msg is added, removed or reordered.
+func pendingMsgsDigest(msgs []*evmtypes.MsgEthereumTx) common.Hash {
+	hashes := make([]byte, 0, len(msgs)*common.HashLength)
+	for _, msg := range msgs {
+		hashes = append(hashes, common.HexToHash(msg.Hash).Bytes()...)
+	}
+	return crypto.Keccak256Hash(hashes)
+}
+
+// newPendingLogs returns the logs of the pending state, the logs of the txs already delivered
+// with the previous pending state of the same height are excluded.
+func newPendingLogs(prev, state *pendingState) []*ethtypes.Log {
+	delivered := make(map[common.Hash]struct{})
+	if prev != nil && prev.height == state.height {
+		for _, receipt := range prev.receipts {
+			delivered[receipt.TxHash] = struct{}{}
+		}
+	}
+
+	var logs []*ethtypes.Log
+	for _, receipt := range state.receipts {
+		if _, ok := delivered[receipt.TxHash]; ok {
+			continue
+		}
+		logs = append(logs, receipt.Logs...)
+	}
+	return logs
+}
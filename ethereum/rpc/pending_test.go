@@ -0,0 +1,42 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	evmtypes "github.com/artela-network/artela-rollkit/x/evm/types"
+)
+
+func TestPendingMsgsDigest(t *testing.T) {
+	a := &evmtypes.MsgEthereumTx{Hash: common.HexToHash("0x01").Hex()}
+	b := &evmtypes.MsgEthereumTx{Hash: common.HexToHash("0x02").Hex()}
+
+	require.Equal(t, pendingMsgsDigest([]*evmtypes.MsgEthereumTx{a, b}), pendingMsgsDigest([]*evmtypes.MsgEthereumTx{a, b}))
+	require.NotEqual(t, pendingMsgsDigest([]*evmtypes.MsgEthereumTx{a, b}), pendingMsgsDigest([]*evmtypes.MsgEthereumTx{b, a}))
+	require.NotEqual(t, pendingMsgsDigest([]*evmtypes.MsgEthereumTx{a}), pendingMsgsDigest([]*evmtypes.MsgEthereumTx{a, b}))
+}
+
+func TestNewPendingLogs(t *testing.T) {
+	receipt := func(hash string) *ethtypes.Receipt {
+		return &ethtypes.Receipt{
+			TxHash: common.HexToHash(hash),
+			Logs:   []*ethtypes.Log{{TxHash: common.HexToHash(hash)}},
+		}
+	}
+
+	prev := &pendingState{height: 10, receipts: ethtypes.Receipts{receipt("0x01")}}
+	state := &pendingState{height: 10, receipts: ethtypes.Receipts{receipt("0x01"), receipt("0x02")}}
+
+	// the logs of the delivered txs are not sent again
+	logs := newPendingLogs(prev, state)
+	require.Len(t, logs, 1)
+	require.Equal(t, common.HexToHash("0x02"), logs[0].TxHash)
+
+	// all the logs are sent after a new block is committed
+	state.height = 11
+	require.Len(t, newPendingLogs(prev, state), 2)
+	require.Len(t, newPendingLogs(nil, state), 2)
+}
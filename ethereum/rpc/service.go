@@ -8,7 +8,9 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/rpc"
 
+	ethindexer "github.com/artela-network/artela-rollkit/ethereum/indexer"
 	"github.com/artela-network/artela-rollkit/ethereum/rpc/types"
+	ethereumtypes "github.com/artela-network/artela-rollkit/ethereum/types"
 )
 
 type ArtelaService struct {
@@ -29,6 +31,8 @@ func NewArtelaService(
 	stack types.NetworkingStack,
 	logger log.Logger,
 	db db.DB,
+	indexer ethereumtypes.EVMTxIndexer,
+	bloomIndexer *ethindexer.BloomIndexer,
 ) *ArtelaService {
 	art := &ArtelaService{
 		cfg:       cfg,
@@ -39,7 +43,7 @@ func NewArtelaService(
 		logger:    logger,
 	}
 
-	art.backend = NewBackend(ctx, clientCtx, art, stack.ExtRPCEnabled(), cfg, logger, db)
+	art.backend = NewBackend(ctx, clientCtx, art, stack.ExtRPCEnabled(), cfg, logger, db, indexer, bloomIndexer)
 	return art
 }
 
@@ -0,0 +1,175 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/trie"
+
+	"github.com/artela-network/artela-evm/vm"
+	"github.com/artela-network/artela-rollkit/ethereum/rpc/api"
+	rpctypes "github.com/artela-network/artela-rollkit/ethereum/rpc/types"
+	evmtypes "github.com/artela-network/artela-rollkit/x/evm/types"
+)
+
+// simErrCodeVMError is the json rpc error code of the simulated calls failed with a vm error other than revert.
+const simErrCodeVMError = -32015
+
+// SimulateV1 simulates the blocks of calls on top of the state of the given block, and returns the
+// simulated blocks with the results of their calls.
+func (b *BackendImpl) SimulateV1(opts rpctypes.SimOpts, blockNrOrHash rpc.BlockNumberOrHash) ([]map[string]interface{}, error) {
+	blockNum, err := b.blockNumberFromCosmos(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := json.Marshal(opts.BlockStateCalls)
+	if err != nil {
+		return nil, err
+	}
+	header, err := b.CosmosBlockByNumber(blockNum)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	req := evmtypes.QuerySimulateV1Request{
+		Blocks:          bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdktypes.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+		Validation:      opts.Validation,
+	}
+
+	// From ContextWithHeight: if the provided height is 0,
+	// it will return an empty context and the gRPC query will use
+	// the latest block height for querying.
+	ctx := rpctypes.ContextWithHeight(blockNum.Int64())
+	timeout := b.RPCEVMTimeout()
+
+	// Setup context so it may be canceled the call has completed
+	// or, in case of unmetered gas, setup a context with a timeout.
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	res, err := b.queryClient.SimulateV1(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	parentHash := common.BytesToHash(header.Block.Hash())
+	blocks := make([]map[string]interface{}, 0, len(res.Blocks))
+	for _, block := range res.Blocks {
+		fields, hash := formatSimulatedBlock(block, parentHash)
+		blocks = append(blocks, fields)
+		parentHash = hash
+	}
+	return blocks, nil
+}
+
+// formatSimulatedBlock assembles the header of a simulated block from the results of its calls, and
+// returns the rpc output of the block with its hash. The calls are not signed txs, so they are only
+// returned as hashes, and the txs root is left empty.
+func formatSimulatedBlock(block *evmtypes.SimulatedBlock, parentHash common.Hash) (map[string]interface{}, common.Hash) {
+	number := new(big.Int).SetUint64(block.Number)
+	receipts := make(ethtypes.Receipts, 0, len(block.Calls))
+	txHashes := make([]common.Hash, 0, len(block.Calls))
+	for i, res := range block.Calls {
+		receipt := &ethtypes.Receipt{
+			Status:            ethtypes.ReceiptStatusSuccessful,
+			CumulativeGasUsed: res.CumulativeGasUsed,
+			Logs:              evmtypes.LogsToEthereum(res.Logs),
+			TxHash:            common.HexToHash(res.Hash),
+			GasUsed:           res.GasUsed,
+			BlockNumber:       number,
+			TransactionIndex:  uint(i),
+		}
+		if res.Failed() {
+			receipt.Status = ethtypes.ReceiptStatusFailed
+		}
+		receipt.Bloom = ethtypes.CreateBloom(ethtypes.Receipts{receipt})
+
+		receipts = append(receipts, receipt)
+		txHashes = append(txHashes, receipt.TxHash)
+	}
+
+	header := &ethtypes.Header{
+		ParentHash:  parentHash,
+		UncleHash:   ethtypes.EmptyUncleHash,
+		Coinbase:    common.HexToAddress(block.Coinbase),
+		TxHash:      ethtypes.EmptyTxsHash,
+		ReceiptHash: ethtypes.DeriveSha(receipts, trie.NewStackTrie(nil)),
+		Bloom:       ethtypes.CreateBloom(receipts),
+		Difficulty:  big.NewInt(0),
+		Number:      number,
+		GasLimit:    block.GasLimit,
+		GasUsed:     block.GasUsed,
+		Time:        block.Time,
+		Extra:       []byte{},
+	}
+	if block.BaseFee != nil {
+		header.BaseFee = block.BaseFee.BigInt()
+	}
+	hash := header.Hash()
+
+	calls := make([]rpctypes.SimCallResult, 0, len(block.Calls))
+	for i, res := range block.Calls {
+		// the block hash is only known after the header is assembled
+		for _, log := range receipts[i].Logs {
+			log.BlockHash = hash
+			log.BlockNumber = block.Number
+		}
+		calls = append(calls, newSimCallResult(res, receipts[i].Logs))
+	}
+
+	fields := api.RPCMarshalHeader(header, hash)
+	fields["transactions"] = txHashes
+	fields["uncles"] = []common.Hash{}
+	fields["calls"] = calls
+	return fields, hash
+}
+
+// newSimCallResult converts the result of a simulated call to the rpc output, the failed calls are
+// returned with the same error codes as eth_call.
+func newSimCallResult(res *evmtypes.MsgEthereumTxResponse, logs []*ethtypes.Log) rpctypes.SimCallResult {
+	if logs == nil {
+		logs = []*ethtypes.Log{}
+	}
+	result := rpctypes.SimCallResult{
+		ReturnValue: res.Ret,
+		Logs:        logs,
+		GasUsed:     hexutil.Uint64(res.GasUsed),
+		Status:      hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+	}
+	if !res.Failed() {
+		return result
+	}
+
+	result.Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+	if res.VmError == vm.ErrExecutionReverted.Error() {
+		revertErr := evmtypes.NewExecErrorWithReason(res.Ret)
+		result.Error = &rpctypes.SimCallError{
+			Code:    revertErr.ErrorCode(),
+			Message: revertErr.Error(),
+			Data:    revertErr.ErrorData().(string),
+		}
+	} else {
+		result.Error = &rpctypes.SimCallError{
+			Code:    simErrCodeVMError,
+			Message: res.VmError,
+		}
+	}
+	return result
+}
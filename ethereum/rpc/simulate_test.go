@@ -0,0 +1,75 @@
+package rpc
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela-evm/vm"
+	rpctypes "github.com/artela-network/artela-rollkit/ethereum/rpc/types"
+	evmtypes "github.com/artela-network/artela-rollkit/x/evm/types"
+)
+
+func TestFormatSimulatedBlock(t *testing.T) {
+	baseFee := sdkmath.NewInt(7)
+	block := &evmtypes.SimulatedBlock{
+		Number:   11,
+		Time:     100,
+		GasLimit: 1000000,
+		GasUsed:  42000,
+		BaseFee:  &baseFee,
+		Coinbase: common.HexToAddress("0x01").Hex(),
+		Calls: []*evmtypes.MsgEthereumTxResponse{
+			{
+				Hash:              common.HexToHash("0x02").Hex(),
+				GasUsed:           21000,
+				CumulativeGasUsed: 21000,
+				Logs:              []*evmtypes.Log{{Address: common.HexToAddress("0x03").Hex(), TxHash: common.HexToHash("0x02").Hex()}},
+			},
+			{
+				Hash:              common.HexToHash("0x04").Hex(),
+				GasUsed:           21000,
+				CumulativeGasUsed: 42000,
+				VmError:           vm.ErrExecutionReverted.Error(),
+			},
+		},
+	}
+	parentHash := common.HexToHash("0x05")
+
+	fields, hash := formatSimulatedBlock(block, parentHash)
+	require.Equal(t, hash, fields["hash"])
+	require.Equal(t, parentHash, fields["parentHash"])
+	require.Equal(t, (*hexutil.Big)(baseFee.BigInt()), fields["baseFeePerGas"])
+	require.Equal(t, []common.Hash{common.HexToHash("0x02"), common.HexToHash("0x04")}, fields["transactions"])
+
+	// the logs are linked to the simulated block
+	calls := fields["calls"].([]rpctypes.SimCallResult)
+	require.Len(t, calls, 2)
+	require.Len(t, calls[0].Logs, 1)
+	require.Equal(t, hash, calls[0].Logs[0].BlockHash)
+	require.Equal(t, uint64(11), calls[0].Logs[0].BlockNumber)
+	require.Equal(t, hexutil.Uint64(ethtypes.ReceiptStatusSuccessful), calls[0].Status)
+	require.Nil(t, calls[0].Error)
+
+	// the reverted call is reported with the same error code as eth_call
+	require.Equal(t, hexutil.Uint64(ethtypes.ReceiptStatusFailed), calls[1].Status)
+	require.NotNil(t, calls[1].Error)
+	require.Equal(t, 3, calls[1].Error.Code)
+	require.Empty(t, calls[1].Logs)
+
+	// the next simulated block is chained to the previous one
+	next, _ := formatSimulatedBlock(&evmtypes.SimulatedBlock{Number: 12, Time: 101}, hash)
+	require.Equal(t, hash, next["parentHash"])
+}
+
+func TestNewSimCallResultVMError(t *testing.T) {
+	result := newSimCallResult(&evmtypes.MsgEthereumTxResponse{VmError: vm.ErrOutOfGas.Error(), GasUsed: 100}, nil)
+	require.Equal(t, hexutil.Uint64(ethtypes.ReceiptStatusFailed), result.Status)
+	require.Equal(t, simErrCodeVMError, result.Error.Code)
+	require.Equal(t, vm.ErrOutOfGas.Error(), result.Error.Message)
+	require.NotNil(t, result.Logs)
+}
@@ -7,6 +7,7 @@ import (
 	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
@@ -186,11 +187,6 @@ func (b *BackendImpl) GetTransactionReceipt(ctx context.Context, hash common.Has
 	}
 	ethMsg := tx.GetMsgs()[res.MsgIndex].(*evmtypes.MsgEthereumTx)
 
-	txData, err := evmtypes.UnpackTxData(ethMsg.Data)
-	if err != nil {
-		return nil, err
-	}
-
 	cumulativeGasUsed := uint64(0)
 	blockRes, err := b.CosmosBlockResultByNumber(&res.Height)
 	if err != nil {
@@ -202,13 +198,6 @@ func (b *BackendImpl) GetTransactionReceipt(ctx context.Context, hash common.Has
 	}
 	cumulativeGasUsed += res.CumulativeGasUsed
 
-	var status hexutil.Uint
-	if res.Failed {
-		status = hexutil.Uint(ethtypes.ReceiptStatusFailed)
-	} else {
-		status = hexutil.Uint(ethtypes.ReceiptStatusSuccessful)
-	}
-
 	// parse tx logs from events
 	msgIndex := int(res.MsgIndex)
 	logs, _ := rpcutils.TxLogsFromEvents(blockRes.TxsResults[res.TxIndex].Events, msgIndex)
@@ -228,6 +217,129 @@ func (b *BackendImpl) GetTransactionReceipt(ctx context.Context, hash common.Has
 		return nil, errors.New("can't find index of ethereum tx")
 	}
 
+	// the base fee is only needed by the effective gas price of the dynamic fee txs
+	var baseFee *big.Int
+	if ethMsg.AsTransaction().Type() == ethtypes.DynamicFeeTxType {
+		baseFee, _ = b.BaseFee(blockRes)
+	}
+
+	return formatTxReceipt(ethMsg, res, logs, common.BytesToHash(resBlock.Block.Header.Hash()), cumulativeGasUsed, baseFee)
+}
+
+// GetBlockReceipts returns the receipts of all the ethereum txs in the block. The results of the block are
+// parsed in a single pass, instead of looking up each tx from the tx indexer like GetTransactionReceipt.
+func (b *BackendImpl) GetBlockReceipts(_ context.Context, blockNrOrHash rpc.BlockNumberOrHash) ([]map[string]interface{}, error) {
+	blockNum, err := b.blockNumberFromCosmos(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	resBlock, err := b.CosmosBlockByNumber(blockNum)
+	if err != nil {
+		b.logger.Debug("GetBlockReceipts failed", "error", err)
+		return nil, nil
+	}
+	blockRes, err := b.CosmosBlockResultByNumber(&resBlock.Block.Height)
+	if err != nil {
+		b.logger.Debug("GetBlockReceipts failed", "error", err)
+		return nil, nil
+	}
+	baseFee, err := b.BaseFee(blockRes)
+	if err != nil {
+		b.logger.Debug("failed to fetch base fee of block", "height", resBlock.Block.Height, "error", err)
+	}
+
+	height := resBlock.Block.Height
+	blockHash := common.BytesToHash(resBlock.Block.Header.Hash())
+	receipts := make([]map[string]interface{}, 0, len(resBlock.Block.Txs))
+
+	// the same as the kv indexer, the cumulative gas used includes the gas used of all the previous cosmos txs,
+	// and the index of the valid ethereum txs is consistent with the txs list returned by eth_getBlock api
+	var (
+		blockGasUsed uint64
+		ethTxIndex   int32
+	)
+	for txIndex, txBz := range resBlock.Block.Txs {
+		result := blockRes.TxsResults[txIndex]
+		cumulativeGasUsed := blockGasUsed
+		blockGasUsed += uint64(result.GasUsed) // #nosec G701
+		if !rpctypes.TxSuccessOrExceedsBlockGasLimit(result) {
+			continue
+		}
+
+		tx, err := b.clientCtx.TxConfig.TxDecoder()(txBz)
+		if err != nil {
+			b.logger.Debug("failed to decode transaction in block", "height", height, "error", err.Error())
+			continue
+		}
+		txs, err := rpctypes.ParseTxResult(result, tx)
+		if err != nil {
+			b.logger.Debug("failed to parse tx events", "height", height, "txIndex", txIndex, "error", err.Error())
+			continue
+		}
+
+		for msgIndex, msg := range tx.GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				continue
+			}
+
+			res := &types.TxResult{
+				Height:     height,
+				TxIndex:    uint32(txIndex),  // #nosec G701
+				MsgIndex:   uint32(msgIndex), // #nosec G701
+				EthTxIndex: ethTxIndex,
+			}
+			if result.Code != abci.CodeTypeOK {
+				// exceeds block gas limit scenario, the gas limit is charged by the ante handler,
+				// and no event is emitted, so the sender is recovered from the signature.
+				res.GasUsed = ethMsg.GetGas()
+				res.Failed = true
+				if sender, err := b.GetSender(ethMsg, b.chainID); err == nil {
+					res.Sender = sender.Hex()
+				}
+			} else {
+				parsedTx := txs.GetTxByHash(ethMsg.AsTransaction().Hash())
+				if parsedTx == nil {
+					b.logger.Debug("msg not found in events", "height", height, "txIndex", txIndex, "msgIndex", msgIndex)
+					continue
+				}
+				res.GasUsed = parsedTx.GasUsed
+				res.Failed = parsedTx.Failed
+				res.Sender = parsedTx.From.Hex()
+			}
+			cumulativeGasUsed += res.GasUsed
+			ethTxIndex++
+
+			logs, _ := rpcutils.TxLogsFromEvents(result.Events, msgIndex)
+			receipt, err := formatTxReceipt(ethMsg, res, logs, blockHash, cumulativeGasUsed, baseFee)
+			if err != nil {
+				return nil, err
+			}
+			receipts = append(receipts, receipt)
+		}
+	}
+
+	return receipts, nil
+}
+
+// formatTxReceipt returns the receipt of the ethereum tx in the json rpc format, the effective gas price
+// is only set for the dynamic fee txs if the base fee is known.
+func formatTxReceipt(ethMsg *evmtypes.MsgEthereumTx, res *types.TxResult, logs []*ethtypes.Log,
+	blockHash common.Hash, cumulativeGasUsed uint64, baseFee *big.Int,
+) (map[string]interface{}, error) {
+	txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	var status hexutil.Uint
+	if res.Failed {
+		status = hexutil.Uint(ethtypes.ReceiptStatusFailed)
+	} else {
+		status = hexutil.Uint(ethtypes.ReceiptStatusSuccessful)
+	}
+
+	tx := ethMsg.AsTransaction()
 	receipt := map[string]interface{}{
 		// Consensus fields: These fields are defined by the Yellow Paper
 		"status":            status,
@@ -237,20 +349,20 @@ func (b *BackendImpl) GetTransactionReceipt(ctx context.Context, hash common.Has
 
 		// Implementation fields: These fields are added by geth when processing a transaction.
 		// They are stored in the chain database.
-		"transactionHash": hash,
+		"transactionHash": tx.Hash(),
 		"contractAddress": nil,
 		"gasUsed":         hexutil.Uint64(res.GasUsed),
 
 		// Inclusion information: These fields provide information about the inclusion of the
 		// transaction corresponding to this receipt.
-		"blockHash":        common.BytesToHash(resBlock.Block.Header.Hash()).Hex(),
+		"blockHash":        blockHash.Hex(),
 		"blockNumber":      hexutil.Uint64(res.Height),
 		"transactionIndex": hexutil.Uint64(res.EthTxIndex),
 
 		// sender and receiver (contract or EOA) addreses
 		"from": res.Sender,
 		"to":   txData.GetTo(),
-		"type": hexutil.Uint(ethMsg.AsTransaction().Type()),
+		"type": hexutil.Uint(tx.Type()),
 	}
 
 	if logs == nil {
@@ -262,11 +374,8 @@ func (b *BackendImpl) GetTransactionReceipt(ctx context.Context, hash common.Has
 		receipt["contractAddress"] = crypto.CreateAddress(common.HexToAddress(res.Sender), txData.GetNonce())
 	}
 
-	if dynamicTx, ok := txData.(*evmtypes.DynamicFeeTx); ok {
-		baseFee, err := b.BaseFee(blockRes)
-		if err == nil {
-			receipt["effectiveGasPrice"] = hexutil.Big(*dynamicTx.EffectiveGasPrice(baseFee))
-		}
+	if dynamicTx, ok := txData.(*evmtypes.DynamicFeeTx); ok && baseFee != nil {
+		receipt["effectiveGasPrice"] = hexutil.Big(*dynamicTx.EffectiveGasPrice(baseFee))
 	}
 
 	return receipt, nil
@@ -514,9 +623,14 @@ func (b *BackendImpl) version() (string, error) {
 }
 
 func (b *BackendImpl) GetTxByEthHash(hash common.Hash) (*types.TxResult, error) {
-	// if b.indexer != nil {
-	// 	return b.indexer.GetByTxHash(hash)
-	// }
+	if b.indexer != nil {
+		txResult, err := b.indexer.GetByTxHash(hash)
+		if err != nil {
+			b.logger.Debug("failed to get tx from indexer", "hash", hash.Hex(), "error", err)
+		} else if txResult != nil {
+			return txResult, nil
+		}
+	}
 
 	// fallback to tendermint tx indexer
 	query := fmt.Sprintf("%s.%s='%s'", evmtypes.TypeMsgEthereumTx, evmtypes.AttributeKeyEthereumTxHash, hash.Hex())
@@ -619,7 +733,8 @@ func (b *BackendImpl) getAccountNonce(accAddr common.Address, pending bool, heig
 	}
 
 	// the account retriever doesn't include the uncommitted transactions on the nonce so we need to
-	// to manually add them.
+	// to manually add them. The txs are counted instead of simulated, so the nonce is cheap to query
+	// and doesn't fall back to the committed nonce if the pending state can't be built.
 	pendingTxs, err := b.PendingTransactions()
 	if err != nil {
 		return nonce, nil
@@ -13,6 +13,7 @@ import (
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 
+	aspecttypes "github.com/artela-network/artela-rollkit/x/aspect/types"
 	"github.com/artela-network/artela-rollkit/x/evm/txs"
 	evmtypes "github.com/artela-network/artela-rollkit/x/evm/types"
 )
@@ -46,8 +47,10 @@ type (
 		Backend
 
 		GetProof(address common.Address, storageKeys []string, blockNrOrHash BlockNumberOrHash) (*AccountResult, error)
-		DoCall(args TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash) (*evmtypes.MsgEthereumTxResponse, error)
-		EstimateGas(ctx context.Context, args TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash) (hexutil.Uint64, error)
+		DoCall(args TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash, overrides *StateOverride, blockOverrides *BlockOverrides) (*evmtypes.MsgEthereumTxResponse, error)
+		EstimateGas(ctx context.Context, args TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash, overrides *StateOverride) (hexutil.Uint64, error)
+		CreateAccessList(args TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash) (*evmtypes.CreateAccessListResponse, error)
+		SimulateV1(opts SimOpts, blockNrOrHash rpc.BlockNumberOrHash) ([]map[string]interface{}, error)
 
 		BlockNumber() (hexutil.Uint64, error)
 		BlockTimeByNumber(blockNum int64) (uint64, error)
@@ -77,6 +80,7 @@ type (
 		GetTxMsg(ctx context.Context, txHash common.Hash) (*evmtypes.MsgEthereumTx, error)
 		SignTransaction(args *TransactionArgs) (*types.Transaction, error)
 		GetTransactionReceipt(ctx context.Context, hash common.Hash) (map[string]interface{}, error)
+		GetBlockReceipts(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) ([]map[string]interface{}, error)
 		RPCTxFeeCap() float64
 		UnprotectedAllowed() bool
 
@@ -95,6 +99,8 @@ type (
 			config *evmtypes.TraceConfig,
 			block *tmrpctypes.ResultBlock,
 		) ([]*txs.TxTraceResult, error)
+		TraceEthBlock(block *types.Block, config *evmtypes.TraceConfig) ([]*txs.TxTraceResult, error)
+		TraceCall(args TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash, config *TraceCallConfig) (interface{}, error)
 		GetReceipts(ctx context.Context, hash common.Hash) (types.Receipts, error)
 
 		DBProperty(property string) (string, error)
@@ -114,6 +120,16 @@ type (
 		PendingTransactionsCount() (int, error)
 	}
 
+	// AspectBackend defines the aspect related interfaces, a version of 0 means the latest version.
+	AspectBackend interface {
+		GetAspect(aspectID common.Address, blockNrOrHash rpc.BlockNumberOrHash) (*aspecttypes.QueryAspectResponse, error)
+		GetAspectCode(aspectID common.Address, version uint64, blockNrOrHash rpc.BlockNumberOrHash) (*aspecttypes.QueryAspectCodeResponse, error)
+		GetAspectProperties(aspectID common.Address, version uint64, blockNrOrHash rpc.BlockNumberOrHash) (*aspecttypes.QueryAspectPropertiesResponse, error)
+		GetAspectState(aspectID common.Address, key string, blockNrOrHash rpc.BlockNumberOrHash) (hexutil.Bytes, error)
+		GetAspectBoundAccounts(aspectID common.Address, blockNrOrHash rpc.BlockNumberOrHash) ([]aspecttypes.AspectBinding, error)
+		GetAccountBindings(account common.Address, blockNrOrHash rpc.BlockNumberOrHash) ([]aspecttypes.AspectBinding, error)
+	}
+
 	// NetBackend is the collection of methods required to satisfy the net
 	// RPC DebugAPI.
 	NetBackend interface {
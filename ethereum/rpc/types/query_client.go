@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 
+	aspecttypes "github.com/artela-network/artela-rollkit/x/aspect/types"
 	evmtypes "github.com/artela-network/artela-rollkit/x/evm/types"
 	feetypes "github.com/artela-network/artela-rollkit/x/fee/types"
 )
@@ -16,10 +17,12 @@ import (
 //   - Transaction simulation
 //   - EVM module queries
 //   - Fee market module queries
+//   - Aspect module queries
 type QueryClient struct {
 	tx.ServiceClient
 	evmtypes.QueryClient
 	FeeMarket feetypes.QueryClient
+	Aspect    aspecttypes.QueryClient
 }
 
 // NewQueryClient creates a new gRPC query client
@@ -28,6 +31,7 @@ func NewQueryClient(clientCtx client.Context) *QueryClient {
 		ServiceClient: tx.NewServiceClient(clientCtx),
 		QueryClient:   evmtypes.NewQueryClient(clientCtx),
 		FeeMarket:     feetypes.NewQueryClient(clientCtx),
+		Aspect:        aspecttypes.NewQueryClient(clientCtx),
 	}
 }
 
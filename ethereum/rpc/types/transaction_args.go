@@ -102,7 +102,7 @@ func (args *TransactionArgs) SetDefaults(ctx context.Context, b TrancsactionBack
 		latestBlockNumber := rpc.LatestBlockNumber
 		estimated, err := b.EstimateGas(ctx, callArgs, &rpc.BlockNumberOrHash{
 			BlockNumber: &latestBlockNumber,
-		})
+		}, nil)
 		if err != nil {
 			return err
 		}
@@ -6,6 +6,9 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/artela-network/artela-rollkit/x/evm/states"
+	evmtypes "github.com/artela-network/artela-rollkit/x/evm/types"
 )
 
 // Copied the Account and StorageResult types since they are registered under an
@@ -29,21 +32,59 @@ type StorageResult struct {
 	Proof []string     `json:"proof"`
 }
 
-// StateOverride is the collection of overridden accounts.
-type StateOverride map[common.Address]OverrideAccount
-
-// OverrideAccount indicates the overriding fields of account during the execution of
-// a message call.
-// Note, states and stateDiff can't be specified at the same time. If states is
-// set, message execution will only use the data in the given states. Otherwise
-// if statDiff is set, all diff will be applied first and then execute the call
-// message.
-type OverrideAccount struct {
-	Nonce     *hexutil.Uint64              `json:"nonce"`
-	Code      *hexutil.Bytes               `json:"code"`
-	Balance   **hexutil.Big                `json:"balance"`
-	State     *map[common.Hash]common.Hash `json:"states"`
-	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+// StateOverride is the collection of overridden accounts, applied by the evm keeper.
+type StateOverride = states.StateOverride
+
+// OverrideAccount indicates the overriding fields of account during the execution of a message call.
+type OverrideAccount = states.OverrideAccount
+
+// BlockOverrides is a set of header fields to override.
+type BlockOverrides = states.BlockOverrides
+
+// TraceCallConfig is the config for traceCall API. It holds the overrides
+// of the state and the block header for tracing.
+type TraceCallConfig struct {
+	evmtypes.TraceConfig
+	StateOverrides *StateOverride  `json:"stateOverrides"`
+	BlockOverrides *BlockOverrides `json:"blockOverrides"`
+}
+
+// SimOpts are the inputs of eth_simulateV1.
+type SimOpts struct {
+	BlockStateCalls []SimBlock `json:"blockStateCalls"`
+	Validation      bool       `json:"validation"`
+}
+
+// SimBlock is a block of calls to simulate, the overrides are applied before the calls.
+type SimBlock struct {
+	BlockOverrides *BlockOverrides   `json:"blockOverrides,omitempty"`
+	StateOverrides *StateOverride    `json:"stateOverrides,omitempty"`
+	Calls          []TransactionArgs `json:"calls"`
+}
+
+// SimCallResult is the result of a simulated call.
+type SimCallResult struct {
+	ReturnValue hexutil.Bytes   `json:"returnData"`
+	Logs        []*ethtypes.Log `json:"logs"`
+	GasUsed     hexutil.Uint64  `json:"gasUsed"`
+	Status      hexutil.Uint64  `json:"status"`
+	Error       *SimCallError   `json:"error,omitempty"`
+}
+
+// SimCallError is the error of a failed simulated call.
+type SimCallError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+// AspectBinding is a binding between an aspect and an account.
+type AspectBinding struct {
+	Aspect    common.Address `json:"aspect"`
+	Account   common.Address `json:"account"`
+	Version   hexutil.Uint64 `json:"version"`
+	Priority  int32          `json:"priority"`
+	JoinPoint hexutil.Uint64 `json:"joinPoint"`
 }
 
 type FeeHistoryResult struct {
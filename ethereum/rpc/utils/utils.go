@@ -3,7 +3,9 @@ package utils
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -91,6 +93,27 @@ func GetLogsFromBlockResults(blockRes *tmrpctypes.ResultBlockResults) ([][]*etht
 	return blockLogs, nil
 }
 
+// BlockBloom parses the block bloom from the events emitted by evm end blocker
+func BlockBloom(blockRes *tmrpctypes.ResultBlockResults) (ethtypes.Bloom, error) {
+	for _, event := range blockRes.FinalizeBlockEvents {
+		if event.Type != evmtypes.EventTypeBlockBloom {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if attr.Key == evmtypes.AttributeKeyEthereumBloom {
+				encodedBloom, err := base64.StdEncoding.DecodeString(attr.Value)
+				if err != nil {
+					return ethtypes.Bloom{}, err
+				}
+
+				return ethtypes.BytesToBloom(encodedBloom), nil
+			}
+		}
+	}
+	return ethtypes.Bloom{}, errors.New("block bloom event is not found")
+}
+
 // AllTxLogsFromEvents parses all ethereum logs from cosmos events
 func AllTxLogsFromEvents(events []abci.Event) ([][]*ethtypes.Log, error) {
 	allLogs := make([][]*ethtypes.Log, 0, 4)
@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/gorilla/websocket"
@@ -176,6 +177,8 @@ func (w *wsConn) ReadMessage() (messageType int, p []byte, err error) {
 }
 
 func (s *websocketsServer) readLoop(wsConn *wsConn) {
+	wsConnectionGauge.Inc(1)
+
 	// subscriptions of current connection
 	subscriptions := make(map[rpc.ID]pubsub.UnsubscribeFunc)
 	defer func() {
@@ -184,6 +187,8 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 		for _, unsubFn := range subscriptions {
 			unsubFn()
 		}
+		wsSubscriptionGauge.Dec(int64(len(subscriptions)))
+		wsConnectionGauge.Dec(1)
 	}()
 
 	for {
@@ -194,111 +199,143 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 			return
 		}
 
-		if isBatch(mb) {
-			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
-				s.sendErrResponse(wsConn, err.Error())
-			}
-			continue
+		if !s.serveMessage(wsConn, mb, subscriptions) {
+			return
 		}
+	}
+}
+
+// serveMessage responds to a message read from the connection, it returns false if the connection is closed.
+func (s *websocketsServer) serveMessage(wsConn *wsConn, mb []byte, subscriptions map[rpc.ID]pubsub.UnsubscribeFunc) bool {
+	var (
+		start   = time.Now()
+		method  = wsForwardMethod
+		success bool
+	)
+	defer func() {
+		updateWsServeMetrics(method, success, time.Since(start))
+	}()
 
-		var msg map[string]interface{}
-		if err = json.Unmarshal(mb, &msg); err != nil {
+	if isBatch(mb) {
+		if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
 			s.sendErrResponse(wsConn, err.Error())
-			continue
+			return true
 		}
+		success = true
+		return true
+	}
 
-		// check if method == eth_subscribe or eth_unsubscribe
-		method, ok := msg["method"].(string)
-		if !ok {
-			// otherwise, call the usual rpc server to respond
-			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
-				s.sendErrResponse(wsConn, err.Error())
-			}
+	var msg map[string]interface{}
+	if err := json.Unmarshal(mb, &msg); err != nil {
+		s.sendErrResponse(wsConn, err.Error())
+		return true
+	}
 
-			continue
+	// check if method == eth_subscribe or eth_unsubscribe
+	msgMethod, ok := msg["method"].(string)
+	if !ok {
+		// otherwise, call the usual rpc server to respond
+		if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
+			s.sendErrResponse(wsConn, err.Error())
+			return true
 		}
+		success = true
+		return true
+	}
+
+	if msgMethod == "eth_subscribe" || msgMethod == "eth_unsubscribe" {
+		method = msgMethod
+	}
 
-		var connID float64
-		switch id := msg["id"].(type) {
-		case string:
-			connID, err = strconv.ParseFloat(id, 64)
-		case float64:
-			connID = id
-		default:
-			err = fmt.Errorf("unknown type")
+	var (
+		connID float64
+		err    error
+	)
+	switch id := msg["id"].(type) {
+	case string:
+		connID, err = strconv.ParseFloat(id, 64)
+	case float64:
+		connID = id
+	default:
+		err = fmt.Errorf("unknown type")
+	}
+	if err != nil {
+		s.sendErrResponse(
+			wsConn,
+			fmt.Errorf("invalid type for connection ID: %T", msg["id"]).Error(),
+		)
+		return true
+	}
+
+	switch msgMethod {
+	case "eth_subscribe":
+		params, ok := s.getParamsAndCheckValid(msg, wsConn)
+		if !ok {
+			return true
 		}
+
+		subID := rpc.NewID()
+		unsubFn, err := s.api.subscribe(wsConn, subID, params)
 		if err != nil {
-			s.sendErrResponse(
-				wsConn,
-				fmt.Errorf("invalid type for connection ID: %T", msg["id"]).Error(),
-			)
-			continue
+			s.sendErrResponse(wsConn, err.Error())
+			return true
 		}
+		subscriptions[subID] = unsubFn
+		wsSubscriptionGauge.Inc(1)
 
-		switch method {
-		case "eth_subscribe":
-			params, ok := s.getParamsAndCheckValid(msg, wsConn)
-			if !ok {
-				continue
-			}
-
-			subID := rpc.NewID()
-			unsubFn, err := s.api.subscribe(wsConn, subID, params)
-			if err != nil {
-				s.sendErrResponse(wsConn, err.Error())
-				continue
-			}
-			subscriptions[subID] = unsubFn
-
-			res := &SubscriptionResponseJSON{
-				Jsonrpc: "2.0",
-				ID:      connID,
-				Result:  subID,
-			}
+		res := &SubscriptionResponseJSON{
+			Jsonrpc: "2.0",
+			ID:      connID,
+			Result:  subID,
+		}
 
-			if err := wsConn.WriteJSON(res); err != nil {
-				_ = wsConn.Close() // #nosec G703
-				s.logger.Error("error writing subscription response", "error", err)
-				return
-			}
-		case "eth_unsubscribe":
-			params, ok := s.getParamsAndCheckValid(msg, wsConn)
-			if !ok {
-				continue
-			}
+		if err := wsConn.WriteJSON(res); err != nil {
+			_ = wsConn.Close() // #nosec G703
+			s.logger.Error("error writing subscription response", "error", err)
+			return false
+		}
+	case "eth_unsubscribe":
+		params, ok := s.getParamsAndCheckValid(msg, wsConn)
+		if !ok {
+			return true
+		}
 
-			id, ok := params[0].(string)
-			if !ok {
-				s.sendErrResponse(wsConn, "invalid parameters")
-				continue
-			}
+		id, ok := params[0].(string)
+		if !ok {
+			s.sendErrResponse(wsConn, "invalid parameters")
+			return true
+		}
 
-			subID := rpc.ID(id)
-			unsubFn, ok := subscriptions[subID]
-			if ok {
-				delete(subscriptions, subID)
-				unsubFn()
-			}
+		subID := rpc.ID(id)
+		unsubFn, ok := subscriptions[subID]
+		if ok {
+			delete(subscriptions, subID)
+			wsSubscriptionGauge.Dec(1)
+			unsubFn()
+		}
 
-			res := &SubscriptionResponseJSON{
-				Jsonrpc: "2.0",
-				ID:      connID,
-				Result:  ok,
-			}
+		res := &SubscriptionResponseJSON{
+			Jsonrpc: "2.0",
+			ID:      connID,
+			Result:  ok,
+		}
 
-			if err := wsConn.WriteJSON(res); err != nil {
-				_ = wsConn.Close() // #nosec G703
-				s.logger.Error("error writing unsubscribe response", "error", err)
-				return
-			}
-		default:
-			// otherwise, call the usual rpc server to respond
-			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
-				s.sendErrResponse(wsConn, err.Error())
-				s.logger.Error("error sending response", "error", err)
-			}
+		if err := wsConn.WriteJSON(res); err != nil {
+			_ = wsConn.Close() // #nosec G703
+			s.logger.Error("error writing unsubscribe response", "error", err)
+			return false
+		}
+	default:
+		// otherwise, call the usual rpc server to respond
+		if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
+			s.sendErrResponse(wsConn, err.Error())
+			s.logger.Error("error sending response", "error", err)
+			return true
 		}
 	}
+
+	success = true
+	return true
 }
 
 // tcpGetAndSendResponse sends error response to client if params is invalid
@@ -0,0 +1,112 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/service"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/artela-network/artela-rollkit/ethereum/indexer"
+	"github.com/artela-network/artela-rollkit/ethereum/rpc/utils"
+)
+
+const (
+	BloomServiceName = "BloomIndexerService"
+
+	// BloomSectionWaitTimeout is the interval to check whether a new section is completed,
+	// a section only completes every few thousand blocks.
+	BloomSectionWaitTimeout = 60 * time.Second
+)
+
+// BloomIndexerService builds the bloombits sections of the committed blocks in background.
+type BloomIndexerService struct {
+	service.BaseService
+
+	bloomIdxr *indexer.BloomIndexer
+	client    rpcclient.Client
+}
+
+// NewBloomIndexerService returns a new service instance.
+func NewBloomIndexerService(
+	bloomIdxr *indexer.BloomIndexer,
+	client rpcclient.Client,
+) *BloomIndexerService {
+	bis := &BloomIndexerService{bloomIdxr: bloomIdxr, client: client}
+	bis.BaseService = *service.NewBaseService(nil, BloomServiceName, bis)
+	return bis
+}
+
+// OnStart implements service.Service by indexing the completed sections, starting from the first
+// section not indexed yet, or from the first section with all the blocks available on a fresh index.
+func (bis *BloomIndexerService) OnStart() error {
+	ctx := context.Background()
+	sections, err := bis.bloomIdxr.Sections()
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		size := bis.bloomIdxr.SectionSize()
+		for {
+			status, err := bis.client.Status(ctx)
+			if err != nil {
+				bis.Logger.Error("failed to fetch status", "err", err)
+			} else {
+				if sections == 0 {
+					sections = firstAvailableSection(status.SyncInfo.EarliestBlockHeight, size)
+				}
+				if sectionEnd := (sections+1)*size - 1; int64(sectionEnd) <= status.SyncInfo.LatestBlockHeight { // #nosec G701
+					if err := bis.indexSection(ctx, sections); err != nil {
+						bis.Logger.Error("failed to index bloom section", "section", sections, "err", err)
+					} else {
+						bis.Logger.Info("indexed bloom section", "section", sections)
+						sections++
+						continue
+					}
+				}
+			}
+
+			select {
+			case <-bis.Quit():
+				return
+			case <-time.After(BloomSectionWaitTimeout):
+			}
+		}
+	}()
+	return nil
+}
+
+// firstAvailableSection returns the first section whose blocks are all available on the node,
+// the genesis height 0 has no block and doesn't make the first section unavailable.
+func firstAvailableSection(earliestBlock int64, size uint64) uint64 {
+	if earliestBlock <= 1 {
+		return 0
+	}
+	return (uint64(earliestBlock) + size - 1) / size // #nosec G701
+}
+
+// indexSection fetches the blooms of the blocks in the section and indexes them,
+// it fails if any block is not available on the node, so no section is indexed with missing blooms.
+func (bis *BloomIndexerService) indexSection(ctx context.Context, section uint64) error {
+	size := bis.bloomIdxr.SectionSize()
+	blooms := make([]ethtypes.Bloom, size)
+	for i := uint64(0); i < size; i++ {
+		height := int64(section*size + i) // #nosec G701
+		if height == 0 {
+			continue
+		}
+		blockRes, err := bis.client.BlockResults(ctx, &height)
+		if err != nil {
+			return err
+		}
+		bloom, err := utils.BlockBloom(blockRes)
+		if err != nil {
+			// no bloom event emitted, index it as an empty bloom
+			continue
+		}
+		blooms[i] = bloom
+	}
+	return bis.bloomIdxr.IndexSection(section, blooms)
+}
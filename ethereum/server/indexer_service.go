@@ -0,0 +1,134 @@
+package server
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/service"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	"github.com/cometbft/cometbft/types"
+
+	ethereumtypes "github.com/artela-network/artela-rollkit/ethereum/types"
+)
+
+const (
+	ServiceName = "EVMIndexerService"
+
+	NewBlockWaitTimeout = 60 * time.Second
+)
+
+// EVMIndexerService indexes transactions for json-rpc service.
+type EVMIndexerService struct {
+	service.BaseService
+
+	txIdxr ethereumtypes.EVMTxIndexer
+	client rpcclient.Client
+}
+
+// NewEVMIndexerService returns a new service instance.
+func NewEVMIndexerService(
+	txIdxr ethereumtypes.EVMTxIndexer,
+	client rpcclient.Client,
+) *EVMIndexerService {
+	is := &EVMIndexerService{txIdxr: txIdxr, client: client}
+	is.BaseService = *service.NewBaseService(nil, ServiceName, is)
+	return is
+}
+
+// OnStart implements service.Service by subscribing for new blocks
+// and indexing them by events.
+func (eis *EVMIndexerService) OnStart() error {
+	ctx := context.Background()
+	status, err := eis.client.Status(ctx)
+	if err != nil {
+		return err
+	}
+	// latestBlock is updated by the subscription and read by the indexing routine
+	var latestBlock atomic.Int64
+	latestBlock.Store(status.SyncInfo.LatestBlockHeight)
+	newBlockSignal := make(chan struct{}, 1)
+
+	blockHeadersChan, err := eis.client.Subscribe(
+		ctx,
+		ServiceName,
+		types.QueryForEvent(types.EventNewBlockHeader).String(),
+		0)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		for {
+			msg, ok := <-blockHeadersChan
+			if !ok {
+				// new blocks are still picked up by polling the status in the indexing routine
+				eis.Logger.Error("block header subscription closed")
+				return
+			}
+			eventDataHeader, ok := msg.Data.(types.EventDataNewBlockHeader)
+			if !ok {
+				continue
+			}
+			if eventDataHeader.Header.Height > latestBlock.Load() {
+				latestBlock.Store(eventDataHeader.Header.Height)
+				// notify
+				select {
+				case newBlockSignal <- struct{}{}:
+				default:
+				}
+			}
+		}
+	}()
+
+	lastBlock, err := eis.txIdxr.LastIndexedBlock()
+	if err != nil {
+		return err
+	}
+	if lastBlock == -1 {
+		lastBlock = latestBlock.Load()
+	}
+
+	go func() {
+		for {
+			if latest := latestBlock.Load(); latest > lastBlock {
+				lastBlock = eis.indexBlocks(ctx, lastBlock+1, latest)
+				if lastBlock == latest {
+					continue
+				}
+			}
+			// nothing to index or failed to fetch or index the blocks, wait for signal of new block
+			select {
+			case <-newBlockSignal:
+			case <-time.After(NewBlockWaitTimeout):
+				// no header received in time, the subscription may be closed
+				if status, err := eis.client.Status(ctx); err == nil && status.SyncInfo.LatestBlockHeight > latestBlock.Load() {
+					latestBlock.Store(status.SyncInfo.LatestBlockHeight)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// indexBlocks indexes the blocks in range [from, to], returns the last indexed block.
+func (eis *EVMIndexerService) indexBlocks(ctx context.Context, from, to int64) int64 {
+	for i := from; i <= to; i++ {
+		height := i
+		block, err := eis.client.Block(ctx, &height)
+		if err != nil {
+			eis.Logger.Error("failed to fetch block", "height", height, "err", err)
+			return height - 1
+		}
+		blockResult, err := eis.client.BlockResults(ctx, &height)
+		if err != nil {
+			eis.Logger.Error("failed to fetch block result", "height", height, "err", err)
+			return height - 1
+		}
+		if err := eis.txIdxr.IndexBlock(block.Block, blockResult.TxsResults); err != nil {
+			eis.Logger.Error("failed to index block", "height", height, "err", err)
+			return height - 1
+		}
+	}
+	return to
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	"github.com/cosmos/cosmos-sdk/version"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/hashicorp/go-metrics"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
@@ -34,8 +35,10 @@ import (
 	"github.com/rollkit/rollkit/rpc"
 	"github.com/rollkit/rollkit/types"
 
+	"github.com/artela-network/artela-rollkit/ethereum/indexer"
 	art "github.com/artela-network/artela-rollkit/ethereum/rpc"
 	appconf "github.com/artela-network/artela-rollkit/ethereum/server/config"
+	ethereumtypes "github.com/artela-network/artela-rollkit/ethereum/types"
 )
 
 const (
@@ -102,6 +105,16 @@ func startInProcess[T sdktypes.Application](svrCtx *server.Context, db dbm.DB, s
 
 	g, ctx := getCtx(svrCtx, true)
 
+	appcfg, err := appconf.GetConfig(svrCtx.Viper)
+	if err != nil {
+		return err
+	}
+
+	var (
+		idxer      ethereumtypes.EVMTxIndexer
+		bloomIdxer *indexer.BloomIndexer
+	)
+	dbBackend := server.GetAppDBBackend(svrCtx.Viper)
 	if gRPCOnly {
 		// TODO: Generalize logic so that gRPC only is really in startStandAlone
 		svrCtx.Logger.Info("starting node in gRPC only mode; CometBFT is disabled")
@@ -126,6 +139,37 @@ func startInProcess[T sdktypes.Application](svrCtx *server.Context, db dbm.DB, s
 			app.RegisterTendermintService(clientCtx)
 			app.RegisterNodeService(clientCtx, svrCfg)
 		}
+
+		if appcfg.JSONRPC.Enable && appcfg.JSONRPC.EnableIndexer {
+			idxDB, err := OpenIndexerDB(cmtCfg.RootDir, dbBackend)
+			if err != nil {
+				svrCtx.Logger.Error("failed to open evm indexer DB", "error", err.Error())
+				return err
+			}
+
+			idxLogger := svrCtx.Logger.With("indexer", "evm")
+			idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
+			indexerService := NewEVMIndexerService(idxer, server.Client())
+			indexerService.SetLogger(servercmtlog.CometLoggerWrapper{Logger: idxLogger})
+			if err := indexerService.Start(); err != nil {
+				return err
+			}
+		}
+
+		if appcfg.JSONRPC.Enable && appcfg.JSONRPC.EnableBloomIndexer {
+			bloomDB, err := OpenBloomBitsDB(cmtCfg.RootDir, dbBackend)
+			if err != nil {
+				svrCtx.Logger.Error("failed to open bloombits DB", "error", err.Error())
+				return err
+			}
+
+			bloomIdxer = indexer.NewBloomIndexer(bloomDB, params.BloomBitsBlocks)
+			bloomService := NewBloomIndexerService(bloomIdxer, server.Client())
+			bloomService.SetLogger(servercmtlog.CometLoggerWrapper{Logger: svrCtx.Logger.With("indexer", "bloombits")})
+			if err := bloomService.Start(); err != nil {
+				return err
+			}
+		}
 	}
 
 	grpcSrv, clientCtx, err := startGrpcServer(ctx, g, svrCfg.GRPC, clientCtx, svrCtx, app)
@@ -144,7 +188,6 @@ func startInProcess[T sdktypes.Application](svrCtx *server.Context, db dbm.DB, s
 		}
 	}
 
-	appcfg, err := appconf.GetConfig(svrCtx.Viper)
 	var (
 		jsonrpcSrv *art.ArtelaService
 		errCh      chan error = make(chan error)
@@ -153,7 +196,7 @@ func startInProcess[T sdktypes.Application](svrCtx *server.Context, db dbm.DB, s
 	if appcfg.JSONRPC.Enable {
 		tmEndpoint := "/websocket"
 		tmRPCAddr := cmtCfg.RPC.ListenAddress
-		jsonrpcSrv, err = CreateJSONRPC(svrCtx, clientCtx, tmRPCAddr, tmEndpoint, &appcfg, db)
+		jsonrpcSrv, err = CreateJSONRPC(svrCtx, clientCtx, tmRPCAddr, tmEndpoint, &appcfg, db, idxer, bloomIdxer)
 		if err != nil {
 			return err
 		}
@@ -12,9 +12,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	sdkserver "github.com/cosmos/cosmos-sdk/server"
 	ethlog "github.com/ethereum/go-ethereum/log"
+	ethmetrics "github.com/ethereum/go-ethereum/metrics"
+	ethmetricsexp "github.com/ethereum/go-ethereum/metrics/exp"
 
+	ethindexer "github.com/artela-network/artela-rollkit/ethereum/indexer"
 	ethrpc "github.com/artela-network/artela-rollkit/ethereum/rpc"
 	"github.com/artela-network/artela-rollkit/ethereum/server/config"
+	ethtypes "github.com/artela-network/artela-rollkit/ethereum/types"
 	ethNode "github.com/ethereum/go-ethereum/node"
 )
 
@@ -25,6 +29,8 @@ func CreateJSONRPC(ctx *sdkserver.Context,
 	tmEndpoint string,
 	config *config.Config,
 	db dbm.DB,
+	indexer ethtypes.EVMTxIndexer,
+	bloomIndexer *ethindexer.BloomIndexer,
 ) (*ethrpc.ArtelaService, error) {
 	cfg := getRpcConfig(config)
 
@@ -40,13 +46,20 @@ func CreateJSONRPC(ctx *sdkserver.Context,
 
 	wsClient := ConnectTmWS(tmRPCAddr, tmEndpoint, nodeCfg.Logger)
 
-	serv := ethrpc.NewArtelaService(ctx, clientCtx, wsClient, cfg, stack, nodeCfg.Logger, db)
+	serv := ethrpc.NewArtelaService(ctx, clientCtx, wsClient, cfg, stack, nodeCfg.Logger, db, indexer, bloomIndexer)
 
 	// allocate separate WS connection to Tendermint
 	tmWsClient := ConnectTmWS(tmRPCAddr, tmEndpoint, nodeCfg.Logger)
 	wsSrv := ethrpc.NewWebsocketsServer(clientCtx, tmWsClient, config, nodeCfg.Logger)
 	wsSrv.Start()
 
+	// geth enables the metrics by the `--metrics` flag, the prometheus metrics are served at
+	// /debug/metrics/prometheus of the metrics address.
+	if ethmetrics.Enabled && config.JSONRPC.MetricsAddress != "" {
+		ctx.Logger.Info("starting JSON-RPC metrics server", "address", config.JSONRPC.MetricsAddress)
+		ethmetricsexp.Setup(config.JSONRPC.MetricsAddress)
+	}
+
 	return serv, nil
 }
 
@@ -100,6 +113,18 @@ func openDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
 	return dbm.NewDB("application", backendType, dataDir)
 }
 
+// OpenIndexerDB opens the custom eth indexer db, using the same db backend as the main app
+func OpenIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	return dbm.NewDB("evmindexer", backendType, dataDir)
+}
+
+// OpenBloomBitsDB opens the bloombits db of the log filters, using the same db backend as the main app
+func OpenBloomBitsDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	return dbm.NewDB("bloombits", backendType, dataDir)
+}
+
 func ConnectTmWS(tmRPCAddr, tmEndpoint string, logger ethlog.Logger) *rpcclient.WSClient {
 	tmWsClient, err := rpcclient.NewWS(tmRPCAddr, tmEndpoint,
 		rpcclient.MaxReconnectAttempts(256),
@@ -1,11 +1,38 @@
 package types
 
 import (
+	"context"
 	math "math"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
+// ValidatorGetter resolves the validators from their consensus addresses, it is implemented by the staking keeper.
+type ValidatorGetter interface {
+	GetValidatorByConsAddr(ctx context.Context, consAddr sdk.ConsAddress) (stakingtypes.Validator, error)
+}
+
+// GetProposerOperator returns the operator address of the validator with the given consensus address,
+// the proposer of the current block is used if the given address is empty.
+func GetProposerOperator(ctx sdk.Context, validators ValidatorGetter, proposerAddress sdk.ConsAddress) (sdk.ValAddress, error) {
+	if len(proposerAddress) == 0 {
+		proposerAddress = ctx.BlockHeader().ProposerAddress
+	}
+
+	validator, err := validators.GetValidatorByConsAddr(ctx, proposerAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(
+			stakingtypes.ErrNoValidatorFound,
+			"failed to retrieve validator from block proposer address %s",
+			proposerAddress.String(),
+		)
+	}
+
+	return sdk.ValAddressFromBech32(validator.GetOperator())
+}
+
 // BlockGasLimit returns the max gas (limit) defined in the block gas meter. If the meter is not
 // set, it returns the max gas from the application consensus params.
 // NOTE: see https://github.com/cosmos/cosmos-sdk/issues/9514 for full reference
@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/aspect-core/djpm"
+	asptypes "github.com/artela-network/aspect-core/types"
+
+	"github.com/artela-network/artela-rollkit/app"
+	aspectstore "github.com/artela-network/artela-rollkit/x/aspect/store"
+	aspectmoduletypes "github.com/artela-network/artela-rollkit/x/aspect/types"
+	"github.com/artela-network/artela-rollkit/x/evm/states"
+)
+
+// TestChainID is the chain id of the app returned by ArtelaApp.
+const TestChainID = "artela_11820-1"
+
+// mockCometRPC is the comet client of the app returned by ArtelaApp, no block can be queried with it,
+// so the evm queries run without the eth block context.
+type mockCometRPC struct {
+	client.CometRPC
+}
+
+func (mockCometRPC) Block(context.Context, *int64) (*coretypes.ResultBlock, error) {
+	return nil, errors.New("block not available")
+}
+
+// ArtelaApp returns an app initialized with a single validator and the given balances, and the context of
+// the block after genesis proposed by the validator. It is used by the tests running evm messages,
+// which need the real account, bank, staking and fee keepers.
+func ArtelaApp(t testing.TB, balances ...banktypes.Balance) (*app.App, sdk.Context) {
+	appOpts := simtestutil.AppOptionsMap{flags.FlagHome: t.TempDir()}
+	artelaApp, err := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, appOpts, baseapp.SetChainID(TestChainID))
+	require.NoError(t, err)
+	artelaApp.EvmKeeper.SetClientContext(client.Context{}.WithClient(mockCometRPC{}))
+
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	validator := cmttypes.NewValidator(pubKey, 1)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{validator})
+
+	// the first genesis account is the delegator of the validator
+	genAccs := []authtypes.GenesisAccount{authtypes.NewBaseAccount(authtypes.NewModuleAddress("delegator"), nil, 0, 0)}
+	for _, balance := range balances {
+		genAccs = append(genAccs, authtypes.NewBaseAccount(sdk.MustAccAddressFromBech32(balance.Address), nil, 0, 0))
+	}
+
+	genesisState, err := simtestutil.GenesisStateWithValSet(artelaApp.AppCodec(), artelaApp.DefaultGenesis(), valSet, genAccs, balances...)
+	require.NoError(t, err)
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+
+	_, err = artelaApp.InitChain(&abci.RequestInitChain{
+		ChainId:         TestChainID,
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	require.NoError(t, err)
+
+	blockTime := time.Now().UTC()
+	_, err = artelaApp.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height:             1,
+		Time:               blockTime,
+		NextValidatorsHash: valSet.Hash(),
+		ProposerAddress:    validator.Address,
+	})
+	require.NoError(t, err)
+	_, err = artelaApp.Commit()
+	require.NoError(t, err)
+
+	header := cmtproto.Header{
+		ChainID:         TestChainID,
+		Height:          2,
+		Time:            blockTime.Add(time.Second),
+		ProposerAddress: validator.Address,
+	}
+	return artelaApp, artelaApp.BaseApp.NewUncachedContext(false, header)
+}
+
+// AspectStoreContext returns a gas free store context of the aspect stores of the app.
+func AspectStoreContext(artelaApp *app.App, ctx sdk.Context) aspectmoduletypes.StoreContext {
+	return aspectmoduletypes.NewGasFreeStoreContext(ctx, artelaApp.AspectKeeper.GetEVMStoreService(), artelaApp.AspectKeeper.GetStoreService())
+}
+
+// BindVerifierAspect deploys a contract at the given address, and binds it with a verifier aspect
+// whose paymaster is the given one.
+func BindVerifierAspect(t testing.TB, artelaApp *app.App, ctx sdk.Context, contract, aspectID, paymaster common.Address) {
+	BindAspect(t, artelaApp, ctx, contract, aspectID, asptypes.JoinPointRunType_VerifyTx, &aspectmoduletypes.AspectMeta{PayMaster: paymaster})
+}
+
+// BindAspect deploys a contract at the given address, and binds it with an aspect of the given meta,
+// which is executed at the given join point.
+func BindAspect(t testing.TB, artelaApp *app.App, ctx sdk.Context, contract, aspectID common.Address,
+	point asptypes.JoinPointRunType, meta *aspectmoduletypes.AspectMeta,
+) {
+	code := []byte{0x00}
+	codeHash := crypto.Keccak256(code)
+	artelaApp.EvmKeeper.SetCode(ctx, codeHash, code)
+	require.NoError(t, artelaApp.EvmKeeper.SetAccount(ctx, contract, states.StateAccount{
+		Balance:  new(big.Int),
+		CodeHash: codeHash,
+	}))
+	BindAccountAspect(t, artelaApp, ctx, contract, aspectID, point, meta, true)
+}
+
+// BindAccountAspect binds the account with an aspect of the given meta, which is executed at the given join point,
+// the account is bound as an EoA if isContract is false.
+func BindAccountAspect(t testing.TB, artelaApp *app.App, ctx sdk.Context, account, aspectID common.Address,
+	point asptypes.JoinPointRunType, meta *aspectmoduletypes.AspectMeta, isContract bool,
+) {
+	joinPoint := uint64(point)
+	metaStore, _, err := aspectstore.GetAspectMetaStore(&aspectmoduletypes.AspectStoreContext{
+		StoreContext: AspectStoreContext(artelaApp, ctx),
+		AspectID:     aspectID,
+	})
+	require.NoError(t, err)
+	require.NoError(t, metaStore.Init())
+	require.NoError(t, metaStore.StoreMeta(meta))
+	version, err := metaStore.BumpVersion()
+	require.NoError(t, err)
+	require.NoError(t, metaStore.StoreVersionMeta(version, &aspectmoduletypes.VersionMeta{JoinPoint: joinPoint}))
+	require.NoError(t, metaStore.StoreBinding(account, version, joinPoint, 0))
+
+	accountStore, _, err := aspectstore.GetAccountStore(&aspectmoduletypes.AccountStoreContext{
+		StoreContext: AspectStoreContext(artelaApp, ctx),
+		Account:      account,
+	})
+	require.NoError(t, err)
+	require.NoError(t, accountStore.Init())
+	require.NoError(t, accountStore.StoreBinding(aspectID, version, joinPoint, 0, isContract))
+}
+
+// SetPaymasterLimit sets the spending limit of the paymaster for the given aspect.
+func SetPaymasterLimit(t testing.TB, artelaApp *app.App, ctx sdk.Context, paymaster, aspectID common.Address, limit int64) {
+	allowance := aspectmoduletypes.NewPaymasterAllowance()
+	allowance.Limit.SetInt64(limit)
+	require.NoError(t, aspectstore.StorePaymasterAllowance(AspectStoreContext(artelaApp, ctx), paymaster, aspectID, allowance))
+}
+
+// AspectVerifiedTxData returns the data of an unsigned tx, whose sender is verified by the aspect with the given payload.
+func AspectVerifiedTxData(payload []byte) []byte {
+	data := append([]byte{}, djpm.CustomVerificationPrefix...)
+	data = append(data, crypto.Keccak256(payload)[:4]...)
+	return append(data, payload...)
+}
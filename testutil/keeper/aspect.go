@@ -19,14 +19,17 @@ import (
 
 	"github.com/artela-network/artela-rollkit/x/aspect/keeper"
 	"github.com/artela-network/artela-rollkit/x/aspect/types"
+	evmtypes "github.com/artela-network/artela-rollkit/x/evm/types"
 )
 
 func AspectKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	evmStoreKey := storetypes.NewKVStoreKey(evmtypes.StoreKey)
 
 	db := dbm.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
 	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(evmStoreKey, storetypes.StoreTypeIAVL, db)
 	require.NoError(t, stateStore.LoadLatestVersion())
 
 	registry := codectypes.NewInterfaceRegistry()
@@ -39,6 +42,7 @@ func AspectKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		log.NewNopLogger(),
 		authority.String(),
 	)
+	k.SetEVMStoreService(runtime.NewKVStoreService(evmStoreKey))
 
 	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
 
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"cosmossdk.io/log"
@@ -16,6 +17,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
 
 	coreStore "cosmossdk.io/core/store"
@@ -23,31 +25,113 @@ import (
 	"github.com/artela-network/artela-rollkit/x/fee/types"
 )
 
+// mockTransientStoreService opens the transient store of the fee keeper, it is backed by a kv store,
+// whose changes are discarded together with the test.
 type mockTransientStoreService struct {
-	key *storetypes.TransientStoreKey
+	key *storetypes.KVStoreKey
 }
 
 func (t mockTransientStoreService) OpenTransientStore(ctx context.Context) coreStore.KVStore {
+	return runtime.NewKVStoreService(t.key).OpenKVStore(ctx)
+}
+
+// MockBankKeeper is the bank keeper of the fee keeper returned by FeeKeeperWithMocks. The balances of the
+// modules and accounts are kept in its own store, so the changes made with a cache context are discarded
+// together with the context.
+type MockBankKeeper struct {
+	storeKey *storetypes.KVStoreKey
+}
+
+// GetBalance returns the balance of the given module name or bech32 account address.
+func (b MockBankKeeper) GetBalance(ctx context.Context, owner string) sdk.Coins {
+	bz := sdk.UnwrapSDKContext(ctx).KVStore(b.storeKey).Get([]byte(owner))
+	coins, err := sdk.ParseCoinsNormalized(string(bz))
+	if err != nil {
+		panic(err)
+	}
+	return coins
+}
+
+// SetBalance sets the balance of the given module name or bech32 account address.
+func (b MockBankKeeper) SetBalance(ctx context.Context, owner string, coins sdk.Coins) {
+	sdk.UnwrapSDKContext(ctx).KVStore(b.storeKey).Set([]byte(owner), []byte(coins.String()))
+}
+
+func (b MockBankKeeper) SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.GetBalance(ctx, addr.String())
+}
+
+func (b MockBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return b.send(ctx, senderModule, recipientAddr.String(), amt)
+}
+
+func (b MockBankKeeper) SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	return b.send(ctx, senderModule, recipientModule, amt)
+}
+
+func (b MockBankKeeper) BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error {
+	balance, negative := b.GetBalance(ctx, moduleName).SafeSub(amt...)
+	if negative {
+		return fmt.Errorf("insufficient funds to burn %s from %s", amt, moduleName)
+	}
+	b.SetBalance(ctx, moduleName, balance)
+	return nil
+}
+
+func (b MockBankKeeper) send(ctx context.Context, from, to string, amt sdk.Coins) error {
+	balance, negative := b.GetBalance(ctx, from).SafeSub(amt...)
+	if negative {
+		return fmt.Errorf("insufficient funds to send %s from %s", amt, from)
+	}
+	b.SetBalance(ctx, from, balance)
+	b.SetBalance(ctx, to, b.GetBalance(ctx, to).Add(amt...))
 	return nil
 }
 
+// MockStakingKeeper is the staking keeper of the fee keeper returned by FeeKeeperWithMocks,
+// it resolves the validators set by their consensus addresses.
+type MockStakingKeeper struct {
+	Validators map[string]stakingtypes.Validator
+}
+
+func (s MockStakingKeeper) GetValidatorByConsAddr(_ context.Context, consAddr sdk.ConsAddress) (stakingtypes.Validator, error) {
+	validator, ok := s.Validators[consAddr.String()]
+	if !ok {
+		return stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound
+	}
+	return validator, nil
+}
+
 func FeeKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	k, ctx, _, _ := FeeKeeperWithMocks(t)
+	return k, ctx
+}
+
+// FeeKeeperWithMocks returns a fee keeper together with its mocked bank and staking keepers.
+func FeeKeeperWithMocks(t testing.TB) (keeper.Keeper, sdk.Context, MockBankKeeper, MockStakingKeeper) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
-	transientStoreKey := storetypes.NewTransientStoreKey(types.StoreKey)
+	transientStoreKey := storetypes.NewKVStoreKey(types.TransientStoreKey)
+	bankStoreKey := storetypes.NewKVStoreKey("mock_bank")
 
 	db := dbm.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
 	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(transientStoreKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(bankStoreKey, storetypes.StoreTypeIAVL, db)
 	require.NoError(t, stateStore.LoadLatestVersion())
 
 	registry := codectypes.NewInterfaceRegistry()
 	cdc := codec.NewProtoCodec(registry)
 	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
 
+	bankKeeper := MockBankKeeper{storeKey: bankStoreKey}
+	stakingKeeper := MockStakingKeeper{Validators: make(map[string]stakingtypes.Validator)}
 	k := keeper.NewKeeper(
 		cdc,
 		runtime.NewKVStoreService(storeKey),
 		mockTransientStoreService{transientStoreKey},
+		bankKeeper,
+		stakingKeeper,
 		log.NewNopLogger(),
 		authority.String(),
 	)
@@ -59,5 +143,5 @@ func FeeKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		panic(err)
 	}
 
-	return k, ctx
+	return k, ctx, bankKeeper, stakingKeeper
 }
@@ -0,0 +1,370 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	aspect "github.com/artela-network/aspect-core/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/artela-network/artela-rollkit/x/aspect/store"
+	"github.com/artela-network/artela-rollkit/x/aspect/types"
+)
+
+// InitAspects writes the aspects, account bindings and paymaster allowances of the genesis state
+// to the store, using the latest version of the aspect stores.
+func (k Keeper) InitAspects(ctx sdk.Context, genState types.GenesisState) error {
+	for _, genAspect := range genState.Aspects {
+		if err := k.initAspect(ctx, genAspect); err != nil {
+			return fmt.Errorf("init aspect %s: %w", genAspect.AspectId, err)
+		}
+	}
+
+	for _, genAccount := range genState.Accounts {
+		if err := k.initAccount(ctx, genAccount); err != nil {
+			return fmt.Errorf("init account %s: %w", genAccount.Account, err)
+		}
+	}
+
+	storeCtx := types.NewGasFreeStoreContext(ctx, k.GetEVMStoreService(), k.storeService)
+	for _, genAllowance := range genState.PaymasterAllowances {
+		allowance, err := genAllowance.ToAllowance()
+		if err != nil {
+			return err
+		}
+		if err := store.StorePaymasterAllowance(storeCtx, common.HexToAddress(genAllowance.Paymaster),
+			common.HexToAddress(genAllowance.AspectId), allowance); err != nil {
+			return fmt.Errorf("init allowance of paymaster %s for aspect %s: %w", genAllowance.Paymaster, genAllowance.AspectId, err)
+		}
+	}
+
+	return nil
+}
+
+// ExportPaymasterAllowances reads the spending limits set by the paymasters for the deployed aspects. The limits
+// set for unknown aspects can never be spent, so they are not exported. A spent exceeding a lowered limit is
+// capped at the limit, which leaves the same zero remaining allowance.
+func (k Keeper) ExportPaymasterAllowances(ctx sdk.Context) ([]types.GenesisPaymasterAllowance, error) {
+	aspectIDs := make(map[common.Address]struct{})
+	if err := k.iterateAspectIDs(ctx, nil, func(aspectID common.Address) (bool, error) {
+		aspectIDs[aspectID] = struct{}{}
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	allowances := make([]types.GenesisPaymasterAllowance, 0)
+	storeCtx := types.NewGasFreeStoreContext(ctx, k.GetEVMStoreService(), k.storeService)
+	err := store.IteratePaymasterAllowances(storeCtx, func(paymaster, aspectID common.Address, allowance *types.PaymasterAllowance) bool {
+		if _, ok := aspectIDs[aspectID]; !ok || !allowance.Enabled() {
+			return false
+		}
+
+		spent := allowance.Spent
+		if spent.Cmp(allowance.Limit) > 0 {
+			spent = allowance.Limit
+		}
+		allowances = append(allowances, types.GenesisPaymasterAllowance{
+			Paymaster: paymaster.Hex(),
+			AspectId:  aspectID.Hex(),
+			Limit:     allowance.Limit.String(),
+			Spent:     spent.String(),
+		})
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return allowances, nil
+}
+
+// ExportAspects reads all the deployed aspects and their account bindings from the store.
+// Aspects stored with any protocol version are exported, so the result can be imported
+// into a chain running the latest store version.
+func (k Keeper) ExportAspects(ctx sdk.Context) ([]types.GenesisAspect, []types.GenesisAccount, error) {
+	aspects := make([]types.GenesisAspect, 0)
+	joinPoints := make(map[common.Address][]uint64)
+	boundAccounts := make(map[common.Address]struct{})
+
+	err := k.iterateAspectIDs(ctx, nil, func(aspectID common.Address) (bool, error) {
+		genAspect, aspectBoundAccounts, err := k.exportAspect(ctx, aspectID)
+		if err != nil {
+			return true, fmt.Errorf("export aspect %s: %w", aspectID.Hex(), err)
+		}
+
+		versionJoinPoints := make([]uint64, 0, len(genAspect.Versions))
+		for _, version := range genAspect.Versions {
+			versionJoinPoints = append(versionJoinPoints, version.JoinPoint)
+		}
+		joinPoints[aspectID] = versionJoinPoints
+
+		for _, account := range aspectBoundAccounts {
+			boundAccounts[account] = struct{}{}
+		}
+
+		aspects = append(aspects, *genAspect)
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	accountAddrs := make([]common.Address, 0, len(boundAccounts))
+	for account := range boundAccounts {
+		accountAddrs = append(accountAddrs, account)
+	}
+	sort.Slice(accountAddrs, func(i, j int) bool {
+		return bytes.Compare(accountAddrs[i].Bytes(), accountAddrs[j].Bytes()) < 0
+	})
+
+	// bindings kept on the account side are the ones taking effect during execution,
+	// so the aspect side bindings are rebuilt from them
+	accounts := make([]types.GenesisAccount, 0, len(accountAddrs))
+	aspectBindings := make(map[common.Address][]types.AspectBinding)
+	for _, account := range accountAddrs {
+		accountStore, _, err := store.GetAccountStore(k.newAccountStoreCtx(ctx, account))
+		if err != nil {
+			return nil, nil, fmt.Errorf("export account %s: %w", account.Hex(), err)
+		}
+
+		bindings, err := accountStore.LoadAccountBoundAspects(types.BindingFilter{})
+		if err != nil {
+			return nil, nil, fmt.Errorf("export account %s: %w", account.Hex(), err)
+		}
+		if len(bindings) == 0 {
+			continue
+		}
+
+		genAccount := types.GenesisAccount{
+			Account:  account.Hex(),
+			Bindings: make([]types.AspectBinding, 0, len(bindings)),
+		}
+		for _, binding := range bindings {
+			aspectID := binding.Account
+			versionJoinPoints, ok := joinPoints[aspectID]
+			if !ok || binding.Version == 0 || binding.Version > uint64(len(versionJoinPoints)) {
+				return nil, nil, fmt.Errorf("export account %s: bound to unknown aspect %s version %d",
+					account.Hex(), aspectID.Hex(), binding.Version)
+			}
+
+			joinPoint := uint64(binding.JoinPoint)
+			if joinPoint == 0 {
+				// join point is not saved in v0 account store, so we take it from the bound version
+				joinPoint = versionJoinPoints[binding.Version-1]
+			}
+
+			genAccount.Bindings = append(genAccount.Bindings, types.AspectBinding{
+				Address:   aspectID.Hex(),
+				Version:   binding.Version,
+				Priority:  int32(binding.Priority),
+				JoinPoint: joinPoint,
+			})
+			aspectBindings[aspectID] = append(aspectBindings[aspectID], types.AspectBinding{
+				Address:   account.Hex(),
+				Version:   binding.Version,
+				Priority:  int32(binding.Priority),
+				JoinPoint: joinPoint,
+			})
+		}
+		accounts = append(accounts, genAccount)
+	}
+
+	for i := range aspects {
+		aspectID := common.HexToAddress(aspects[i].AspectId)
+		if bindings, ok := aspectBindings[aspectID]; ok {
+			aspects[i].Bindings = bindings
+		}
+	}
+
+	return aspects, accounts, nil
+}
+
+// exportAspect exports the given aspect without bindings, and returns the accounts bound to it.
+func (k Keeper) exportAspect(ctx sdk.Context, aspectID common.Address) (*types.GenesisAspect, []common.Address, error) {
+	metaStore, info, err := k.loadAspect(ctx, aspectID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	genAspect := &types.GenesisAspect{
+		AspectId:  info.AspectId,
+		PayMaster: info.PayMaster,
+		Proof:     info.Proof,
+		Owner:     info.Owner,
+		Status:    info.Status,
+		Versions:  make([]types.GenesisAspectVersion, 0, info.LatestVersion),
+		Bindings:  make([]types.AspectBinding, 0),
+		State:     make([]types.GenesisAspectState, 0),
+	}
+
+	for version := uint64(1); version <= info.LatestVersion; version++ {
+		meta, err := metaStore.GetVersionMeta(version)
+		if err != nil {
+			return nil, nil, err
+		}
+		code, err := metaStore.GetCode(version)
+		if err != nil {
+			return nil, nil, err
+		}
+		properties, err := metaStore.GetProperties(version)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		genVersion := types.GenesisAspectVersion{
+			Version:    version,
+			JoinPoint:  meta.JoinPoint,
+			Code:       code,
+			CodeHash:   crypto.Keccak256Hash(code).Hex(),
+			Properties: make([]types.AspectProperty, 0, len(properties)),
+		}
+		for _, property := range properties {
+			genVersion.Properties = append(genVersion.Properties, types.AspectProperty{
+				Key:   property.Key,
+				Value: property.Value,
+			})
+		}
+		genAspect.Versions = append(genAspect.Versions, genVersion)
+	}
+
+	bindings, err := metaStore.LoadAspectBoundAccounts()
+	if err != nil {
+		return nil, nil, err
+	}
+	accounts := make([]common.Address, 0, len(bindings))
+	for _, binding := range bindings {
+		accounts = append(accounts, binding.Account)
+	}
+
+	stateStore, err := store.GetAspectStateStore(k.newAspectStoreCtx(ctx, aspectID))
+	if err != nil {
+		return nil, nil, err
+	}
+	stateStore.IterateState(func(key, value []byte) bool {
+		genAspect.State = append(genAspect.State, types.GenesisAspectState{
+			Key:   common.CopyBytes(key),
+			Value: common.CopyBytes(value),
+		})
+		return false
+	})
+
+	return genAspect, accounts, nil
+}
+
+func (k Keeper) initAspect(ctx sdk.Context, genAspect types.GenesisAspect) error {
+	aspectID := common.HexToAddress(genAspect.AspectId)
+	storeCtx := k.newAspectStoreCtx(ctx, aspectID)
+	metaStore, _, err := store.GetAspectMetaStore(storeCtx)
+	if err != nil {
+		return err
+	}
+
+	if used, err := metaStore.Used(); err != nil {
+		return err
+	} else if used {
+		return store.ErrAlreadyDeployed
+	}
+	if err := metaStore.Init(); err != nil {
+		return err
+	}
+
+	for _, genVersion := range genAspect.Versions {
+		version, err := metaStore.BumpVersion()
+		if err != nil {
+			return err
+		}
+		if version != genVersion.Version {
+			return fmt.Errorf("unexpected version %d, expected %d", genVersion.Version, version)
+		}
+
+		if err := metaStore.StoreCode(version, genVersion.Code); err != nil {
+			return err
+		}
+		if err := metaStore.StoreVersionMeta(version, &types.VersionMeta{
+			JoinPoint: genVersion.JoinPoint,
+			CodeHash:  crypto.Keccak256Hash(genVersion.Code),
+		}); err != nil {
+			return err
+		}
+
+		properties := make([]types.Property, 0, len(genVersion.Properties))
+		for _, property := range genVersion.Properties {
+			properties = append(properties, types.Property{
+				Key:   property.Key,
+				Value: property.Value,
+			})
+		}
+		if err := metaStore.StoreProperties(version, properties); err != nil {
+			return err
+		}
+	}
+
+	meta := &types.AspectMeta{
+		PayMaster: common.HexToAddress(genAspect.PayMaster),
+		Proof:     genAspect.Proof,
+		Status:    genAspect.Status,
+	}
+	if genAspect.Owner != "" {
+		owner := common.HexToAddress(genAspect.Owner)
+		meta.Owner = &owner
+	}
+	if err := metaStore.StoreMeta(meta); err != nil {
+		return err
+	}
+
+	for _, binding := range genAspect.Bindings {
+		if err := metaStore.StoreBinding(common.HexToAddress(binding.Address),
+			binding.Version, binding.JoinPoint, int8(binding.Priority)); err != nil {
+			return err
+		}
+	}
+
+	stateStore, err := store.GetAspectStateStore(storeCtx)
+	if err != nil {
+		return err
+	}
+	for _, state := range genAspect.State {
+		stateStore.SetState(state.Key, state.Value)
+	}
+
+	return nil
+}
+
+func (k Keeper) initAccount(ctx sdk.Context, genAccount types.GenesisAccount) error {
+	account := common.HexToAddress(genAccount.Account)
+	accountStore, _, err := store.GetAccountStore(k.newAccountStoreCtx(ctx, account))
+	if err != nil {
+		return err
+	}
+
+	if used, err := accountStore.Used(); err != nil {
+		return err
+	} else if !used {
+		if err := accountStore.Init(); err != nil {
+			return err
+		}
+	}
+
+	// whether the account is a contract is not kept in genesis, EOAs can only be bound
+	// with verifiers, so the account is treated as a contract if any non-verifier is bound
+	isCA := false
+	for _, binding := range genAccount.Bindings {
+		if !aspect.CheckIsTxVerifier(int64(binding.JoinPoint)) {
+			isCA = true
+			break
+		}
+	}
+
+	for _, binding := range genAccount.Bindings {
+		if err := accountStore.StoreBinding(common.HexToAddress(binding.Address),
+			binding.Version, binding.JoinPoint, int8(binding.Priority), isCA); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
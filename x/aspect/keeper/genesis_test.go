@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/artela-network/artela-rollkit/testutil/keeper"
+	"github.com/artela-network/artela-rollkit/x/aspect/store"
+	v0 "github.com/artela-network/artela-rollkit/x/aspect/store/v0"
+	"github.com/artela-network/artela-rollkit/x/aspect/types"
+)
+
+func TestExportImportAspects(t *testing.T) {
+	k, ctx := keepertest.AspectKeeper(t)
+	setupTestAspects(t, k, ctx)
+
+	v1State, err := store.GetAspectStateStore(&types.AspectStoreContext{StoreContext: storeCtx(k, ctx), AspectID: testAspectV1})
+	require.NoError(t, err)
+	v1State.SetState([]byte("counter"), []byte{1})
+	v0State := v0.NewStateStore(&types.AspectStoreContext{StoreContext: storeCtx(k, ctx), AspectID: testAspectV0})
+	v0State.SetState([]byte("b"), []byte("2"))
+	v0State.SetState([]byte("a"), []byte("1"))
+
+	// the limit of the v0 aspect is lowered below the spent, and the limit of an unknown aspect is never spent
+	allowances := map[common.Address][2]int64{
+		testAspectV1: {1000, 400},
+		testAspectV0: {100, 150},
+		common.HexToAddress("0x0000000000000000000000000000000000000dead"): {1000, 0},
+	}
+	for aspectID, allowance := range allowances {
+		require.NoError(t, store.StorePaymasterAllowance(storeCtx(k, ctx), testPayMaster, aspectID, &types.PaymasterAllowance{
+			Limit: big.NewInt(allowance[0]),
+			Spent: big.NewInt(allowance[1]),
+		}))
+	}
+
+	aspects, accounts, err := k.ExportAspects(ctx)
+	require.NoError(t, err)
+	require.Len(t, aspects, 2)
+
+	// v0 aspect
+	require.Equal(t, testAspectV0.Hex(), aspects[0].AspectId)
+	require.Equal(t, []byte("v0-proof"), aspects[0].Proof)
+	require.Equal(t, []types.GenesisAspectVersion{{
+		Version:    1,
+		JoinPoint:  2,
+		Code:       []byte("code-v0"),
+		CodeHash:   crypto.Keccak256Hash([]byte("code-v0")).Hex(),
+		Properties: []types.AspectProperty{{Key: "k", Value: []byte("v")}},
+	}}, aspects[0].Versions)
+	require.Equal(t, []types.GenesisAspectState{
+		{Key: []byte("a"), Value: []byte("1")},
+		{Key: []byte("b"), Value: []byte("2")},
+	}, aspects[0].State)
+
+	// v1 aspect
+	require.Equal(t, testAspectV1.Hex(), aspects[1].AspectId)
+	require.Len(t, aspects[1].Versions, 2)
+	require.Equal(t, []types.AspectBinding{{Address: testAccount.Hex(), Version: 2, JoinPoint: 1}}, aspects[1].Bindings)
+	require.Equal(t, []types.GenesisAspectState{{Key: []byte("counter"), Value: []byte{1}}}, aspects[1].State)
+
+	require.Equal(t, []types.GenesisAccount{{
+		Account:  testAccount.Hex(),
+		Bindings: []types.AspectBinding{{Address: testAspectV1.Hex(), Version: 2, JoinPoint: 1}},
+	}}, accounts)
+
+	paymasterAllowances, err := k.ExportPaymasterAllowances(ctx)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []types.GenesisPaymasterAllowance{
+		{Paymaster: testPayMaster.Hex(), AspectId: testAspectV1.Hex(), Limit: "1000", Spent: "400"},
+		// the spent is capped at the lowered limit, nothing can be spent either way
+		{Paymaster: testPayMaster.Hex(), AspectId: testAspectV0.Hex(), Limit: "100", Spent: "100"},
+	}, paymasterAllowances)
+
+	genState := types.GenesisState{
+		Params:              types.DefaultParams(),
+		Aspects:             aspects,
+		Accounts:            accounts,
+		PaymasterAllowances: paymasterAllowances,
+	}
+	require.NoError(t, genState.Validate())
+
+	// import into a fresh chain, all aspects are written with the latest store version
+	k2, ctx2 := keepertest.AspectKeeper(t)
+	require.NoError(t, k2.InitAspects(ctx2, genState))
+
+	res, err := k2.Aspect(ctx2, &types.QueryAspectRequest{AspectId: testAspectV0.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), res.Aspect.MetaVersion)
+
+	reexportedAspects, reexportedAccounts, err := k2.ExportAspects(ctx2)
+	require.NoError(t, err)
+	require.Equal(t, aspects, reexportedAspects)
+	require.Equal(t, accounts, reexportedAccounts)
+
+	reexportedAllowances, err := k2.ExportPaymasterAllowances(ctx2)
+	require.NoError(t, err)
+	require.Equal(t, paymasterAllowances, reexportedAllowances)
+
+	allowance, err := store.LoadPaymasterAllowance(storeCtx(k2, ctx2), testPayMaster, testAspectV1)
+	require.NoError(t, err)
+	require.True(t, allowance.Enabled())
+	require.Equal(t, big.NewInt(600), allowance.Remaining())
+
+	// importing the same aspects twice is rejected
+	require.Error(t, k2.InitAspects(ctx2, genState))
+}
@@ -7,6 +7,7 @@ import (
 	"cosmossdk.io/log"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/artela-network/artela-rollkit/x/aspect/types"
 )
@@ -17,6 +18,10 @@ type (
 		storeService store.KVStoreService
 		logger       log.Logger
 
+		// evmStoreService is the store service of x/evm, where the aspects deployed
+		// with protocol v0 are kept. It is set by x/evm, since x/evm depends on this keeper.
+		evmStoreService *store.KVStoreService
+
 		// the address capable of executing a MsgUpdateParams message. Typically, this
 		// should be the x/gov module account.
 		authority string
@@ -35,10 +40,11 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		cdc:          cdc,
-		storeService: storeService,
-		authority:    authority,
-		logger:       logger,
+		cdc:             cdc,
+		storeService:    storeService,
+		evmStoreService: new(store.KVStoreService),
+		authority:       authority,
+		logger:          logger,
 	}
 }
 
@@ -55,3 +61,29 @@ func (k Keeper) Logger() log.Logger {
 func (k Keeper) GetStoreService() store.KVStoreService {
 	return k.storeService
 }
+
+// SetEVMStoreService sets the x/evm store service, it is shared by all copies of the keeper.
+func (k Keeper) SetEVMStoreService(evmStoreService store.KVStoreService) {
+	*k.evmStoreService = evmStoreService
+}
+
+// GetEVMStoreService returns the x/evm store service.
+func (k Keeper) GetEVMStoreService() store.KVStoreService {
+	return *k.evmStoreService
+}
+
+// newAspectStoreCtx builds a gas free store context for accessing the given aspect.
+func (k Keeper) newAspectStoreCtx(ctx sdk.Context, aspectID common.Address) *types.AspectStoreContext {
+	return &types.AspectStoreContext{
+		StoreContext: types.NewGasFreeStoreContext(ctx, k.GetEVMStoreService(), k.storeService),
+		AspectID:     aspectID,
+	}
+}
+
+// newAccountStoreCtx builds a gas free store context for accessing the given account.
+func (k Keeper) newAccountStoreCtx(ctx sdk.Context, account common.Address) *types.AccountStoreContext {
+	return &types.AccountStoreContext{
+		StoreContext: types.NewGasFreeStoreContext(ctx, k.GetEVMStoreService(), k.storeService),
+		Account:      account,
+	}
+}
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/artela-network/artela-rollkit/x/aspect/store"
+	v0 "github.com/artela-network/artela-rollkit/x/aspect/store/v0"
+	"github.com/artela-network/artela-rollkit/x/aspect/types"
+	evmtypes "github.com/artela-network/artela-rollkit/x/evm/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates all the aspects and accounts saved with protocol v0 to the latest protocol version.
+// Aspects and accounts not migrated here are still migrated lazily on their first write.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	aspectIDs := m.keeper.collectV0Addresses(ctx, v0.V0AspectCodeVersionKeyPrefix)
+	for _, aspectID := range aspectIDs {
+		current, newStore, err := store.GetAspectMetaStore(m.keeper.newAspectStoreCtx(ctx, aspectID))
+		if err != nil {
+			return fmt.Errorf("migrate aspect %s: %w", aspectID.Hex(), err)
+		}
+		if _, err := store.MigrateAspectMetaStore(current, newStore); err != nil {
+			return fmt.Errorf("migrate aspect %s: %w", aspectID.Hex(), err)
+		}
+	}
+
+	accounts := m.keeper.collectV0Addresses(ctx, v0.V0VerifierBindingKeyPrefix, v0.V0ContractBindKeyPrefix)
+	for _, account := range accounts {
+		current, newStore, err := store.GetAccountStore(m.keeper.newAccountStoreCtx(ctx, account))
+		if err != nil {
+			return fmt.Errorf("migrate account %s: %w", account.Hex(), err)
+		}
+		if _, err := store.MigrateAccountStore(current, newStore); err != nil {
+			return fmt.Errorf("migrate account %s: %w", account.Hex(), err)
+		}
+	}
+
+	m.keeper.Logger().Info("migrated aspect store to latest protocol version",
+		"aspects", len(aspectIDs), "accounts", len(accounts))
+	return nil
+}
+
+// Migrate2to3 initializes the aspect limits and storage costs params, which were hard-coded before.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return m.keeper.SetParams(ctx, types.DefaultParams())
+}
+
+// collectV0Addresses collects the addresses keyed with format {address}/ under the given v0 prefixes.
+// Addresses are collected before migrating, so the evm store is not iterated while being read.
+func (k Keeper) collectV0Addresses(ctx sdk.Context, prefixKeys ...string) []common.Address {
+	evmStore := runtime.KVStoreAdapter(k.GetEVMStoreService().OpenKVStore(ctx))
+
+	seen := make(map[common.Address]struct{})
+	addresses := make([]common.Address, 0)
+	for _, prefixKey := range prefixKeys {
+		iter := storetypes.KVStorePrefixIterator(prefix.NewStore(evmStore, evmtypes.KeyPrefix(prefixKey)), nil)
+		for ; iter.Valid(); iter.Next() {
+			key := iter.Key()
+			if len(key) != common.AddressLength+v0.PathSeparatorLen {
+				continue
+			}
+
+			address := common.BytesToAddress(key[:common.AddressLength])
+			if _, ok := seen[address]; ok {
+				continue
+			}
+			seen[address] = struct{}{}
+			addresses = append(addresses, address)
+		}
+		iter.Close()
+	}
+
+	return addresses
+}
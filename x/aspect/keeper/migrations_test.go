@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/artela-network/artela-rollkit/testutil/keeper"
+	"github.com/artela-network/artela-rollkit/x/aspect/keeper"
+	"github.com/artela-network/artela-rollkit/x/aspect/store"
+	v0 "github.com/artela-network/artela-rollkit/x/aspect/store/v0"
+	"github.com/artela-network/artela-rollkit/x/aspect/types"
+)
+
+var testContract = common.HexToAddress("0x00000000000000000000000000000000000000c2")
+
+// setupV0Binding binds the v0 test aspect to a contract with the v0 layout.
+func setupV0Binding(t *testing.T, k keeper.Keeper, ctx sdk.Context) {
+	metaStore := v0.NewAspectMetaStore(&types.AspectStoreContext{StoreContext: storeCtx(k, ctx), AspectID: testAspectV0}, nil)
+	require.NoError(t, metaStore.StoreBinding(testContract, 1, 2, 3))
+
+	accountStore := v0.NewAccountStore(&types.AccountStoreContext{StoreContext: storeCtx(k, ctx), Account: testContract})
+	require.NoError(t, accountStore.StoreBinding(testAspectV0, 1, 2, 3, true))
+
+	v0.NewStateStore(&types.AspectStoreContext{StoreContext: storeCtx(k, ctx), AspectID: testAspectV0}).
+		SetState([]byte("key"), []byte("value"))
+}
+
+func TestMigrate1to2(t *testing.T) {
+	k, ctx := keepertest.AspectKeeper(t)
+	setupTestAspects(t, k, ctx)
+	setupV0Binding(t, k, ctx)
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate1to2(ctx))
+
+	res, err := k.Aspect(ctx, &types.QueryAspectRequest{AspectId: testAspectV0.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, types.AspectInfo{
+		AspectId:      testAspectV0.Hex(),
+		LatestVersion: 1,
+		PayMaster:     testPayMaster.Hex(),
+		Proof:         []byte("v0-proof"),
+		MetaVersion:   1,
+	}, res.Aspect)
+
+	code, err := k.AspectCode(ctx, &types.QueryAspectCodeRequest{AspectId: testAspectV0.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, []byte("code-v0"), code.Code)
+
+	properties, err := k.AspectProperties(ctx, &types.QueryAspectPropertiesRequest{AspectId: testAspectV0.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, []types.AspectProperty{{Key: "k", Value: []byte("v")}}, properties.Properties)
+
+	expected := types.AspectBinding{Version: 1, Priority: 3, JoinPoint: 2}
+	aspectBindings, err := k.AspectBindings(ctx, &types.QueryAspectBindingsRequest{AspectId: testAspectV0.Hex()})
+	require.NoError(t, err)
+	expected.Address = testContract.Hex()
+	require.Equal(t, []types.AspectBinding{expected}, aspectBindings.Bindings)
+
+	accountStore, newAccountStore, err := store.GetAccountStore(&types.AccountStoreContext{StoreContext: storeCtx(k, ctx), Account: testContract})
+	require.NoError(t, err)
+	require.Nil(t, newAccountStore)
+	require.Equal(t, store.ProtocolVersion(1), accountStore.Version())
+
+	accountBindings, err := k.AccountBindings(ctx, &types.QueryAccountBindingsRequest{Account: testContract.Hex()})
+	require.NoError(t, err)
+	expected.Address = testAspectV0.Hex()
+	require.Equal(t, []types.AspectBinding{expected}, accountBindings.Bindings)
+
+	// states are kept in v0 layout
+	stateStore, err := store.GetAspectStateStore(&types.AspectStoreContext{StoreContext: storeCtx(k, ctx), AspectID: testAspectV0})
+	require.NoError(t, err)
+	require.Equal(t, store.ProtocolVersion(0), stateStore.Version())
+	require.Equal(t, []byte("value"), stateStore.GetState([]byte("key")))
+
+	// migrating again is a no-op
+	require.NoError(t, keeper.NewMigrator(k).Migrate1to2(ctx))
+}
+
+func TestLazyMigrationGas(t *testing.T) {
+	k, ctx := keepertest.AspectKeeper(t)
+	setupTestAspects(t, k, ctx)
+	setupV0Binding(t, k, ctx)
+
+	newStoreCtx := func(ctx sdk.Context, gas uint64) *types.AspectStoreContext {
+		return &types.AspectStoreContext{
+			StoreContext: types.NewStoreContext(ctx, k.GetEVMStoreService(), k.GetStoreService(), gas),
+			AspectID:     testAspectV0,
+		}
+	}
+
+	// not enough gas to migrate, the failed tx is reverted
+	cacheCtx, _ := ctx.CacheContext()
+	current, newStore, err := store.GetAspectMetaStore(newStoreCtx(cacheCtx, 100))
+	require.NoError(t, err)
+	require.NotNil(t, newStore)
+	_, err = store.MigrateAspectMetaStore(current, newStore)
+	require.Error(t, err)
+
+	// migration is charged
+	const gas = 10_000_000
+	current, newStore, err = store.GetAspectMetaStore(newStoreCtx(ctx, gas))
+	require.NoError(t, err)
+	migrated, err := store.MigrateAspectMetaStore(current, newStore)
+	require.NoError(t, err)
+	require.Equal(t, store.ProtocolVersion(1), migrated.Version())
+	require.Less(t, migrated.Gas(), uint64(gas))
+
+	// aspect is now on the latest version, no more migration needed
+	current, newStore, err = store.GetAspectMetaStore(newStoreCtx(ctx, gas))
+	require.NoError(t, err)
+	require.Nil(t, newStore)
+	require.Equal(t, store.ProtocolVersion(1), current.Version())
+}
+
+func TestMigrate2to3(t *testing.T) {
+	k, ctx := keepertest.AspectKeeper(t)
+	require.NoError(t, k.SetParams(ctx, types.Params{}))
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate2to3(ctx))
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+}
+
+func TestMigrate1to3WithoutParams(t *testing.T) {
+	k, ctx := keepertest.AspectKeeper(t)
+	// v1 stores have no aspect params, they decode to zero params
+	require.NoError(t, k.SetParams(ctx, types.Params{}))
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+	require.Equal(t, types.DefaultParams(), storeCtx(k, ctx).Params())
+
+	setupTestAspects(t, k, ctx)
+	setupV0Binding(t, k, ctx)
+
+	m := keeper.NewMigrator(k)
+	require.NoError(t, m.Migrate1to2(ctx))
+	require.NoError(t, m.Migrate2to3(ctx))
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+
+	accountBindings, err := k.AccountBindings(ctx, &types.QueryAccountBindingsRequest{Account: testContract.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, []types.AspectBinding{{Address: testAspectV0.Hex(), Version: 1, Priority: 3, JoinPoint: 2}}, accountBindings.Bindings)
+}
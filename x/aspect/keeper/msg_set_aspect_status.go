@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/artela-network/artela-rollkit/x/aspect/store"
+	"github.com/artela-network/artela-rollkit/x/aspect/types"
+)
+
+// SetAspectStatus changes the execution status of an aspect on behalf of the module authority,
+// so a misbehaving aspect can be paused without the cooperation of its owner.
+func (k msgServer) SetAspectStatus(goCtx context.Context, req *types.MsgSetAspectStatus) (*types.MsgSetAspectStatusResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+	if !common.IsHexAddress(req.AspectId) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAspectStatus, "invalid aspect id %s", req.AspectId)
+	}
+	if _, ok := types.AspectStatus_name[int32(req.Status)]; !ok {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAspectStatus, "unknown status %d", req.Status)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	aspectID := common.HexToAddress(req.AspectId)
+	currentStore, newStore, err := store.GetAspectMetaStore(k.newAspectStoreCtx(ctx, aspectID))
+	if err != nil {
+		return nil, err
+	}
+
+	if latestVersion, err := currentStore.GetLatestVersion(); err != nil {
+		return nil, err
+	} else if latestVersion == 0 {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAspectStatus, "aspect %s not deployed", aspectID.Hex())
+	}
+
+	// status can only be saved in the latest store version
+	metaStore, err := store.MigrateAspectMetaStore(currentStore, newStore)
+	if err != nil {
+		return nil, err
+	}
+
+	meta, err := metaStore.GetMeta()
+	if err != nil {
+		return nil, err
+	}
+
+	previousStatus := meta.Status
+	meta.Status = req.Status
+	if err := metaStore.StoreMeta(meta); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAspectStatusChanged{
+		AspectId:       aspectID.Hex(),
+		PreviousStatus: previousStatus,
+		Status:         req.Status,
+		Operator:       req.Authority,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetAspectStatusResponse{}, nil
+}
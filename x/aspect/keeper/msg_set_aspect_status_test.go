@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela-rollkit/x/aspect/types"
+)
+
+func TestMsgSetAspectStatus(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx)
+	setupTestAspects(t, k, wctx)
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgSetAspectStatus
+		expErrMsg string
+	}{
+		{
+			name: "invalid authority",
+			input: &types.MsgSetAspectStatus{
+				Authority: "invalid",
+				AspectId:  testAspectV1.Hex(),
+				Status:    types.AspectStatus_ASPECT_STATUS_PAUSED,
+			},
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "unknown status",
+			input: &types.MsgSetAspectStatus{
+				Authority: k.GetAuthority(),
+				AspectId:  testAspectV1.Hex(),
+				Status:    types.AspectStatus(100),
+			},
+			expErrMsg: "unknown status",
+		},
+		{
+			name: "aspect not deployed",
+			input: &types.MsgSetAspectStatus{
+				Authority: k.GetAuthority(),
+				AspectId:  testAccount.Hex(),
+				Status:    types.AspectStatus_ASPECT_STATUS_PAUSED,
+			},
+			expErrMsg: "not deployed",
+		},
+		{
+			name: "pause v1 aspect",
+			input: &types.MsgSetAspectStatus{
+				Authority: k.GetAuthority(),
+				AspectId:  testAspectV1.Hex(),
+				Status:    types.AspectStatus_ASPECT_STATUS_PAUSED,
+			},
+		},
+		{
+			name: "deprecate v0 aspect",
+			input: &types.MsgSetAspectStatus{
+				Authority: k.GetAuthority(),
+				AspectId:  testAspectV0.Hex(),
+				Status:    types.AspectStatus_ASPECT_STATUS_DEPRECATED,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.SetAspectStatus(wctx, tc.input)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+
+			res, err := k.Aspect(wctx, &types.QueryAspectRequest{AspectId: tc.input.AspectId})
+			require.NoError(t, err)
+			require.Equal(t, tc.input.Status, res.Aspect.Status)
+			// status is kept with the latest store version, so v0 aspects are migrated
+			require.Equal(t, uint32(1), res.Aspect.MetaVersion)
+		})
+	}
+
+	// governance can bring a paused aspect back
+	_, err := ms.SetAspectStatus(wctx, &types.MsgSetAspectStatus{
+		Authority: k.GetAuthority(),
+		AspectId:  testAspectV1.Hex(),
+		Status:    types.AspectStatus_ASPECT_STATUS_ACTIVE,
+	})
+	require.NoError(t, err)
+	res, err := k.Aspect(wctx, &types.QueryAspectRequest{AspectId: testAspectV1.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, types.AspectStatus_ASPECT_STATUS_ACTIVE, res.Aspect.Status)
+	require.Equal(t, testPayMaster.Hex(), res.Aspect.PayMaster)
+}
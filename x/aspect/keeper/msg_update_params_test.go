@@ -32,12 +32,40 @@ func TestMsgUpdateParams(t *testing.T) {
 			expErrMsg: "invalid authority",
 		},
 		{
-			name: "send enabled param",
+			name: "invalid params",
 			input: &types.MsgUpdateParams{
 				Authority: k.GetAuthority(),
 				Params:    types.Params{},
 			},
-			expErr: false,
+			expErr:    true,
+			expErrMsg: "cannot be 0",
+		},
+		{
+			name: "code hash both allowed and denied",
+			input: &types.MsgUpdateParams{
+				Authority: k.GetAuthority(),
+				Params: func() types.Params {
+					p := types.DefaultParams()
+					p.AllowedCodeHashes = []string{"0x0000000000000000000000000000000000000000000000000000000000000001"}
+					p.DeniedCodeHashes = []string{"0x0000000000000000000000000000000000000000000000000000000000000001"}
+					return p
+				}(),
+			},
+			expErr:    true,
+			expErrMsg: "both allowed and denied",
+		},
+		{
+			name: "invalid allowed deployer",
+			input: &types.MsgUpdateParams{
+				Authority: k.GetAuthority(),
+				Params: func() types.Params {
+					p := types.DefaultParams()
+					p.AllowedDeployers = []string{"deployer"}
+					return p
+				}(),
+			},
+			expErr:    true,
+			expErrMsg: "invalid address",
 		},
 		{
 			name: "all good",
@@ -12,12 +12,12 @@ import (
 func (k Keeper) GetParams(ctx context.Context) (params types.Params) {
 	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	bz := store.Get(types.ParamsKey)
-	if bz == nil {
-		return params
+	if bz != nil {
+		k.cdc.MustUnmarshal(bz, &params)
 	}
 
-	k.cdc.MustUnmarshal(bz, &params)
-	return params
+	// params are not stored until the module is migrated to version 3
+	return types.ParamsOrDefault(params)
 }
 
 // SetParams set the params
@@ -3,9 +3,11 @@ package keeper_test
 import (
 	"testing"
 
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 
 	keepertest "github.com/artela-network/artela-rollkit/testutil/keeper"
+	"github.com/artela-network/artela-rollkit/x/aspect/store"
 	"github.com/artela-network/artela-rollkit/x/aspect/types"
 )
 
@@ -16,3 +18,51 @@ func TestGetParams(t *testing.T) {
 	require.NoError(t, k.SetParams(ctx, params))
 	require.EqualValues(t, params, k.GetParams(ctx))
 }
+
+func TestParamsLimitAspectStore(t *testing.T) {
+	k, ctx := keepertest.AspectKeeper(t)
+	params := types.DefaultParams()
+	params.MaxProperties = 1
+	params.MaxAccountBindings = 1
+	require.NoError(t, k.SetParams(ctx, params))
+
+	metaStore, _, err := store.GetAspectMetaStore(&types.AspectStoreContext{StoreContext: storeCtx(k, ctx), AspectID: testAspectV1})
+	require.NoError(t, err)
+	require.NoError(t, metaStore.Init())
+	version, err := metaStore.BumpVersion()
+	require.NoError(t, err)
+	require.ErrorIs(t, metaStore.StoreProperties(version, []types.Property{
+		{Key: "a", Value: []byte("1")},
+		{Key: "b", Value: []byte("2")},
+	}), store.ErrTooManyProperties)
+	require.NoError(t, metaStore.StoreProperties(version, []types.Property{{Key: "a", Value: []byte("1")}}))
+
+	accountStore, _, err := store.GetAccountStore(&types.AccountStoreContext{StoreContext: storeCtx(k, ctx), Account: testAccount})
+	require.NoError(t, err)
+	require.NoError(t, accountStore.Init())
+	require.NoError(t, accountStore.StoreBinding(testAspectV1, 1, 1, 0, true))
+	require.ErrorIs(t, accountStore.StoreBinding(testAspectV0, 1, 2, 0, true), store.ErrBindingLimitExceeded)
+}
+
+func TestParamsCodeHashLists(t *testing.T) {
+	allowed := crypto.Keccak256Hash([]byte("allowed"))
+	denied := crypto.Keccak256Hash([]byte("denied"))
+	other := crypto.Keccak256Hash([]byte("other"))
+
+	params := types.DefaultParams()
+	require.True(t, params.IsCodeHashAllowed(other))
+	require.True(t, params.IsDeployerAllowed(testAccount))
+
+	params.DeniedCodeHashes = []string{denied.Hex()}
+	require.NoError(t, params.Validate())
+	require.False(t, params.IsCodeHashAllowed(denied))
+	require.True(t, params.IsCodeHashAllowed(other))
+
+	params.AllowedCodeHashes = []string{allowed.Hex()}
+	params.AllowedDeployers = []string{testPayMaster.Hex()}
+	require.NoError(t, params.Validate())
+	require.True(t, params.IsCodeHashAllowed(allowed))
+	require.False(t, params.IsCodeHashAllowed(other))
+	require.True(t, params.IsDeployerAllowed(testPayMaster))
+	require.False(t, params.IsDeployerAllowed(testAccount))
+}
@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/artela-network/artela-rollkit/testutil/keeper"
+	"github.com/artela-network/artela-rollkit/x/aspect/store"
+	"github.com/artela-network/artela-rollkit/x/aspect/types"
+)
+
+func TestPaymasterAllowance(t *testing.T) {
+	k, ctx := keepertest.AspectKeeper(t)
+	sctx := storeCtx(k, ctx)
+
+	// sponsoring is disabled until the paymaster sets a limit
+	allowance, err := store.LoadPaymasterAllowance(sctx, testPayMaster, testAspectV1)
+	require.NoError(t, err)
+	require.False(t, allowance.Enabled())
+
+	allowance.Limit = big.NewInt(1000)
+	require.NoError(t, store.StorePaymasterAllowance(sctx, testPayMaster, testAspectV1, allowance))
+
+	allowance, err = store.SpendPaymasterAllowance(sctx, testPayMaster, testAspectV1, big.NewInt(600))
+	require.NoError(t, err)
+	require.True(t, allowance.Enabled())
+	require.Equal(t, big.NewInt(400), allowance.Remaining())
+
+	// the fees exceeding the remaining limit are rejected, and nothing is spent
+	_, err = store.SpendPaymasterAllowance(sctx, testPayMaster, testAspectV1, big.NewInt(500))
+	require.ErrorIs(t, err, store.ErrPaymasterLimitExceeded)
+
+	// the refunded fees are given back to the limit
+	allowance, err = store.SpendPaymasterAllowance(sctx, testPayMaster, testAspectV1, big.NewInt(-200))
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(400), allowance.Spent)
+
+	// the limit is only spent by the aspect it is set for
+	_, err = store.SpendPaymasterAllowance(sctx, testPayMaster, testAspectV0, big.NewInt(1))
+	require.ErrorIs(t, err, store.ErrPaymasterLimitExceeded)
+	allowance, err = store.LoadPaymasterAllowance(sctx, testPayMaster, testAspectV0)
+	require.NoError(t, err)
+	require.False(t, allowance.Enabled())
+
+	allowance, err = store.LoadPaymasterAllowance(sctx, testPayMaster, testAspectV1)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1000), allowance.Limit)
+	require.Equal(t, big.NewInt(400), allowance.Spent)
+
+	// lowering the limit below the spent leaves nothing to sponsor
+	allowance.Limit = big.NewInt(300)
+	require.NoError(t, store.StorePaymasterAllowance(sctx, testPayMaster, testAspectV1, allowance))
+	allowance, err = store.LoadPaymasterAllowance(sctx, testPayMaster, testAspectV1)
+	require.NoError(t, err)
+	require.Zero(t, allowance.Remaining().Sign())
+
+	require.ErrorIs(t, store.StorePaymasterAllowance(sctx, testPayMaster, testAspectV1, &types.PaymasterAllowance{
+		Limit: big.NewInt(-1),
+		Spent: new(big.Int),
+	}), store.ErrInvalidPaymasterAllowance)
+}
@@ -0,0 +1,481 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	aspect "github.com/artela-network/aspect-core/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/artela-network/artela-rollkit/x/aspect/store"
+	v0 "github.com/artela-network/artela-rollkit/x/aspect/store/v0"
+	"github.com/artela-network/artela-rollkit/x/aspect/types"
+	evmtypes "github.com/artela-network/artela-rollkit/x/evm/types"
+)
+
+func (k Keeper) Aspect(goCtx context.Context, req *types.QueryAspectRequest) (*types.QueryAspectResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	aspectID, err := parseAddress(req.AspectId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	metaStore, info, err := k.loadAspect(ctx, aspectID)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]types.AspectVersion, 0, info.LatestVersion)
+	for version := uint64(1); version <= info.LatestVersion; version++ {
+		meta, err := metaStore.GetVersionMeta(version)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		codeHash := meta.CodeHash
+		if codeHash == (common.Hash{}) {
+			// code hash is not saved in v0 store, so we calculate it here
+			code, err := metaStore.GetCode(version)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			codeHash = crypto.Keccak256Hash(code)
+		}
+
+		versions = append(versions, types.AspectVersion{
+			Version:   version,
+			JoinPoint: meta.JoinPoint,
+			CodeHash:  codeHash.Hex(),
+		})
+	}
+
+	return &types.QueryAspectResponse{Aspect: *info, Versions: versions}, nil
+}
+
+func (k Keeper) AspectCode(goCtx context.Context, req *types.QueryAspectCodeRequest) (*types.QueryAspectCodeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	aspectID, err := parseAddress(req.AspectId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	metaStore, info, err := k.loadAspect(ctx, aspectID)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := resolveVersion(req.Version, info.LatestVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	code, err := metaStore.GetCode(version)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAspectCodeResponse{
+		Version:  version,
+		Code:     code,
+		CodeHash: crypto.Keccak256Hash(code).Hex(),
+	}, nil
+}
+
+func (k Keeper) AspectProperties(goCtx context.Context, req *types.QueryAspectPropertiesRequest) (*types.QueryAspectPropertiesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	aspectID, err := parseAddress(req.AspectId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	metaStore, info, err := k.loadAspect(ctx, aspectID)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := resolveVersion(req.Version, info.LatestVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	properties, err := metaStore.GetProperties(version)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := make([]types.AspectProperty, 0, len(properties))
+	for _, property := range properties {
+		result = append(result, types.AspectProperty{
+			Key:   property.Key,
+			Value: property.Value,
+		})
+	}
+
+	return &types.QueryAspectPropertiesResponse{Version: version, Properties: result}, nil
+}
+
+func (k Keeper) AspectBindings(goCtx context.Context, req *types.QueryAspectBindingsRequest) (*types.QueryAspectBindingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	aspectID, err := parseAddress(req.AspectId)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := parseJoinPointFilter(req.JoinPoint)
+	if err != nil {
+		return nil, err
+	}
+	page, err := parseBindingPage(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	metaStore, _, err := k.loadAspect(ctx, aspectID)
+	if err != nil {
+		return nil, err
+	}
+
+	bindings, next, err := metaStore.LoadAspectBoundAccountsPaged(filter, page)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAspectBindingsResponse{
+		Bindings:   toAspectBindings(bindings),
+		Pagination: bindingPageResponse(next),
+	}, nil
+}
+
+func (k Keeper) AccountBindings(goCtx context.Context, req *types.QueryAccountBindingsRequest) (*types.QueryAccountBindingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	account, err := parseAddress(req.Account)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := parseJoinPointFilter(req.JoinPoint)
+	if err != nil {
+		return nil, err
+	}
+	page, err := parseBindingPage(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	accountStore, _, err := store.GetAccountStore(k.newAccountStoreCtx(ctx, account))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	bindings, next, err := accountStore.LoadAccountBoundAspectsPaged(filter, page)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAccountBindingsResponse{
+		Bindings:   toAspectBindings(bindings),
+		Pagination: bindingPageResponse(next),
+	}, nil
+}
+
+func (k Keeper) AspectState(goCtx context.Context, req *types.QueryAspectStateRequest) (*types.QueryAspectStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	aspectID, err := parseAddress(req.AspectId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, _, err := k.loadAspect(ctx, aspectID); err != nil {
+		return nil, err
+	}
+
+	stateStore, err := store.GetAspectStateStore(k.newAspectStoreCtx(ctx, aspectID))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAspectStateResponse{Value: stateStore.GetState([]byte(req.Key))}, nil
+}
+
+// parseJoinPointFilter builds the binding filter of the given join point name, empty name matches all bindings.
+func parseJoinPointFilter(joinPoint string) (types.BindingFilter, error) {
+	if joinPoint == "" {
+		return types.BindingFilter{}, nil
+	}
+	if _, ok := aspect.JoinPointRunType_value[joinPoint]; !ok {
+		return types.BindingFilter{}, status.Errorf(codes.InvalidArgument, "invalid join point: %s", joinPoint)
+	}
+
+	return types.NewJoinPointFilter(aspect.PointCut(joinPoint)), nil
+}
+
+// parseBindingPage converts the page request of binding queries to the binding page of the stores.
+// The key of the request is the 8 bytes big endian cursor returned as the next key of the previous page,
+// and the offset is the position in the binding list to start from.
+func parseBindingPage(pageReq *query.PageRequest) (types.BindingPage, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Reverse {
+		return types.BindingPage{}, status.Error(codes.InvalidArgument, "reverse pagination is not supported")
+	}
+	if pageReq.CountTotal {
+		return types.BindingPage{}, status.Error(codes.InvalidArgument, "count total is not supported")
+	}
+	if len(pageReq.Key) > 0 && pageReq.Offset > 0 {
+		return types.BindingPage{}, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+	}
+
+	page := types.BindingPage{
+		Cursor: pageReq.Offset,
+		Limit:  pageReq.Limit,
+	}
+	if len(pageReq.Key) > 0 {
+		if len(pageReq.Key) != 8 {
+			return types.BindingPage{}, status.Error(codes.InvalidArgument, "invalid pagination key")
+		}
+		page.Cursor = binary.BigEndian.Uint64(pageReq.Key)
+	}
+	if page.Limit == 0 {
+		page.Limit = query.DefaultLimit
+	}
+
+	return page, nil
+}
+
+// bindingPageResponse builds the page response of binding queries with the cursor of the next page.
+func bindingPageResponse(next uint64) *query.PageResponse {
+	if next == 0 {
+		return &query.PageResponse{}
+	}
+
+	nextKey := make([]byte, 8)
+	binary.BigEndian.PutUint64(nextKey, next)
+	return &query.PageResponse{NextKey: nextKey}
+}
+
+func (k Keeper) Aspects(goCtx context.Context, req *types.QueryAspectsRequest) (*types.QueryAspectsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Reverse {
+		return nil, status.Error(codes.InvalidArgument, "reverse pagination is not supported")
+	}
+	if len(pageReq.Key) > 0 && pageReq.Offset > 0 {
+		return nil, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+	}
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	countTotal := pageReq.CountTotal && len(pageReq.Key) == 0
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	var (
+		count   uint64
+		nextKey []byte
+		aspects = make([]types.AspectInfo, 0)
+	)
+	err := k.iterateAspectIDs(ctx, pageReq.Key, func(aspectID common.Address) (bool, error) {
+		count++
+		if count <= pageReq.Offset {
+			return false, nil
+		}
+		if uint64(len(aspects)) == limit {
+			if nextKey == nil {
+				nextKey = aspectID.Bytes()
+			}
+			// keep iterating only if total count is required
+			return !countTotal, nil
+		}
+
+		_, info, err := k.loadAspect(ctx, aspectID)
+		if err != nil {
+			return true, err
+		}
+		aspects = append(aspects, *info)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if countTotal {
+		pageRes.Total = count
+	}
+
+	return &types.QueryAspectsResponse{Aspects: aspects, Pagination: pageRes}, nil
+}
+
+// loadAspect loads the meta store and the info of a deployed aspect.
+func (k Keeper) loadAspect(ctx sdk.Context, aspectID common.Address) (store.AspectMetaStore, *types.AspectInfo, error) {
+	metaStore, _, err := store.GetAspectMetaStore(k.newAspectStoreCtx(ctx, aspectID))
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	latestVersion, err := metaStore.GetLatestVersion()
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+	if latestVersion == 0 {
+		return nil, nil, status.Errorf(codes.NotFound, "aspect %s not deployed", aspectID.Hex())
+	}
+
+	meta, err := metaStore.GetMeta()
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	info := &types.AspectInfo{
+		AspectId:      aspectID.Hex(),
+		LatestVersion: latestVersion,
+		PayMaster:     meta.PayMaster.Hex(),
+		Proof:         meta.Proof,
+		MetaVersion:   uint32(metaStore.Version()),
+		Status:        meta.Status,
+	}
+	if meta.Owner != nil {
+		info.Owner = meta.Owner.Hex()
+	}
+
+	return metaStore, info, nil
+}
+
+// iterateAspectIDs iterates the ids of all deployed aspects in ascending order, starting from the given id.
+// Aspects are collected from both the v0 layout in the evm store and the protocol info kept in the aspect store.
+func (k Keeper) iterateAspectIDs(ctx sdk.Context, start []byte, cb func(aspectID common.Address) (stop bool, err error)) error {
+	// aspects with protocol info, accounts are sharing the same prefix, but only have the version saved
+	protocolStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), store.AspectProtocolInfoKeyPrefix)
+	protocolIter := newAspectIDIterator(protocolStore.Iterator(start, nil), func(key, value []byte) bool {
+		return len(key) == common.AddressLength && len(value) > store.ProtocolVersionLen
+	})
+	defer protocolIter.Close()
+
+	// v0 aspects, key format {aspectID}/
+	v0Store := prefix.NewStore(runtime.KVStoreAdapter(k.GetEVMStoreService().OpenKVStore(ctx)), evmtypes.KeyPrefix(v0.V0AspectCodeVersionKeyPrefix))
+	v0Iter := newAspectIDIterator(v0Store.Iterator(start, nil), func(key, _ []byte) bool {
+		return len(key) == common.AddressLength+v0.PathSeparatorLen
+	})
+	defer v0Iter.Close()
+
+	for protocolIter.Valid() || v0Iter.Valid() {
+		var next common.Address
+		switch {
+		case !v0Iter.Valid():
+			next = protocolIter.Pop()
+		case !protocolIter.Valid():
+			next = v0Iter.Pop()
+		default:
+			cmp := bytes.Compare(protocolIter.Peek().Bytes(), v0Iter.Peek().Bytes())
+			if cmp <= 0 {
+				next = protocolIter.Pop()
+			}
+			if cmp >= 0 {
+				// same aspect may exist in both stores if it has been migrated
+				next = v0Iter.Pop()
+			}
+		}
+
+		if stop, err := cb(next); err != nil || stop {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// aspectIDIterator wraps a store iterator and yields the aspect ids of the valid entries.
+type aspectIDIterator struct {
+	storetypes.Iterator
+
+	accept func(key, value []byte) bool
+}
+
+func newAspectIDIterator(iter storetypes.Iterator, accept func(key, value []byte) bool) *aspectIDIterator {
+	it := &aspectIDIterator{Iterator: iter, accept: accept}
+	it.skip()
+	return it
+}
+
+func (it *aspectIDIterator) skip() {
+	for it.Iterator.Valid() && !it.accept(it.Key(), it.Value()) {
+		it.Iterator.Next()
+	}
+}
+
+// Peek returns the current aspect id without moving the iterator.
+func (it *aspectIDIterator) Peek() common.Address {
+	return common.BytesToAddress(it.Key()[:common.AddressLength])
+}
+
+// Pop returns the current aspect id and moves the iterator to the next valid entry.
+func (it *aspectIDIterator) Pop() common.Address {
+	current := it.Peek()
+	it.Iterator.Next()
+	it.skip()
+	return current
+}
+
+func parseAddress(address string) (common.Address, error) {
+	if !common.IsHexAddress(address) {
+		return common.Address{}, status.Errorf(codes.InvalidArgument, "invalid address: %s", address)
+	}
+	return common.HexToAddress(address), nil
+}
+
+// resolveVersion returns the latest version if version is not specified.
+func resolveVersion(version, latestVersion uint64) (uint64, error) {
+	if version == 0 {
+		return latestVersion, nil
+	}
+	if version > latestVersion {
+		return 0, status.Error(codes.NotFound, "aspect version not deployed")
+	}
+	return version, nil
+}
+
+func toAspectBindings(bindings []types.Binding) []types.AspectBinding {
+	result := make([]types.AspectBinding, 0, len(bindings))
+	for _, binding := range bindings {
+		result = append(result, types.AspectBinding{
+			Address:   binding.Account.Hex(),
+			Version:   binding.Version,
+			Priority:  int32(binding.Priority),
+			JoinPoint: uint64(binding.JoinPoint),
+		})
+	}
+	return result
+}
@@ -0,0 +1,317 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/artela-network/artela-rollkit/testutil/keeper"
+	"github.com/artela-network/artela-rollkit/x/aspect/keeper"
+	"github.com/artela-network/artela-rollkit/x/aspect/store"
+	v0 "github.com/artela-network/artela-rollkit/x/aspect/store/v0"
+	_ "github.com/artela-network/artela-rollkit/x/aspect/store/v1"
+	"github.com/artela-network/artela-rollkit/x/aspect/types"
+)
+
+var (
+	testAspectV1  = common.HexToAddress("0x0000000000000000000000000000000000000a01")
+	testAspectV0  = common.HexToAddress("0x0000000000000000000000000000000000000a00")
+	testAccount   = common.HexToAddress("0x00000000000000000000000000000000000000c1")
+	testPayMaster = common.HexToAddress("0x00000000000000000000000000000000000000f1")
+)
+
+func storeCtx(k keeper.Keeper, ctx sdk.Context) types.StoreContext {
+	return types.NewGasFreeStoreContext(ctx, k.GetEVMStoreService(), k.GetStoreService())
+}
+
+func deployTestAspect(t *testing.T, metaStore store.AspectMetaStore, code []byte, joinPoint uint64, properties []types.Property) uint64 {
+	version, err := metaStore.BumpVersion()
+	require.NoError(t, err)
+	require.NoError(t, metaStore.StoreCode(version, code))
+	require.NoError(t, metaStore.StoreVersionMeta(version, &types.VersionMeta{
+		JoinPoint: joinPoint,
+		CodeHash:  crypto.Keccak256Hash(code),
+	}))
+	require.NoError(t, metaStore.StoreProperties(version, properties))
+	return version
+}
+
+func setupTestAspects(t *testing.T, k keeper.Keeper, ctx sdk.Context) {
+	// v1 aspect with 2 versions, bound to an EoA as verifier
+	metaStore, _, err := store.GetAspectMetaStore(&types.AspectStoreContext{StoreContext: storeCtx(k, ctx), AspectID: testAspectV1})
+	require.NoError(t, err)
+	require.NoError(t, metaStore.Init())
+	require.NoError(t, metaStore.StoreMeta(&types.AspectMeta{PayMaster: testPayMaster, Proof: []byte("proof")}))
+	deployTestAspect(t, metaStore, []byte("code-v1"), 1, []types.Property{{Key: "b", Value: []byte("1")}, {Key: "a", Value: []byte("2")}})
+	deployTestAspect(t, metaStore, []byte("code-v2"), 1, []types.Property{{Key: "b", Value: []byte("3")}})
+	require.NoError(t, metaStore.StoreBinding(testAccount, 2, 1, 0))
+
+	accountStore, _, err := store.GetAccountStore(&types.AccountStoreContext{StoreContext: storeCtx(k, ctx), Account: testAccount})
+	require.NoError(t, err)
+	require.NoError(t, accountStore.Init())
+	require.NoError(t, accountStore.StoreBinding(testAspectV1, 2, 1, 0, false))
+
+	// v0 aspect
+	v0Store := v0.NewAspectMetaStore(&types.AspectStoreContext{StoreContext: storeCtx(k, ctx), AspectID: testAspectV0}, nil)
+	require.NoError(t, v0Store.StoreMeta(&types.AspectMeta{PayMaster: testPayMaster, Proof: []byte("v0-proof")}))
+	deployTestAspect(t, v0Store, []byte("code-v0"), 2, []types.Property{{Key: "k", Value: []byte("v")}})
+}
+
+func TestAspectQuery(t *testing.T) {
+	k, ctx := keepertest.AspectKeeper(t)
+	setupTestAspects(t, k, ctx)
+
+	res, err := k.Aspect(ctx, &types.QueryAspectRequest{AspectId: testAspectV1.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, types.AspectInfo{
+		AspectId:      testAspectV1.Hex(),
+		LatestVersion: 2,
+		PayMaster:     testPayMaster.Hex(),
+		Proof:         []byte("proof"),
+		MetaVersion:   1,
+	}, res.Aspect)
+	require.Len(t, res.Versions, 2)
+	require.Equal(t, crypto.Keccak256Hash([]byte("code-v2")).Hex(), res.Versions[1].CodeHash)
+
+	res, err = k.Aspect(ctx, &types.QueryAspectRequest{AspectId: testAspectV0.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Aspect.LatestVersion)
+	require.Equal(t, testPayMaster.Hex(), res.Aspect.PayMaster)
+	require.Equal(t, uint32(0), res.Aspect.MetaVersion)
+	require.Equal(t, uint64(2), res.Versions[0].JoinPoint)
+	require.Equal(t, crypto.Keccak256Hash([]byte("code-v0")).Hex(), res.Versions[0].CodeHash)
+
+	_, err = k.Aspect(ctx, &types.QueryAspectRequest{AspectId: testAccount.Hex()})
+	require.ErrorContains(t, err, "not deployed")
+
+	_, err = k.Aspect(ctx, &types.QueryAspectRequest{AspectId: "invalid"})
+	require.ErrorContains(t, err, "invalid address")
+}
+
+func TestAspectOwnerQuery(t *testing.T) {
+	k, ctx := keepertest.AspectKeeper(t)
+	setupTestAspects(t, k, ctx)
+
+	owner := common.HexToAddress("0x00000000000000000000000000000000000000e1")
+	metaStore, _, err := store.GetAspectMetaStore(&types.AspectStoreContext{StoreContext: storeCtx(k, ctx), AspectID: testAspectV1})
+	require.NoError(t, err)
+	meta, err := metaStore.GetMeta()
+	require.NoError(t, err)
+	require.Nil(t, meta.Owner)
+
+	meta.Owner = &owner
+	require.NoError(t, metaStore.StoreMeta(meta))
+
+	res, err := k.Aspect(ctx, &types.QueryAspectRequest{AspectId: testAspectV1.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, owner.Hex(), res.Aspect.Owner)
+	require.Equal(t, testPayMaster.Hex(), res.Aspect.PayMaster)
+	require.Equal(t, []byte("proof"), res.Aspect.Proof)
+
+	// renounced aspect keeps a zero address owner
+	meta.Owner = &common.Address{}
+	require.NoError(t, metaStore.StoreMeta(meta))
+	res, err = k.Aspect(ctx, &types.QueryAspectRequest{AspectId: testAspectV1.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, common.Address{}.Hex(), res.Aspect.Owner)
+
+	// v0 store cannot persist ownership
+	v0Store := v0.NewAspectMetaStore(&types.AspectStoreContext{StoreContext: storeCtx(k, ctx), AspectID: testAspectV0}, nil)
+	require.ErrorIs(t, v0Store.StoreMeta(&types.AspectMeta{PayMaster: testPayMaster, Owner: &owner}), store.ErrOwnershipNotSupported)
+}
+
+func TestAspectCodeAndPropertiesQuery(t *testing.T) {
+	k, ctx := keepertest.AspectKeeper(t)
+	setupTestAspects(t, k, ctx)
+
+	code, err := k.AspectCode(ctx, &types.QueryAspectCodeRequest{AspectId: testAspectV1.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), code.Version)
+	require.Equal(t, []byte("code-v2"), code.Code)
+
+	code, err = k.AspectCode(ctx, &types.QueryAspectCodeRequest{AspectId: testAspectV1.Hex(), Version: 1})
+	require.NoError(t, err)
+	require.Equal(t, []byte("code-v1"), code.Code)
+
+	_, err = k.AspectCode(ctx, &types.QueryAspectCodeRequest{AspectId: testAspectV1.Hex(), Version: 3})
+	require.ErrorContains(t, err, "version not deployed")
+
+	props, err := k.AspectProperties(ctx, &types.QueryAspectPropertiesRequest{AspectId: testAspectV1.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, []types.AspectProperty{
+		{Key: "a", Value: []byte("2")},
+		{Key: "b", Value: []byte("3")},
+	}, props.Properties)
+
+	// reserved properties of v0 aspect should not be returned
+	props, err = k.AspectProperties(ctx, &types.QueryAspectPropertiesRequest{AspectId: testAspectV0.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, []types.AspectProperty{{Key: "k", Value: []byte("v")}}, props.Properties)
+}
+
+func TestBindingsQuery(t *testing.T) {
+	k, ctx := keepertest.AspectKeeper(t)
+	setupTestAspects(t, k, ctx)
+
+	bindings, err := k.AspectBindings(ctx, &types.QueryAspectBindingsRequest{AspectId: testAspectV1.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, []types.AspectBinding{{Address: testAccount.Hex(), Version: 2, JoinPoint: 1}}, bindings.Bindings)
+
+	accountBindings, err := k.AccountBindings(ctx, &types.QueryAccountBindingsRequest{Account: testAccount.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, []types.AspectBinding{{Address: testAspectV1.Hex(), Version: 2, JoinPoint: 1}}, accountBindings.Bindings)
+
+	_, err = k.AccountBindings(ctx, &types.QueryAccountBindingsRequest{Account: testAccount.Hex(), JoinPoint: "unknown"})
+	require.ErrorContains(t, err, "invalid join point")
+}
+
+func TestBindingsQueryPagination(t *testing.T) {
+	k, ctx := keepertest.AspectKeeper(t)
+	setupTestAspects(t, k, ctx)
+
+	// bind enough accounts to spread the bindings over multiple slots,
+	// with the accounts of odd index bound as tx level aspects
+	metaStore, _, err := store.GetAspectMetaStore(&types.AspectStoreContext{StoreContext: storeCtx(k, ctx), AspectID: testAspectV1})
+	require.NoError(t, err)
+	accounts := []common.Address{testAccount}
+	for i := 1; i < 300; i++ {
+		account := common.BigToAddress(big.NewInt(int64(0x10000 + i)))
+		joinPoint := uint64(1)
+		if i%2 == 1 {
+			joinPoint = 2
+		}
+		require.NoError(t, metaStore.StoreBinding(account, 2, joinPoint, 0))
+		accounts = append(accounts, account)
+	}
+
+	collected := make([]string, 0, len(accounts))
+	pageReq := &query.PageRequest{Limit: 70}
+	for {
+		res, err := k.AspectBindings(ctx, &types.QueryAspectBindingsRequest{AspectId: testAspectV1.Hex(), Pagination: pageReq})
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(res.Bindings), 70)
+		for _, binding := range res.Bindings {
+			collected = append(collected, binding.Address)
+		}
+		if res.Pagination.NextKey == nil {
+			break
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 70}
+	}
+	require.Len(t, collected, len(accounts))
+	for i, account := range accounts {
+		require.Equal(t, account.Hex(), collected[i])
+	}
+
+	// offset starts from the given position of the binding list
+	res, err := k.AspectBindings(ctx, &types.QueryAspectBindingsRequest{
+		AspectId:   testAspectV1.Hex(),
+		Pagination: &query.PageRequest{Offset: 239, Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{accounts[239].Hex(), accounts[240].Hex()},
+		[]string{res.Bindings[0].Address, res.Bindings[1].Address})
+	require.NotNil(t, res.Pagination.NextKey)
+
+	// join point filter is applied while scanning
+	res, err = k.AspectBindings(ctx, &types.QueryAspectBindingsRequest{
+		AspectId:   testAspectV1.Hex(),
+		JoinPoint:  "verifyTx",
+		Pagination: &query.PageRequest{Limit: 1000},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Bindings, 150)
+	for _, binding := range res.Bindings {
+		require.Equal(t, uint64(1), binding.JoinPoint)
+	}
+	require.Nil(t, res.Pagination.NextKey)
+
+	_, err = k.AspectBindings(ctx, &types.QueryAspectBindingsRequest{
+		AspectId:   testAspectV1.Hex(),
+		Pagination: &query.PageRequest{Key: []byte{1}},
+	})
+	require.ErrorContains(t, err, "invalid pagination key")
+
+	_, err = k.AccountBindings(ctx, &types.QueryAccountBindingsRequest{
+		Account:    testAccount.Hex(),
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	require.ErrorContains(t, err, "count total is not supported")
+}
+
+func TestLoadAccountBoundAspectsUnfiltered(t *testing.T) {
+	k, ctx := keepertest.AspectKeeper(t)
+
+	accountStore, _, err := store.GetAccountStore(&types.AccountStoreContext{StoreContext: storeCtx(k, ctx), Account: testContract})
+	require.NoError(t, err)
+	require.NoError(t, accountStore.Init())
+	require.NoError(t, accountStore.StoreBinding(testAspectV1, 1, 1, 0, true))
+	require.NoError(t, accountStore.StoreBinding(testAspectV0, 1, 2, 0, true))
+
+	// the aspect contract handlers rely on all bindings being returned regardless of the filter
+	bindings, err := accountStore.LoadAccountBoundAspects(types.NewDefaultFilter(false))
+	require.NoError(t, err)
+	require.Len(t, bindings, 2)
+
+	// the paged loader used by binding queries applies the filter
+	paged, next, err := accountStore.LoadAccountBoundAspectsPaged(types.NewDefaultFilter(false), types.BindingPage{})
+	require.NoError(t, err)
+	require.Zero(t, next)
+	require.Len(t, paged, 1)
+	require.Equal(t, testAspectV1, paged[0].Account)
+}
+
+func TestAspectsQuery(t *testing.T) {
+	k, ctx := keepertest.AspectKeeper(t)
+	setupTestAspects(t, k, ctx)
+
+	res, err := k.Aspects(ctx, &types.QueryAspectsRequest{Pagination: &query.PageRequest{CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, res.Aspects, 2)
+	// aspects are sorted by id, accounts sharing the protocol info prefix are skipped
+	require.Equal(t, testAspectV0.Hex(), res.Aspects[0].AspectId)
+	require.Equal(t, testAspectV1.Hex(), res.Aspects[1].AspectId)
+	require.Equal(t, uint64(2), res.Pagination.Total)
+	require.Nil(t, res.Pagination.NextKey)
+
+	res, err = k.Aspects(ctx, &types.QueryAspectsRequest{Pagination: &query.PageRequest{Limit: 1}})
+	require.NoError(t, err)
+	require.Len(t, res.Aspects, 1)
+	require.Equal(t, testAspectV0.Hex(), res.Aspects[0].AspectId)
+	require.Equal(t, testAspectV1.Bytes(), res.Pagination.NextKey)
+
+	res, err = k.Aspects(ctx, &types.QueryAspectsRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Len(t, res.Aspects, 1)
+	require.Equal(t, testAspectV1.Hex(), res.Aspects[0].AspectId)
+
+	res, err = k.Aspects(ctx, &types.QueryAspectsRequest{Pagination: &query.PageRequest{Offset: 1}})
+	require.NoError(t, err)
+	require.Len(t, res.Aspects, 1)
+	require.Equal(t, testAspectV1.Hex(), res.Aspects[0].AspectId)
+}
+
+func TestAspectStateQuery(t *testing.T) {
+	k, ctx := keepertest.AspectKeeper(t)
+	setupTestAspects(t, k, ctx)
+
+	stateStore, err := store.GetAspectStateStore(&types.AspectStoreContext{StoreContext: storeCtx(k, ctx), AspectID: testAspectV1})
+	require.NoError(t, err)
+	stateStore.SetState([]byte("counter"), []byte{0x01})
+
+	res, err := k.AspectState(ctx, &types.QueryAspectStateRequest{AspectId: testAspectV1.Hex(), Key: "counter"})
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x01}, res.Value)
+
+	res, err = k.AspectState(ctx, &types.QueryAspectStateRequest{AspectId: testAspectV1.Hex(), Key: "unset"})
+	require.NoError(t, err)
+	require.Empty(t, res.Value)
+
+	_, err = k.AspectState(ctx, &types.QueryAspectStateRequest{AspectId: testAccount.Hex(), Key: "counter"})
+	require.ErrorContains(t, err, "not deployed")
+}
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/artela-network/artela-rollkit/x/aspect/store"
+)
+
+// IterateAspectIDs iterates the ids of all deployed aspects in ascending order,
+// the iteration stops when the callback returns true or an error.
+func (k Keeper) IterateAspectIDs(ctx sdk.Context, cb func(aspectID common.Address) (stop bool, err error)) error {
+	return k.iterateAspectIDs(ctx, nil, cb)
+}
+
+// CheckBindingFilters verifies the binding filters of the given aspect against its binding data,
+// and rebuilds the filters if fix is set and any issue is found. The issues found before the rebuild
+// are returned. Aspects saved with a store version not indexing bindings with filters have no issues.
+func (k Keeper) CheckBindingFilters(ctx sdk.Context, aspectID common.Address, fix bool) ([]store.BindingFilterIssue, error) {
+	metaStore, _, err := store.GetAspectMetaStore(k.newAspectStoreCtx(ctx, aspectID))
+	if err != nil {
+		return nil, err
+	}
+
+	filterStore, ok := metaStore.(store.BindingFilterStore)
+	if !ok {
+		return nil, nil
+	}
+
+	issues, err := filterStore.VerifyBindingFilters()
+	if err != nil || len(issues) == 0 || !fix {
+		return issues, err
+	}
+
+	return issues, filterStore.RebuildBindingFilters()
+}
@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/artela-network/artela-rollkit/testutil/keeper"
+	"github.com/artela-network/artela-rollkit/x/aspect/store"
+	v1 "github.com/artela-network/artela-rollkit/x/aspect/store/v1"
+	"github.com/artela-network/artela-rollkit/x/aspect/types"
+)
+
+func TestCheckBindingFilters(t *testing.T) {
+	k, ctx := keepertest.AspectKeeper(t)
+	setupTestAspects(t, k, ctx)
+
+	// bind accounts to fill more than one binding slot
+	metaStore, _, err := store.GetAspectMetaStore(&types.AspectStoreContext{StoreContext: storeCtx(k, ctx), AspectID: testAspectV1})
+	require.NoError(t, err)
+	for i := 1; i < 130; i++ {
+		require.NoError(t, metaStore.StoreBinding(common.BigToAddress(big.NewInt(int64(0x10000+i))), 2, 1, 0))
+	}
+
+	issues, err := k.CheckBindingFilters(ctx, testAspectV1, false)
+	require.NoError(t, err)
+	require.Empty(t, issues)
+
+	// v0 aspects do not keep binding filters
+	issues, err = k.CheckBindingFilters(ctx, testAspectV0, true)
+	require.NoError(t, err)
+	require.Empty(t, issues)
+
+	// drop the first filter, all the bindings it manages cannot be found anymore
+	filterKey := store.NewKeyBuilder(v1.V1AspectBindingKeyPrefix).AppendBytes(testAspectV1.Bytes()).
+		AppendByte(v1.V1AspectBindingFilterKeyPrefix).AppendUint8(0).Build()
+	require.NoError(t, k.GetStoreService().OpenKVStore(ctx).Delete(filterKey))
+
+	issues, err = k.CheckBindingFilters(ctx, testAspectV1, false)
+	require.NoError(t, err)
+	require.Len(t, issues, 130)
+	require.Equal(t, testAccount, issues[0].Account)
+	require.Equal(t, "filter not found", issues[0].Reason)
+	require.Equal(t, uint64(1), issues[129].Slot)
+
+	// without fix the store is left untouched
+	issues, err = k.CheckBindingFilters(ctx, testAspectV1, false)
+	require.NoError(t, err)
+	require.Len(t, issues, 130)
+
+	issues, err = k.CheckBindingFilters(ctx, testAspectV1, true)
+	require.NoError(t, err)
+	require.Len(t, issues, 130)
+
+	issues, err = k.CheckBindingFilters(ctx, testAspectV1, false)
+	require.NoError(t, err)
+	require.Empty(t, issues)
+
+	// duplicated bindings are still rejected with the rebuilt filters
+	require.ErrorIs(t, metaStore.StoreBinding(testAccount, 2, 1, 0), store.ErrAlreadyBound)
+}
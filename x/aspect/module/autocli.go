@@ -17,6 +17,47 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Shows the parameters of the module",
 				},
+				{
+					RpcMethod:      "Aspect",
+					Use:            "aspect [aspect-id]",
+					Short:          "Shows the metadata and versions of an aspect",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "aspect_id"}},
+				},
+				{
+					RpcMethod:      "AspectCode",
+					Use:            "code [aspect-id]",
+					Short:          "Shows the code of an aspect, latest version by default",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "aspect_id"}},
+				},
+				{
+					RpcMethod:      "AspectProperties",
+					Use:            "properties [aspect-id]",
+					Short:          "Shows the properties of an aspect, latest version by default",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "aspect_id"}},
+				},
+				{
+					RpcMethod:      "AspectBindings",
+					Use:            "bound-accounts [aspect-id]",
+					Short:          "Shows the accounts bound to an aspect",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "aspect_id"}},
+				},
+				{
+					RpcMethod:      "AccountBindings",
+					Use:            "bindings [account]",
+					Short:          "Shows the aspects bound to an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "account"}},
+				},
+				{
+					RpcMethod: "Aspects",
+					Use:       "aspects",
+					Short:     "Lists all deployed aspects",
+				},
+				{
+					RpcMethod:      "AspectState",
+					Use:            "state [aspect-id] [key]",
+					Short:          "Shows a value in the state of an aspect",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "aspect_id"}, {ProtoField: "key"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -28,6 +69,10 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
 				},
+				{
+					RpcMethod: "SetAspectStatus",
+					Skip:      true, // skipped because authority gated
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
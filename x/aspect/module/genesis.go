@@ -13,6 +13,9 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	if err := k.SetParams(ctx, genState.Params); err != nil {
 		panic(err)
 	}
+	if err := k.InitAspects(ctx, genState); err != nil {
+		panic(err)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis.
@@ -20,6 +23,19 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
 
+	aspects, accounts, err := k.ExportAspects(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.Aspects = aspects
+	genesis.Accounts = accounts
+
+	allowances, err := k.ExportPaymasterAllowances(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.PaymasterAllowances = allowances
+
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -6,6 +6,7 @@ import (
 	"slices"
 
 	cstore "cosmossdk.io/core/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/artela-network/artela-rollkit/x/aspect/store"
@@ -55,26 +56,114 @@ func (j *ArtelaProvider) getCodes(ctx context.Context, address common.Address, p
 		return nil, errors.New("failed to unwrap AspectRuntimeContext from context.Context")
 	}
 
-	accountStore, _, err := store.GetAccountStore(j.buildAccountStoreCtx(aspectCtx, address))
+	bound, err := j.loadActiveAspects(aspectCtx.CosmosContext(), address, point)
 	if err != nil {
 		return nil, err
 	}
 
-	bindings, err := accountStore.LoadAccountBoundAspects(aspectmoduletypes.NewJoinPointFilter(point))
+	codes := make([]*asptypes.AspectCode, 0, len(bound))
+	for _, aspect := range bound {
+		binding := aspect.binding
+		code, err := aspect.metaStore.GetCode(binding.Version)
+		if err != nil {
+			return nil, err
+		}
+
+		codes = append(codes, &asptypes.AspectCode{
+			AspectId: binding.Account.Hex(),
+			Version:  binding.Version,
+			Priority: binding.Priority,
+			Code:     code,
+		})
+	}
+
+	// sort the codes by priority
+	slices.SortFunc(codes, func(a, b *asptypes.AspectCode) int {
+		if a.Priority == b.Priority {
+			return 0
+		} else if a.Priority < b.Priority {
+			return -1
+		} else {
+			return 1
+		}
+	})
+
+	// let the tracer know the versions of the aspects to execute
+	if logger := aspectCtx.AspectHostLogger(); logger != nil {
+		logger.CaptureAspectCodes(codes)
+	}
+
+	return codes, nil
+}
+
+// GetVerifierPayMaster returns the verifier aspect bound to the given account together with its paymaster,
+// zero addresses are returned if the account is not verified by exactly one active aspect.
+func (j *ArtelaProvider) GetVerifierPayMaster(ctx sdk.Context, account common.Address) (aspectID, paymaster common.Address, err error) {
+	verifiers, err := j.loadActiveAspects(ctx, account, asptypes.VERIFY_TX)
+	if err != nil {
+		return common.Address{}, common.Address{}, err
+	}
+
+	// same as the tx verification, the account must be bound with a single verifier
+	if len(verifiers) != 1 {
+		return common.Address{}, common.Address{}, nil
+	}
+
+	return verifiers[0].binding.Account, verifiers[0].meta.PayMaster, nil
+}
+
+// HasExecutionAspects returns whether the given contract is bound with active aspects executed along with its txs,
+// the bindings and the aspect metas are loaded once for all the tx level join points.
+func (j *ArtelaProvider) HasExecutionAspects(ctx sdk.Context, contract common.Address) (bool, error) {
+	bound, err := j.loadMatchedAspects(ctx, contract, aspectmoduletypes.BindingFilter{TxLevelOnly: true}, asptypes.CheckIsTransactionLevel)
+	if err != nil {
+		return false, err
+	}
+	return len(bound) > 0, nil
+}
+
+// boundAspect is an active aspect bound to an account
+type boundAspect struct {
+	binding   aspectmoduletypes.Binding
+	metaStore store.AspectMetaStore
+	meta      *aspectmoduletypes.AspectMeta
+}
+
+// loadActiveAspects returns the active aspects bound to the given account, which can be executed at the given join point.
+func (j *ArtelaProvider) loadActiveAspects(ctx sdk.Context, address common.Address, point asptypes.PointCut) ([]boundAspect, error) {
+	return j.loadMatchedAspects(ctx, address, aspectmoduletypes.NewJoinPointFilter(point), func(joinPoint int64) bool {
+		return asptypes.CanExecPoint(joinPoint, point)
+	})
+}
+
+// loadMatchedAspects returns the active aspects bound to the given account passing the filter,
+// whose join points are matched by the given function.
+func (j *ArtelaProvider) loadMatchedAspects(ctx sdk.Context, address common.Address, filter aspectmoduletypes.BindingFilter, match func(joinPoint int64) bool) ([]boundAspect, error) {
+	accountStore, _, err := store.GetAccountStore(j.buildAccountStoreCtx(ctx, address))
+	if err != nil {
+		return nil, err
+	}
+
+	bindings, err := accountStore.LoadAccountBoundAspects(filter)
 	if err != nil {
 		return nil, err
 	}
 
-	codes := make([]*asptypes.AspectCode, 0, len(bindings))
+	result := make([]boundAspect, 0, len(bindings))
 	for _, binding := range bindings {
-		metaStore, _, err := store.GetAspectMetaStore(j.buildAspectStoreCtx(aspectCtx, binding.Account))
+		metaStore, _, err := store.GetAspectMetaStore(j.buildAspectStoreCtx(ctx, binding.Account))
 		if err != nil {
 			return nil, err
 		}
-		code, err := metaStore.GetCode(binding.Version)
+
+		// paused or deprecated aspects are kept bound, but not executed
+		aspectMeta, err := metaStore.GetMeta()
 		if err != nil {
 			return nil, err
 		}
+		if aspectMeta.Status != aspectmoduletypes.AspectStatus_ASPECT_STATUS_ACTIVE {
+			continue
+		}
 
 		var isExpectedJP bool
 		if binding.JoinPoint == 0 {
@@ -82,9 +171,9 @@ func (j *ArtelaProvider) getCodes(ctx context.Context, address common.Address, p
 			if err != nil {
 				return nil, err
 			}
-			isExpectedJP = asptypes.CanExecPoint(int64(meta.JoinPoint), point)
+			isExpectedJP = match(int64(meta.JoinPoint))
 		} else {
-			isExpectedJP = asptypes.CanExecPoint(int64(binding.JoinPoint), point)
+			isExpectedJP = match(int64(binding.JoinPoint))
 		}
 
 		// filter matched aspect with given join point
@@ -92,38 +181,26 @@ func (j *ArtelaProvider) getCodes(ctx context.Context, address common.Address, p
 			continue
 		}
 
-		codes = append(codes, &asptypes.AspectCode{
-			AspectId: binding.Account.Hex(),
-			Version:  binding.Version,
-			Priority: binding.Priority,
-			Code:     code,
+		result = append(result, boundAspect{
+			binding:   binding,
+			metaStore: metaStore,
+			meta:      aspectMeta,
 		})
 	}
 
-	// sort the codes by priority
-	slices.SortFunc(codes, func(a, b *asptypes.AspectCode) int {
-		if a.Priority == b.Priority {
-			return 0
-		} else if a.Priority < b.Priority {
-			return -1
-		} else {
-			return 1
-		}
-	})
-
-	return codes, nil
+	return result, nil
 }
 
-func (j *ArtelaProvider) buildAspectStoreCtx(ctx *types.AspectRuntimeContext, aspectID common.Address) *aspectmoduletypes.AspectStoreContext {
+func (j *ArtelaProvider) buildAspectStoreCtx(ctx sdk.Context, aspectID common.Address) *aspectmoduletypes.AspectStoreContext {
 	return &aspectmoduletypes.AspectStoreContext{
-		StoreContext: aspectmoduletypes.NewGasFreeStoreContext(ctx.CosmosContext(), j.evmStoreService, j.storeService),
+		StoreContext: aspectmoduletypes.NewGasFreeStoreContext(ctx, j.evmStoreService, j.storeService),
 		AspectID:     aspectID,
 	}
 }
 
-func (j *ArtelaProvider) buildAccountStoreCtx(ctx *types.AspectRuntimeContext, account common.Address) *aspectmoduletypes.AccountStoreContext {
+func (j *ArtelaProvider) buildAccountStoreCtx(ctx sdk.Context, account common.Address) *aspectmoduletypes.AccountStoreContext {
 	return &aspectmoduletypes.AccountStoreContext{
-		StoreContext: aspectmoduletypes.NewGasFreeStoreContext(ctx.CosmosContext(), j.evmStoreService, j.storeService),
+		StoreContext: aspectmoduletypes.NewGasFreeStoreContext(ctx, j.evmStoreService, j.storeService),
 		Account:      account,
 	}
 }
@@ -20,4 +20,11 @@ var (
 	ErrSerdeFail               = errors.New("serialize or deserialize fail")
 	ErrBoundNonVerifierWithEOA = errors.New("binding non-verifier aspect with EOA")
 	ErrInvalidJoinPoint        = errors.New("invalid join point")
+	ErrAlreadyDeployed         = errors.New("aspect already deployed")
+	ErrInvalidMigration        = errors.New("invalid store migration")
+	ErrOwnershipNotSupported   = errors.New("aspect ownership not supported by store")
+	ErrStatusNotSupported      = errors.New("aspect status not supported by store")
+
+	ErrInvalidPaymasterAllowance = errors.New("invalid paymaster allowance")
+	ErrPaymasterLimitExceeded    = errors.New("paymaster spending limit exceeded")
 )
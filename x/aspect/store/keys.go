@@ -15,6 +15,7 @@ var (
 // global keys, shouldn't be changed in the future
 var (
 	AspectProtocolInfoKeyPrefix = []byte{GlobalScope, 0x01}
+	PaymasterAllowanceKeyPrefix = []byte{GlobalScope, 0x02}
 )
 
 type KeyBuilder struct {
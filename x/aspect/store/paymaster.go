@@ -0,0 +1,127 @@
+package store
+
+import (
+	"math/big"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/ethereum/go-ethereum/common"
+
+	aspectmoduletypes "github.com/artela-network/artela-rollkit/x/aspect/types"
+)
+
+// paymasterAllowanceLen is the length of the saved paymaster allowance, a 32 bytes limit followed by a 32 bytes spent
+const paymasterAllowanceLen = 64
+
+// LoadPaymasterAllowance returns the spending limit of the given paymaster for the given aspect, sponsoring
+// is disabled if the paymaster has never set a limit for the aspect.
+func LoadPaymasterAllowance(ctx aspectmoduletypes.StoreContext, paymaster, aspectID common.Address) (*aspectmoduletypes.PaymasterAllowance, error) {
+	store := runtime.KVStoreAdapter(ctx.StoreService().OpenKVStore(ctx.CosmosContext()))
+
+	key := paymasterAllowanceKey(paymaster, aspectID)
+	raw := store.Get(key)
+	if err := consumeStorageGas(ctx, len(key)+len(raw), ctx.Params().StorageLoadCost); err != nil {
+		return nil, err
+	}
+
+	allowance := aspectmoduletypes.NewPaymasterAllowance()
+	if len(raw) == 0 {
+		return allowance, nil
+	}
+	if len(raw) != paymasterAllowanceLen {
+		ctx.Logger().Error("invalid paymaster allowance", "paymaster", paymaster.Hex(), "aspect", aspectID.Hex(), "length", len(raw))
+		return nil, ErrStorageCorrupted
+	}
+
+	allowance.Limit.SetBytes(raw[:32])
+	allowance.Spent.SetBytes(raw[32:])
+	return allowance, nil
+}
+
+// StorePaymasterAllowance saves the spending limit of the given paymaster for the given aspect, the allowance
+// is deleted once both the limit and the spent are zero.
+func StorePaymasterAllowance(ctx aspectmoduletypes.StoreContext, paymaster, aspectID common.Address, allowance *aspectmoduletypes.PaymasterAllowance) error {
+	if allowance.Limit.Sign() < 0 || allowance.Spent.Sign() < 0 ||
+		allowance.Limit.BitLen() > 256 || allowance.Spent.BitLen() > 256 {
+		return ErrInvalidPaymasterAllowance
+	}
+
+	store := runtime.KVStoreAdapter(ctx.StoreService().OpenKVStore(ctx.CosmosContext()))
+	key := paymasterAllowanceKey(paymaster, aspectID)
+	if allowance.Limit.Sign() == 0 && allowance.Spent.Sign() == 0 {
+		store.Delete(key)
+		return nil
+	}
+
+	raw := make([]byte, paymasterAllowanceLen)
+	allowance.Limit.FillBytes(raw[:32])
+	allowance.Spent.FillBytes(raw[32:])
+	if err := consumeStorageGas(ctx, len(key)+len(raw), ctx.Params().StorageStoreCost); err != nil {
+		return err
+	}
+
+	store.Set(key, raw)
+	return nil
+}
+
+// SpendPaymasterAllowance adds the given fees to the spent of the paymaster for the given aspect, it fails
+// if the fees exceed the remaining allowance. Negative fees are refunded back to the allowance.
+func SpendPaymasterAllowance(ctx aspectmoduletypes.StoreContext, paymaster, aspectID common.Address, fees *big.Int) (*aspectmoduletypes.PaymasterAllowance, error) {
+	allowance, err := LoadPaymasterAllowance(ctx, paymaster, aspectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if fees.Sign() > 0 && allowance.Remaining().Cmp(fees) < 0 {
+		return allowance, ErrPaymasterLimitExceeded
+	}
+
+	allowance.Spent.Add(allowance.Spent, fees)
+	if allowance.Spent.Sign() < 0 {
+		allowance.Spent.SetInt64(0)
+	}
+
+	return allowance, StorePaymasterAllowance(ctx, paymaster, aspectID, allowance)
+}
+
+// IteratePaymasterAllowances iterates the allowances of all paymasters in the order of the paymaster and aspect
+// addresses, the iteration stops once the callback returns true.
+func IteratePaymasterAllowances(ctx aspectmoduletypes.StoreContext, cb func(paymaster, aspectID common.Address, allowance *aspectmoduletypes.PaymasterAllowance) (stop bool)) error {
+	store := prefix.NewStore(runtime.KVStoreAdapter(ctx.StoreService().OpenKVStore(ctx.CosmosContext())), PaymasterAllowanceKeyPrefix)
+	iter := storetypes.KVStorePrefixIterator(store, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key, raw := iter.Key(), iter.Value()
+		if len(key) != 2*common.AddressLength || len(raw) != paymasterAllowanceLen {
+			ctx.Logger().Error("invalid paymaster allowance", "key", common.Bytes2Hex(key), "length", len(raw))
+			return ErrStorageCorrupted
+		}
+
+		allowance := aspectmoduletypes.NewPaymasterAllowance()
+		allowance.Limit.SetBytes(raw[:32])
+		allowance.Spent.SetBytes(raw[32:])
+		if cb(common.BytesToAddress(key[:common.AddressLength]), common.BytesToAddress(key[common.AddressLength:]), allowance) {
+			break
+		}
+	}
+
+	return nil
+}
+
+// paymasterAllowanceKey returns the key of the allowance, which is set by the paymaster for each aspect it
+// sponsors, so the limit of an aspect cannot be spent by the others sharing the same paymaster.
+func paymasterAllowanceKey(paymaster, aspectID common.Address) []byte {
+	return NewKeyBuilder(PaymasterAllowanceKeyPrefix).AppendBytes(paymaster.Bytes()).AppendBytes(aspectID.Bytes()).Build()
+}
+
+// consumeStorageGas charges the storage access with the same rate of the aspect stores,
+// nothing is charged with the gas free store contexts.
+func consumeStorageGas(ctx aspectmoduletypes.StoreContext, dataLen int, gasCostPer32Bytes uint64) error {
+	if !ctx.ChargeGas() {
+		return nil
+	}
+
+	return ctx.ConsumeGas(((uint64(dataLen) + 32) >> 5) * gasCostPer32Bytes)
+}
@@ -116,6 +116,9 @@ type GasMeteredStore interface {
 type AccountStore interface {
 	// LoadAccountBoundAspects returns the aspects bound to the account,
 	LoadAccountBoundAspects(filter aspectmoduletypes.BindingFilter) ([]aspectmoduletypes.Binding, error)
+	// LoadAccountBoundAspectsPaged returns a page of the aspects bound to the account and the cursor of the next page,
+	// the returned cursor is 0 if there are no more bindings
+	LoadAccountBoundAspectsPaged(filter aspectmoduletypes.BindingFilter, page aspectmoduletypes.BindingPage) ([]aspectmoduletypes.Binding, uint64, error)
 	// StoreBinding adds the binding of the given aspect to the account
 	StoreBinding(aspectID common.Address, version uint64, joinPoint uint64, priority int8, isCA bool) error
 	// RemoveBinding removes the binding of the given aspect from the account
@@ -139,6 +142,9 @@ type AspectStateStore interface {
 	GetState(key []byte) []byte
 	// SetState sets the value for the given key
 	SetState(key []byte, value []byte)
+	// IterateState iterates over all the states of the aspect in key order,
+	// the iteration stops when the callback returns true
+	IterateState(cb func(key, value []byte) (stop bool))
 	// Version returns the version of the store
 	Version() ProtocolVersion
 }
@@ -155,8 +161,13 @@ type AspectMetaStore interface {
 	GetLatestVersion() (uint64, error)
 	// GetProperty returns the properties for the given version
 	GetProperty(version uint64, key string) ([]byte, error)
+	// GetProperties returns all properties for the given version, sorted by key
+	GetProperties(version uint64) ([]aspectmoduletypes.Property, error)
 	// LoadAspectBoundAccounts returns the accounts bound to the aspect
 	LoadAspectBoundAccounts() ([]aspectmoduletypes.Binding, error)
+	// LoadAspectBoundAccountsPaged returns a page of the accounts bound to the aspect and the cursor of the next page,
+	// the returned cursor is 0 if there are no more bindings
+	LoadAspectBoundAccountsPaged(filter aspectmoduletypes.BindingFilter, page aspectmoduletypes.BindingPage) ([]aspectmoduletypes.Binding, uint64, error)
 
 	// BumpVersion bumps the version of the aspect
 	BumpVersion() (uint64, error)
@@ -185,6 +196,43 @@ type AspectMetaStore interface {
 	GasMeteredStore
 }
 
+// BindingFilterIssue describes a binding that cannot be found in the filter managing its binding slot
+type BindingFilterIssue struct {
+	// Account is the bound account
+	Account common.Address
+	// Slot is the binding slot the account is saved in
+	Slot uint64
+	// Filter is the index of the filter managing the slot
+	Filter uint8
+	// Reason describes why the binding is not found
+	Reason string
+}
+
+// BindingFilterStore is implemented by the aspect meta stores indexing the bindings with filters,
+// it is used by the offline tools to check and repair the filters.
+type BindingFilterStore interface {
+	// VerifyBindingFilters checks whether all the bound accounts can be found in the filters
+	VerifyBindingFilters() ([]BindingFilterIssue, error)
+	// RebuildBindingFilters rebuilds all the filters from the binding data
+	RebuildBindingFilters() error
+}
+
+// GetProtocolInfo returns the protocol version and the aspect info saved for the given aspect or account,
+// the aspect info is empty for accounts and the stores of protocol v0.
+func GetProtocolInfo(ctx aspectmoduletypes.StoreContext) (ProtocolVersion, *AspectInfo, error) {
+	protocolVersion, rawAspectInfo, err := loadProtocolInfo(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	aspectInfo, err := parseAspectInfo(rawAspectInfo)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return protocolVersion, aspectInfo, nil
+}
+
 // loadProtocolInfo loads the protocol info for the given address
 func loadProtocolInfo(ctx aspectmoduletypes.StoreContext) (ProtocolVersion, []byte, error) {
 	store := runtime.KVStoreAdapter(ctx.StoreService().OpenKVStore(ctx.CosmosContext()))
@@ -371,6 +419,34 @@ func GetAspectStateStore(ctx *aspectmoduletypes.AspectStoreContext) (AspectState
 	return constructor(ctx), nil
 }
 
+// MigrateAccountStore migrates the account data to the new store if needed,
+// and returns the store to write with. Both stores should be the ones returned by GetAccountStore.
+func MigrateAccountStore(current, new AccountStore) (AccountStore, error) {
+	if new == nil {
+		return current, nil
+	}
+
+	if err := new.MigrateFrom(current); err != nil {
+		return nil, err
+	}
+
+	return new, nil
+}
+
+// MigrateAspectMetaStore migrates the aspect meta to the new store if needed,
+// and returns the store to write with. Both stores should be the ones returned by GetAspectMetaStore.
+func MigrateAspectMetaStore(current, new AspectMetaStore) (AspectMetaStore, error) {
+	if new == nil {
+		return current, nil
+	}
+
+	if err := new.MigrateFrom(current); err != nil {
+		return nil, err
+	}
+
+	return new, nil
+}
+
 func latestStoreVersion[T any](registry map[ProtocolVersion]T) ProtocolVersion {
 	return ProtocolVersion(len(registry) - 1)
 }
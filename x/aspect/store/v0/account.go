@@ -276,3 +276,15 @@ func (s *accountStore) LoadAccountBoundAspects(filter types.BindingFilter) ([]ty
 
 	return bindings, nil
 }
+
+// LoadAccountBoundAspectsPaged loads a page of the aspects bound to the given account.
+func (s *accountStore) LoadAccountBoundAspectsPaged(filter types.BindingFilter, page types.BindingPage) ([]types.Binding, uint64, error) {
+	// bindings are filtered by the binding keys in v0 store
+	bindings, err := s.LoadAccountBoundAspects(filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	result, next := types.PaginateBindings(bindings, types.BindingFilter{}, page)
+	return result, next, nil
+}
@@ -4,13 +4,6 @@ import (
 	"github.com/artela-network/artela-rollkit/x/aspect/types"
 )
 
-const (
-	storageLoadCost     = 10
-	storageStoreCost    = 1000
-	storageSaveCodeCost = 1000
-	storageUpdateCost   = 1000
-)
-
 type GasMeter interface {
 	MeasureStorageUpdate(dataLen int) error
 	MeasureStorageCodeSave(dataLen int) error
@@ -70,20 +63,22 @@ func NewGasMeter(ctx types.StoreContext) GasMeter {
 	}
 }
 
+// storage costs are taken from the aspect module params, so they can be adjusted by governance
+
 func (m *gasMeter) MeasureStorageUpdate(dataLen int) error {
-	return m.Consume(dataLen, storageUpdateCost)
+	return m.Consume(dataLen, m.ctx.Params().StorageUpdateCost)
 }
 
 func (m *gasMeter) MeasureStorageCodeSave(dataLen int) error {
-	return m.Consume(dataLen, storageSaveCodeCost)
+	return m.Consume(dataLen, m.ctx.Params().StorageSaveCodeCost)
 }
 
 func (m *gasMeter) MeasureStorageStore(dataLen int) error {
-	return m.Consume(dataLen, storageStoreCost)
+	return m.Consume(dataLen, m.ctx.Params().StorageStoreCost)
 }
 
 func (m *gasMeter) MeasureStorageLoad(dataLen int) error {
-	return m.Consume(dataLen, storageLoadCost)
+	return m.Consume(dataLen, m.ctx.Params().StorageLoadCost)
 }
 
 func (m *gasMeter) RemainingGas() uint64 {
@@ -53,6 +53,15 @@ func NewAspectMetaStore(ctx *types.AspectStoreContext, _ []byte) store.AspectMet
 }
 
 func (s *metaStore) StoreMeta(meta *types.AspectMeta) error {
+	if meta.Owner != nil {
+		// ownership can only be saved in the latest store
+		return store.ErrOwnershipNotSupported
+	}
+	if meta.Status != types.AspectStatus_ASPECT_STATUS_ACTIVE {
+		// v0 aspects are always active
+		return store.ErrStatusNotSupported
+	}
+
 	// v0 Store saves paymaster and proof as aspect properties
 	paymaster := types.Property{
 		Key:   V0AspectAccountKey,
@@ -66,11 +75,12 @@ func (s *metaStore) StoreMeta(meta *types.AspectMeta) error {
 }
 
 func (s *metaStore) GetMeta() (*types.AspectMeta, error) {
-	paymaster, err := s.GetProperty(0, V0AspectAccountKey)
+	// paymaster and proof are saved with reserved keys, which cannot be read through GetProperty
+	paymaster, err := s.getProperty(V0AspectAccountKey)
 	if err != nil {
 		return nil, err
 	}
-	proof, err := s.GetProperty(0, V0AspectProofKey)
+	proof, err := s.getProperty(V0AspectProofKey)
 	if err != nil {
 		return nil, err
 	}
@@ -179,13 +189,17 @@ func (s *metaStore) getLatestVersion(aspectID common.Address) (uint64, error) {
 
 // GetProperty returns the property of the aspect with the given ID and key.
 func (s *metaStore) GetProperty(_ uint64, key string) ([]byte, error) {
-	aspectID := s.ctx.AspectID
-	codeStore := s.NewPrefixStore(V0AspectPropertyKeyPrefix)
 	if _, ok := reservedPropertyKeys[key]; ok {
 		// for reserved key, we return empty value
 		return nil, nil
 	}
 
+	return s.getProperty(key)
+}
+
+func (s *metaStore) getProperty(key string) ([]byte, error) {
+	aspectID := s.ctx.AspectID
+	codeStore := s.NewPrefixStore(V0AspectPropertyKeyPrefix)
 	aspectPropertyKey := AspectPropertyKey(
 		aspectID.Bytes(),
 		[]byte(key),
@@ -194,6 +208,39 @@ func (s *metaStore) GetProperty(_ uint64, key string) ([]byte, error) {
 	return s.Load(codeStore, aspectPropertyKey)
 }
 
+// GetProperties returns all properties of the aspect with the given ID, v0 properties are not versioned.
+func (s *metaStore) GetProperties(_ uint64) ([]types.Property, error) {
+	allKeys, err := s.getProperty(V0AspectPropertyAllKeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(allKeys) == 0 {
+		return nil, nil
+	}
+
+	// all keys are saved in sorted order
+	keys := strings.Split(string(allKeys), V0AspectPropertyAllKeySplit)
+	properties := make([]types.Property, 0, len(keys))
+	for _, key := range keys {
+		if _, ok := reservedPropertyKeys[key]; ok {
+			continue
+		}
+
+		value, err := s.getProperty(key)
+		if err != nil {
+			return nil, err
+		}
+
+		properties = append(properties, types.Property{
+			Key:   key,
+			Value: value,
+		})
+	}
+
+	return properties, nil
+}
+
 // BumpVersion bumps the version of the aspect with the given ID.
 func (s *metaStore) BumpVersion() (v uint64, err error) {
 	aspectID := s.ctx.AspectID
@@ -289,7 +336,7 @@ func (s *metaStore) storeProperties(properties []types.Property) error {
 	}
 
 	// check key limit
-	if keySet.Size() > types.AspectPropertyLimit {
+	if keySet.Size() > int(s.ctx.Params().MaxProperties) {
 		return store.ErrTooManyProperties
 	}
 
@@ -350,6 +397,18 @@ func (s *metaStore) LoadAspectBoundAccounts() ([]types.Binding, error) {
 	return bindings, nil
 }
 
+// LoadAspectBoundAccountsPaged loads a page of the accounts bound to the given aspect.
+// Join points of the bindings are not saved in v0 store, so all bindings pass the filter.
+func (s *metaStore) LoadAspectBoundAccountsPaged(filter types.BindingFilter, page types.BindingPage) ([]types.Binding, uint64, error) {
+	bindings, err := s.LoadAspectBoundAccounts()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	result, next := types.PaginateBindings(bindings, filter, page)
+	return result, next, nil
+}
+
 func (s *metaStore) saveAspectRef(prefixStore prefix.Store, aspectID common.Address, account common.Address) error {
 	storeKey := AspectIDKey(aspectID.Bytes())
 	set, err := s.loadAspectRef(prefixStore)
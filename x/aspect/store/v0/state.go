@@ -1,6 +1,9 @@
 package v0
 
 import (
+	"bytes"
+
+	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 
 	"github.com/artela-network/artela-rollkit/x/aspect/store"
@@ -48,3 +51,19 @@ func (s *stateStore) GetState(key []byte) []byte {
 	storeKey := AspectArrayKey(aspectID.Bytes(), key)
 	return prefixStore.Get(storeKey)
 }
+
+// IterateState iterates over all the states of the aspect with the given ID.
+func (s *stateStore) IterateState(cb func(key, value []byte) (stop bool)) {
+	aspectID := s.ctx.AspectID
+	prefixStore := s.NewPrefixStore(V0AspectStateKeyPrefix)
+	aspectPrefix := AspectArrayKey(aspectID.Bytes())
+	iter := storetypes.KVStorePrefixIterator(prefixStore, aspectPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key := bytes.TrimSuffix(iter.Key()[len(aspectPrefix):], PathSeparator)
+		if cb(key, iter.Value()) {
+			return
+		}
+	}
+}
@@ -65,6 +65,30 @@ func (a *accountStore) LoadAccountBoundAspects(filter types.BindingFilter) ([]ty
 	return result, nil
 }
 
+// LoadAccountBoundAspectsPaged loads a page of the aspects bound to the account passing the filter.
+// Unlike LoadAccountBoundAspects, which returns all bindings for the aspect contract handlers,
+// the bindings are strictly matched with the filter, it is only used by the binding queries.
+func (a *accountStore) LoadAccountBoundAspectsPaged(filter types.BindingFilter, page types.BindingPage) ([]types.Binding, uint64, error) {
+	if filter.JoinPoint != nil {
+		if _, ok := aspect.JoinPointRunType_value[string(*filter.JoinPoint)]; !ok {
+			return nil, 0, store.ErrInvalidJoinPoint
+		}
+	}
+
+	allBindings, err := a.getAllBindings()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	bindings := make([]types.Binding, 0, len(allBindings))
+	for _, binding := range allBindings {
+		bindings = append(bindings, types.Binding(binding))
+	}
+
+	result, next := types.PaginateBindings(bindings, filter, page)
+	return result, next, nil
+}
+
 func (a *accountStore) StoreBinding(aspectID common.Address, version uint64, joinPoint uint64, priority int8, isCA bool) (err error) {
 	allBindings, err := a.getAllBindings()
 	if err != nil {
@@ -83,7 +107,7 @@ func (a *accountStore) StoreBinding(aspectID common.Address, version uint64, joi
 		return store.ErrBoundNonVerifierWithEOA
 	}
 
-	if len(allBindings) >= maxAspectBoundLimit {
+	if len(allBindings) >= int(a.ctx.Params().MaxAccountBindings) {
 		return store.ErrBindingLimitExceeded
 	}
 
@@ -182,9 +206,77 @@ func (a *accountStore) Used() (bool, error) {
 	return protocolVersion == store.ProtocolVersion(1), nil
 }
 
+// MigrateFrom migrates the account bindings from the v0 store.
 func (a *accountStore) MigrateFrom(old store.AccountStore) error {
-	//TODO implement me
-	panic("implement me")
+	if old.Version() != store.ProtocolVersion(0) {
+		return store.ErrInvalidMigration
+	}
+	if used, err := a.Used(); err != nil {
+		return err
+	} else if used {
+		return store.ErrInvalidMigration
+	}
+
+	oldBindings, err := old.LoadAccountBoundAspects(types.BindingFilter{})
+	if err != nil {
+		return err
+	}
+
+	if err := a.Init(); err != nil {
+		return err
+	}
+	if len(oldBindings) == 0 {
+		return nil
+	}
+	if len(oldBindings) > int(a.ctx.Params().MaxAccountBindings) {
+		return store.ErrBindingLimitExceeded
+	}
+
+	// bindings have been validated when saved in v0 store, so we just save them as is
+	allBindings := make([]Binding, 0, len(oldBindings))
+	for _, binding := range oldBindings {
+		joinPoint := binding.JoinPoint
+		if joinPoint == 0 {
+			// join point is not saved in v0 account store, use the one of the bound version
+			joinPoint, err = a.loadJoinPoint(binding.Account, binding.Version)
+			if err != nil {
+				return err
+			}
+		}
+
+		allBindings = append(allBindings, Binding{
+			Account:   binding.Account,
+			Version:   binding.Version,
+			Priority:  binding.Priority,
+			JoinPoint: joinPoint,
+		})
+	}
+
+	key := store.NewKeyBuilder(V1AccountBindingKeyPrefix).AppendBytes(a.ctx.Account.Bytes()).Build()
+	bindingsBytes, err := Bindings(allBindings).MarshalText()
+	if err != nil {
+		return err
+	}
+
+	return a.Store(key, bindingsBytes)
+}
+
+// loadJoinPoint loads the join point of the given aspect version.
+func (a *accountStore) loadJoinPoint(aspectID common.Address, version uint64) (uint16, error) {
+	metaStore, _, err := store.GetAspectMetaStore(&types.AspectStoreContext{
+		StoreContext: a.ctx.StoreContext,
+		AspectID:     aspectID,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	meta, err := metaStore.GetVersionMeta(version)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint16(meta.JoinPoint), nil
 }
 
 func (a *accountStore) Init() error {
@@ -2,7 +2,6 @@ package v1
 
 import (
 	"encoding/hex"
-	"math"
 
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
@@ -12,8 +11,7 @@ import (
 )
 
 const (
-	maxAspectBoundLimit = math.MaxUint8
-	protocolVersion     = store.ProtocolVersion(1)
+	protocolVersion = store.ProtocolVersion(1)
 )
 
 // BaseStore defines a shared base store which can be implemented by all other stores
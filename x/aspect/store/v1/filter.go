@@ -0,0 +1,109 @@
+package v1
+
+import (
+	"math"
+
+	cuckoo "github.com/artela-network/artela-rollkit/x/aspect/cuckoofilter"
+	"github.com/artela-network/artela-rollkit/x/aspect/store"
+)
+
+var _ store.BindingFilterStore = (*metaStore)(nil)
+
+// VerifyBindingFilters checks whether every bound account can be found in the filter managing its binding slot.
+// An account bound in slot s is indexed in filter s/filterManagedSlots with key {account}{s%filterManagedSlots}.
+func (m *metaStore) VerifyBindingFilters() ([]store.BindingFilterIssue, error) {
+	bindings, err := m.LoadAspectBoundAccounts()
+	if err != nil {
+		return nil, err
+	}
+
+	filterKey := store.NewKeyBuilder(V1AspectBindingKeyPrefix).
+		AppendBytes(m.ctx.AspectID.Bytes()).AppendByte(V1AspectBindingFilterKeyPrefix)
+
+	var (
+		issues  = make([]store.BindingFilterIssue, 0)
+		filters = make(map[uint8]*cuckoo.Filter)
+		reasons = make(map[uint8]string)
+	)
+	for i, binding := range bindings {
+		slot := uint64(i) / bindingSlotSize
+		filterSlot := uint8(slot / filterManagedSlots)
+
+		filter, loaded := filters[filterSlot]
+		if !loaded {
+			filterData, err := m.Load(filterKey.AppendUint8(filterSlot).Build())
+			if err != nil {
+				return nil, err
+			}
+
+			if len(filterData) == 0 {
+				reasons[filterSlot] = "filter not found"
+			} else if filter, err = cuckoo.Decode(filterData); err != nil {
+				filter = nil
+				reasons[filterSlot] = "filter corrupted: " + err.Error()
+			}
+			filters[filterSlot] = filter
+		}
+
+		reason := reasons[filterSlot]
+		if filter != nil && !filter.Lookup(store.NewKeyBuilder(binding.Account.Bytes()).AppendUint8(uint8(slot%filterManagedSlots)).Build()) {
+			reason = "account not found in filter"
+		}
+		if reason == "" {
+			continue
+		}
+
+		issues = append(issues, store.BindingFilterIssue{
+			Account: binding.Account,
+			Slot:    slot,
+			Filter:  filterSlot,
+			Reason:  reason,
+		})
+	}
+
+	return issues, nil
+}
+
+// RebuildBindingFilters rebuilds all the binding filters of the aspect from the binding data,
+// the filters not managing any binding slots are deleted.
+func (m *metaStore) RebuildBindingFilters() error {
+	bindings, err := m.LoadAspectBoundAccounts()
+	if err != nil {
+		return err
+	}
+
+	filterKey := store.NewKeyBuilder(V1AspectBindingKeyPrefix).
+		AppendBytes(m.ctx.AspectID.Bytes()).AppendByte(V1AspectBindingFilterKeyPrefix)
+
+	filters := make([]*cuckoo.Filter, 0)
+	for i, binding := range bindings {
+		slot := uint64(i) / bindingSlotSize
+		filterSlot := int(slot / filterManagedSlots)
+		if filterSlot == len(filters) {
+			filters = append(filters, cuckoo.NewFilter(filterMaxSize))
+		}
+
+		filters[filterSlot].Insert(store.NewKeyBuilder(binding.Account.Bytes()).AppendUint8(uint8(slot % filterManagedSlots)).Build())
+	}
+
+	for i := 0; i <= math.MaxUint8; i++ {
+		key := filterKey.AppendUint8(uint8(i)).Build()
+		if i < len(filters) {
+			if err := m.Store(key, filters[i].Encode()); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// remove stale filters left by removed bindings, storing empty value deletes the key
+		if filterData, err := m.Load(key); err != nil {
+			return err
+		} else if len(filterData) > 0 {
+			if err := m.Store(key, nil); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
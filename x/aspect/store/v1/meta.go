@@ -2,16 +2,17 @@ package v1
 
 import (
 	"encoding/json"
-	"math"
 	"sort"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	cuckoo "github.com/artela-network/artela-rollkit/x/aspect/cuckoofilter"
 	"github.com/artela-network/artela-rollkit/x/aspect/store"
 	v0 "github.com/artela-network/artela-rollkit/x/aspect/store/v0"
 	"github.com/artela-network/artela-rollkit/x/aspect/types"
+	aspect "github.com/artela-network/aspect-core/types"
 )
 
 var _ store.AspectMetaStore = (*metaStore)(nil)
@@ -23,7 +24,6 @@ const (
 	filterMaxSize      = 2047
 	filterActualLimit  = filterMaxSize * 9 / 10 // assume load factor of filter is 90%
 	filterManagedSlots = filterActualLimit / bindingSlotSize
-	maxBindingSize     = filterManagedSlots * math.MaxUint8 * bindingSlotSize
 )
 
 type metaStore struct {
@@ -91,9 +91,17 @@ func (m *metaStore) GetVersionMeta(version uint64) (*types.VersionMeta, error) {
 }
 
 func (m *metaStore) GetMeta() (*types.AspectMeta, error) {
+	var owner *common.Address
+	if m.ext.Owner != nil {
+		owner = new(common.Address)
+		*owner = *m.ext.Owner
+	}
+
 	return &types.AspectMeta{
 		PayMaster: m.ext.PayMaster,
 		Proof:     m.ext.Proof,
+		Owner:     owner,
+		Status:    types.AspectStatus(m.ext.Status),
 	}, nil
 }
 
@@ -153,6 +161,31 @@ func (m *metaStore) GetProperty(version uint64, propKey string) ([]byte, error)
 	return allProps[propKey], nil
 }
 
+func (m *metaStore) GetProperties(version uint64) ([]types.Property, error) {
+	if version == 0 {
+		return nil, nil
+	}
+
+	allProps, err := m.getProperties(version)
+	if err != nil {
+		return nil, err
+	}
+
+	properties := make([]types.Property, 0, len(allProps))
+	for key, value := range allProps {
+		properties = append(properties, types.Property{
+			Key:   key,
+			Value: value,
+		})
+	}
+
+	sort.Slice(properties, func(i, j int) bool {
+		return properties[i].Key < properties[j].Key
+	})
+
+	return properties, nil
+}
+
 func (m *metaStore) BumpVersion() (ver uint64, err error) {
 	key := store.NewKeyBuilder(store.AspectProtocolInfoKeyPrefix).AppendBytes(m.ctx.AspectID.Bytes()).Build()
 	raw, err := m.Load(key)
@@ -199,15 +232,21 @@ func (m *metaStore) StoreVersionMeta(version uint64, meta *types.VersionMeta) er
 func (m *metaStore) StoreMeta(meta *types.AspectMeta) (err error) {
 	oldPayMaster := m.ext.PayMaster
 	oldProof := m.ext.Proof
+	oldOwner := m.ext.Owner
+	oldStatus := m.ext.Status
 
 	m.ext.PayMaster = meta.PayMaster
 	m.ext.Proof = meta.Proof
+	m.ext.Owner = meta.Owner
+	m.ext.Status = uint8(meta.Status)
 
 	defer func() {
 		// rollback if failed
 		if err != nil {
 			m.ext.PayMaster = oldPayMaster
 			m.ext.Proof = oldProof
+			m.ext.Owner = oldOwner
+			m.ext.Status = oldStatus
 		}
 	}()
 
@@ -267,7 +306,7 @@ func (m *metaStore) StoreProperties(version uint64, properties []types.Property)
 	}
 
 	// check limits
-	if len(properties) > types.AspectPropertyLimit {
+	if len(properties) > int(m.ctx.Params().MaxProperties) {
 		return store.ErrTooManyProperties
 	}
 
@@ -329,8 +368,9 @@ func (m *metaStore) StoreBinding(account common.Address, version uint64, joinPoi
 		return err
 	}
 
-	// check max binding amount, an aspect can bind with at most 459000 accounts
-	if length >= maxBindingSize {
+	// check max binding amount, the binding filters of an aspect can manage at most 459000 accounts,
+	// which is the upper bound of the max aspect bindings param
+	if length >= DataLength(m.ctx.Params().MaxAspectBindings) {
 		return store.ErrBindingLimitExceeded
 	}
 
@@ -529,46 +569,63 @@ func (m *metaStore) StoreBinding(account common.Address, version uint64, joinPoi
 }
 
 func (m *metaStore) LoadAspectBoundAccounts() ([]types.Binding, error) {
+	bindings, _, err := m.LoadAspectBoundAccountsPaged(types.BindingFilter{}, types.BindingPage{})
+	return bindings, err
+}
+
+func (m *metaStore) LoadAspectBoundAccountsPaged(filter types.BindingFilter, page types.BindingPage) ([]types.Binding, uint64, error) {
+	if filter.JoinPoint != nil {
+		if _, ok := aspect.JoinPointRunType_value[string(*filter.JoinPoint)]; !ok {
+			return nil, 0, store.ErrInvalidJoinPoint
+		}
+	}
+
 	// key format {5B codePrefix}{8B version}{20B aspectID}
 	key := store.NewKeyBuilder(V1AspectBindingKeyPrefix).
 		AppendBytes(m.ctx.AspectID.Bytes()).AppendByte(V1AspectBindingDataKeyPrefix)
 
 	firstSlot, err := m.Load(key.AppendUint64(0).Build())
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	var length DataLength
 	if err := length.UnmarshalText(firstSlot); err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	// binding format for first slot {8B Length}{256B Bloom}{32B Binding}{32B Binding}...
-	// each slot will save maximum 120 binding info, which is 3840 bytes
-	bindingData := firstSlot[bindingDataLength:]
-	bindings := make([]types.Binding, 0, length)
-	for i := uint64(0); i < uint64(length); i += bindingSlotSize {
-		for j := 0; j < len(bindingData); j += bindingInfoLength {
-			data := bindingData[j : j+bindingInfoLength]
-			var binding Binding
-			if err := binding.UnmarshalText(data); err != nil {
-				return nil, err
-			}
-			bindings = append(bindings, types.Binding(binding))
-			if uint64(len(bindings)) == uint64(length) {
-				// EOF
-				return bindings, nil
+	// each slot will save maximum 120 binding info, which is 3840 bytes,
+	// so we can jump to the slot of the cursor directly without loading the previous slots
+	var bindingData []byte
+	bindings := make([]types.Binding, 0)
+	for i := page.Cursor; i < uint64(length); i++ {
+		if page.Limit > 0 && uint64(len(bindings)) == page.Limit {
+			return bindings, i, nil
+		}
+
+		slot, offset := i/bindingSlotSize, (i%bindingSlotSize)*bindingInfoLength
+		if bindingData == nil || offset == 0 {
+			if slot == 0 {
+				bindingData = firstSlot[bindingDataLength:]
+			} else if bindingData, err = m.Load(key.AppendUint64(slot).Build()); err != nil {
+				return nil, 0, err
 			}
 		}
+		if uint64(len(bindingData)) < offset+bindingInfoLength {
+			return nil, 0, store.ErrStorageCorrupted
+		}
 
-		// load next slot
-		bindingData, err = m.Load(key.AppendUint64((i / bindingSlotSize) + 1).Build())
-		if err != nil {
-			return nil, err
+		var binding Binding
+		if err := binding.UnmarshalText(bindingData[offset : offset+bindingInfoLength]); err != nil {
+			return nil, 0, err
+		}
+		if filter.Match(binding.JoinPoint) {
+			bindings = append(bindings, types.Binding(binding))
 		}
 	}
 
-	return bindings, nil
+	return bindings, 0, nil
 }
 
 func (m *metaStore) RemoveBinding(account common.Address) (err error) {
@@ -805,9 +862,138 @@ BindingFound:
 	return m.Store(firstSlotKey, firstSlot)
 }
 
+// MigrateFrom migrates the aspect meta, versions, properties and bindings from the v0 store.
+// Aspect states are not migrated, they are kept in the v0 layout and accessed with the v0 state store.
 func (m *metaStore) MigrateFrom(old store.AspectMetaStore) error {
-	//TODO implement me
-	panic("implement me")
+	if old.Version() != store.ProtocolVersion(0) {
+		return store.ErrInvalidMigration
+	}
+	if used, err := m.Used(); err != nil {
+		return err
+	} else if used {
+		return store.ErrInvalidMigration
+	}
+
+	latestVersion, err := old.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+	if latestVersion == 0 {
+		// nothing to migrate
+		return nil
+	}
+
+	if err := m.init(old.Version()); err != nil {
+		return err
+	}
+
+	meta, err := old.GetMeta()
+	if err != nil {
+		return err
+	}
+	if err := m.StoreMeta(meta); err != nil {
+		return err
+	}
+
+	for version := uint64(1); version <= latestVersion; version++ {
+		if newVersion, err := m.BumpVersion(); err != nil {
+			return err
+		} else if newVersion != version {
+			return store.ErrInvalidMigration
+		}
+
+		code, err := old.GetCode(version)
+		if err != nil {
+			return err
+		}
+		if err := m.StoreCode(version, code); err != nil {
+			return err
+		}
+
+		versionMeta, err := old.GetVersionMeta(version)
+		if err != nil {
+			return err
+		}
+		if versionMeta.CodeHash == emptyHash {
+			// code hash is not saved in v0 store
+			versionMeta.CodeHash = crypto.Keccak256Hash(code)
+		}
+		if err := m.StoreVersionMeta(version, versionMeta); err != nil {
+			return err
+		}
+
+		properties, err := old.GetProperties(version)
+		if err != nil {
+			return err
+		}
+		if err := m.StoreProperties(version, properties); err != nil {
+			return err
+		}
+	}
+
+	bindings, err := old.LoadAspectBoundAccounts()
+	if err != nil {
+		return err
+	}
+	for _, binding := range bindings {
+		// v0 only saves the bound accounts on the aspect side, the rest of binding info
+		// is loaded from the account side
+		accountBinding, ok, err := m.loadAccountBinding(binding.Account)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			m.ctx.Logger().Debug("skip dangling aspect binding", "aspect", m.ctx.AspectID.Hex(), "account", binding.Account.Hex())
+			continue
+		}
+
+		if err := m.StoreBinding(binding.Account, accountBinding.Version, uint64(accountBinding.JoinPoint), accountBinding.Priority); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// loadAccountBinding loads the binding of current aspect from the given account store.
+func (m *metaStore) loadAccountBinding(account common.Address) (types.Binding, bool, error) {
+	accountStore, _, err := store.GetAccountStore(&types.AccountStoreContext{
+		StoreContext: m.ctx.StoreContext,
+		Account:      account,
+	})
+	if err != nil {
+		return types.Binding{}, false, err
+	}
+
+	bindings, err := accountStore.LoadAccountBoundAspects(types.BindingFilter{})
+	if err != nil {
+		return types.Binding{}, false, err
+	}
+
+	for _, binding := range bindings {
+		if binding.Account != m.ctx.AspectID || binding.Version == 0 {
+			continue
+		}
+
+		joinPoint := binding.JoinPoint
+		if joinPoint == 0 {
+			// join point is not saved in v0 account store, use the one of the bound version
+			meta, err := m.GetVersionMeta(binding.Version)
+			if err != nil {
+				return types.Binding{}, false, err
+			}
+			joinPoint = uint16(meta.JoinPoint)
+		}
+
+		return types.Binding{
+			Account:   account,
+			Version:   binding.Version,
+			Priority:  binding.Priority,
+			JoinPoint: joinPoint,
+		}, true, nil
+	}
+
+	return types.Binding{}, false, nil
 }
 
 func (m *metaStore) Used() (bool, error) {
@@ -815,6 +1001,11 @@ func (m *metaStore) Used() (bool, error) {
 }
 
 func (m *metaStore) Init() error {
+	return m.init(protocolVersion)
+}
+
+// init saves the protocol info of the aspect, with the given version of state store.
+func (m *metaStore) init(stateVersion store.ProtocolVersion) error {
 	versionBytes, err := protocolVersion.MarshalText()
 	if err != nil {
 		return err
@@ -822,7 +1013,7 @@ func (m *metaStore) Init() error {
 
 	info := &store.AspectInfo{
 		MetaVersion:  protocolVersion,
-		StateVersion: protocolVersion,
+		StateVersion: stateVersion,
 	}
 
 	infoBytes, err := info.MarshalText()
@@ -94,6 +94,11 @@ type Extension struct {
 	AspectVersion uint64
 	PayMaster     common.Address
 	Proof         []byte
+	// Owner is the owner saved once the ownership is transferred or renounced,
+	// nil means the ownership is checked with the aspect code
+	Owner *common.Address
+	// Status is the execution status of the aspect, 0 means active
+	Status uint8
 }
 
 func (e *Extension) UnmarshalText(text []byte) error {
@@ -107,20 +112,54 @@ func (e *Extension) UnmarshalText(text []byte) error {
 	e.AspectVersion = binary.BigEndian.Uint64(text[:8])
 	e.PayMaster.SetBytes(text[8:28])
 	proofLen := binary.BigEndian.Uint64(text[28:36])
-	if uint64(len(text)) != 36+proofLen {
+	if uint64(len(text)-36) < proofLen {
 		return store.ErrInvalidExtension
 	}
 	e.Proof = make([]byte, proofLen)
-	copy(e.Proof, text[36:])
+	copy(e.Proof, text[36:36+proofLen])
+
+	// owner and status are optional, and appended after the proof in order,
+	// since they are of different lengths, each combination can be told apart by the length
+	rest := text[36+proofLen:]
+	e.Owner = nil
+	e.Status = 0
+	switch len(rest) {
+	case 0:
+	case 1:
+		e.Status = rest[0]
+	case common.AddressLength, common.AddressLength + 1:
+		owner := common.BytesToAddress(rest[:common.AddressLength])
+		e.Owner = &owner
+		if len(rest) > common.AddressLength {
+			e.Status = rest[common.AddressLength]
+		}
+	default:
+		return store.ErrInvalidExtension
+	}
 	return nil
 }
 
 func (e Extension) MarshalText() (text []byte, err error) {
-	result := make([]byte, 8+20+8+len(e.Proof))
+	size := 8 + 20 + 8 + len(e.Proof)
+	if e.Owner != nil {
+		size += common.AddressLength
+	}
+	if e.Status != 0 {
+		size++
+	}
+	result := make([]byte, size)
 	binary.BigEndian.PutUint64(result[:8], e.AspectVersion)
 	copy(result[8:28], e.PayMaster.Bytes())
 	binary.BigEndian.PutUint64(result[28:36], uint64(len(e.Proof)))
 	copy(result[36:], e.Proof)
+	offset := 36 + len(e.Proof)
+	if e.Owner != nil {
+		copy(result[offset:], e.Owner.Bytes())
+		offset += common.AddressLength
+	}
+	if e.Status != 0 {
+		result[offset] = e.Status
+	}
 	return result, nil
 }
 
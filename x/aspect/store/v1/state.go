@@ -1,6 +1,7 @@
 package v1
 
 import (
+	storetypes "cosmossdk.io/store/types"
 	"github.com/artela-network/artela-rollkit/x/aspect/store"
 	v0 "github.com/artela-network/artela-rollkit/x/aspect/store/v0"
 	"github.com/artela-network/artela-rollkit/x/aspect/types"
@@ -13,7 +14,8 @@ var _ store.AspectStateStore = (*stateStore)(nil)
 type stateStore struct {
 	BaseStore
 
-	ctx *types.AspectStoreContext
+	ctx     *types.AspectStoreContext
+	kvStore storetypes.KVStore
 }
 
 // NewStateStore creates a new instance of account state.
@@ -25,6 +27,7 @@ func NewStateStore(ctx *types.AspectStoreContext) store.AspectStateStore {
 	return &stateStore{
 		BaseStore: NewBaseStore(ctx.CosmosContext().Logger(), v0.NewNoOpGasMeter(ctx), store),
 		ctx:       ctx,
+		kvStore:   store,
 	}
 }
 
@@ -47,3 +50,17 @@ func (s *stateStore) GetState(key []byte) []byte {
 	s.ctx.Logger().Debug("get aspect state", "key", string(key), "value", abbreviateHex(data))
 	return data
 }
+
+// IterateState iterates over all the states of the aspect with the given ID.
+func (s *stateStore) IterateState(cb func(key, value []byte) (stop bool)) {
+	aspectID := s.ctx.AspectID
+	statePrefix := store.NewKeyBuilder(V1AspectStateKeyPrefix).AppendBytes(aspectID.Bytes()).Build()
+	iter := storetypes.KVStorePrefixIterator(s.kvStore, statePrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(iter.Key()[len(statePrefix):], iter.Value()) {
+			return
+		}
+	}
+}
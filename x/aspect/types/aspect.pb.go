@@ -0,0 +1,1389 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: artela/aspect/aspect.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AspectStatus defines the execution status of an aspect.
+type AspectStatus int32
+
+const (
+	// ASPECT_STATUS_ACTIVE means the aspect is executed on the join points it is bound to.
+	AspectStatus_ASPECT_STATUS_ACTIVE AspectStatus = 0
+	// ASPECT_STATUS_PAUSED means the aspect is temporarily not executed, it can be resumed later.
+	AspectStatus_ASPECT_STATUS_PAUSED AspectStatus = 1
+	// ASPECT_STATUS_DEPRECATED means the aspect is no longer executed and cannot be bound any more.
+	AspectStatus_ASPECT_STATUS_DEPRECATED AspectStatus = 2
+)
+
+var AspectStatus_name = map[int32]string{
+	0: "ASPECT_STATUS_ACTIVE",
+	1: "ASPECT_STATUS_PAUSED",
+	2: "ASPECT_STATUS_DEPRECATED",
+}
+
+var AspectStatus_value = map[string]int32{
+	"ASPECT_STATUS_ACTIVE":     0,
+	"ASPECT_STATUS_PAUSED":     1,
+	"ASPECT_STATUS_DEPRECATED": 2,
+}
+
+func (x AspectStatus) String() string {
+	return proto.EnumName(AspectStatus_name, int32(x))
+}
+
+func (AspectStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1e10ae6b46e67b2c, []int{0}
+}
+
+// AspectInfo defines the account level metadata of a deployed aspect.
+type AspectInfo struct {
+	// aspect_id is the hex address of the aspect.
+	AspectId string `protobuf:"bytes,1,opt,name=aspect_id,json=aspectId,proto3" json:"aspect_id,omitempty"`
+	// latest_version is the latest deployed version of the aspect.
+	LatestVersion uint64 `protobuf:"varint,2,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+	// pay_master is the hex address of the account paying for the aspect.
+	PayMaster string `protobuf:"bytes,3,opt,name=pay_master,json=payMaster,proto3" json:"pay_master,omitempty"`
+	// proof is the paymaster proof submitted on deployment.
+	Proof []byte `protobuf:"bytes,4,opt,name=proof,proto3" json:"proof,omitempty"`
+	// meta_version is the protocol version of the store keeping the aspect meta.
+	MetaVersion uint32 `protobuf:"varint,5,opt,name=meta_version,json=metaVersion,proto3" json:"meta_version,omitempty"`
+	// owner is the hex address of the aspect owner, it is only set once the ownership
+	// is transferred or renounced, otherwise the ownership is checked with the aspect code.
+	// A renounced aspect has the zero address as owner.
+	Owner string `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty"`
+	// status is the execution status of the aspect.
+	Status AspectStatus `protobuf:"varint,7,opt,name=status,proto3,enum=artela.aspect.AspectStatus" json:"status,omitempty"`
+}
+
+func (m *AspectInfo) Reset()         { *m = AspectInfo{} }
+func (m *AspectInfo) String() string { return proto.CompactTextString(m) }
+func (*AspectInfo) ProtoMessage()    {}
+func (*AspectInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e10ae6b46e67b2c, []int{0}
+}
+func (m *AspectInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AspectInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AspectInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AspectInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AspectInfo.Merge(m, src)
+}
+func (m *AspectInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *AspectInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_AspectInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AspectInfo proto.InternalMessageInfo
+
+func (m *AspectInfo) GetAspectId() string {
+	if m != nil {
+		return m.AspectId
+	}
+	return ""
+}
+
+func (m *AspectInfo) GetLatestVersion() uint64 {
+	if m != nil {
+		return m.LatestVersion
+	}
+	return 0
+}
+
+func (m *AspectInfo) GetPayMaster() string {
+	if m != nil {
+		return m.PayMaster
+	}
+	return ""
+}
+
+func (m *AspectInfo) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *AspectInfo) GetMetaVersion() uint32 {
+	if m != nil {
+		return m.MetaVersion
+	}
+	return 0
+}
+
+func (m *AspectInfo) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *AspectInfo) GetStatus() AspectStatus {
+	if m != nil {
+		return m.Status
+	}
+	return AspectStatus_ASPECT_STATUS_ACTIVE
+}
+
+// AspectVersion defines the metadata of a specific version of an aspect.
+type AspectVersion struct {
+	// version is the version number of the aspect.
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// join_point is the bitmap of join points the version is able to run on.
+	JoinPoint uint64 `protobuf:"varint,2,opt,name=join_point,json=joinPoint,proto3" json:"join_point,omitempty"`
+	// code_hash is the hex-formatted keccak256 hash of the aspect code.
+	CodeHash string `protobuf:"bytes,3,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+}
+
+func (m *AspectVersion) Reset()         { *m = AspectVersion{} }
+func (m *AspectVersion) String() string { return proto.CompactTextString(m) }
+func (*AspectVersion) ProtoMessage()    {}
+func (*AspectVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e10ae6b46e67b2c, []int{1}
+}
+func (m *AspectVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AspectVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AspectVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AspectVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AspectVersion.Merge(m, src)
+}
+func (m *AspectVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *AspectVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_AspectVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AspectVersion proto.InternalMessageInfo
+
+func (m *AspectVersion) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *AspectVersion) GetJoinPoint() uint64 {
+	if m != nil {
+		return m.JoinPoint
+	}
+	return 0
+}
+
+func (m *AspectVersion) GetCodeHash() string {
+	if m != nil {
+		return m.CodeHash
+	}
+	return ""
+}
+
+// AspectProperty defines a key-value property of an aspect.
+type AspectProperty struct {
+	// key is the key of the property.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// value is the raw value of the property.
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *AspectProperty) Reset()         { *m = AspectProperty{} }
+func (m *AspectProperty) String() string { return proto.CompactTextString(m) }
+func (*AspectProperty) ProtoMessage()    {}
+func (*AspectProperty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e10ae6b46e67b2c, []int{2}
+}
+func (m *AspectProperty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AspectProperty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AspectProperty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AspectProperty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AspectProperty.Merge(m, src)
+}
+func (m *AspectProperty) XXX_Size() int {
+	return m.Size()
+}
+func (m *AspectProperty) XXX_DiscardUnknown() {
+	xxx_messageInfo_AspectProperty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AspectProperty proto.InternalMessageInfo
+
+func (m *AspectProperty) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *AspectProperty) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+// AspectBinding defines a binding between an aspect and an account.
+type AspectBinding struct {
+	// address is the hex address of the other side of the binding, it is the bound account
+	// when queried by aspect, or the aspect id when queried by account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// version is the bound aspect version.
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// priority is the execution priority of the binding.
+	Priority int32 `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	// join_point is the bitmap of join points of the bound aspect version,
+	// it is always 0 for bindings stored with protocol version 0.
+	JoinPoint uint64 `protobuf:"varint,4,opt,name=join_point,json=joinPoint,proto3" json:"join_point,omitempty"`
+}
+
+func (m *AspectBinding) Reset()         { *m = AspectBinding{} }
+func (m *AspectBinding) String() string { return proto.CompactTextString(m) }
+func (*AspectBinding) ProtoMessage()    {}
+func (*AspectBinding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e10ae6b46e67b2c, []int{3}
+}
+func (m *AspectBinding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AspectBinding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AspectBinding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AspectBinding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AspectBinding.Merge(m, src)
+}
+func (m *AspectBinding) XXX_Size() int {
+	return m.Size()
+}
+func (m *AspectBinding) XXX_DiscardUnknown() {
+	xxx_messageInfo_AspectBinding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AspectBinding proto.InternalMessageInfo
+
+func (m *AspectBinding) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AspectBinding) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *AspectBinding) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *AspectBinding) GetJoinPoint() uint64 {
+	if m != nil {
+		return m.JoinPoint
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("artela.aspect.AspectStatus", AspectStatus_name, AspectStatus_value)
+	proto.RegisterType((*AspectInfo)(nil), "artela.aspect.AspectInfo")
+	proto.RegisterType((*AspectVersion)(nil), "artela.aspect.AspectVersion")
+	proto.RegisterType((*AspectProperty)(nil), "artela.aspect.AspectProperty")
+	proto.RegisterType((*AspectBinding)(nil), "artela.aspect.AspectBinding")
+}
+
+func init() { proto.RegisterFile("artela/aspect/aspect.proto", fileDescriptor_1e10ae6b46e67b2c) }
+
+var fileDescriptor_1e10ae6b46e67b2c = []byte{
+	// 479 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0xeb, 0xae, 0x7f, 0x5f, 0xda, 0xaa, 0xb2, 0x7a, 0x88, 0x36, 0x88, 0x4a, 0x25, 0xa4,
+	0x0a, 0x89, 0x56, 0x62, 0x07, 0xb8, 0x66, 0x6d, 0x24, 0x7a, 0x40, 0x0a, 0x49, 0xb6, 0x03, 0x97,
+	0xe0, 0x35, 0xde, 0x6a, 0x9a, 0xc6, 0x91, 0xed, 0x76, 0xe4, 0xc2, 0x67, 0xe0, 0x63, 0x71, 0xdc,
+	0x91, 0x23, 0x6a, 0x3f, 0x02, 0x5f, 0x00, 0x25, 0x4e, 0xc6, 0x3a, 0x4e, 0xf1, 0xf3, 0x7b, 0x9d,
+	0xc7, 0xef, 0xf3, 0xda, 0x70, 0x4a, 0x84, 0xa2, 0x11, 0x99, 0x12, 0x99, 0xd0, 0xa5, 0x2a, 0x3e,
+	0x93, 0x44, 0x70, 0xc5, 0x71, 0x57, 0xd7, 0x26, 0x1a, 0x8e, 0xfe, 0x20, 0x00, 0x2b, 0x5f, 0x2e,
+	0xe2, 0x1b, 0x8e, 0xcf, 0xa0, 0xad, 0x0b, 0x01, 0x0b, 0x0d, 0x34, 0x44, 0xe3, 0xb6, 0xdb, 0xd2,
+	0x60, 0x11, 0xe2, 0x57, 0xd0, 0x8b, 0x88, 0xa2, 0x52, 0x05, 0x3b, 0x2a, 0x24, 0xe3, 0xb1, 0x51,
+	0x1d, 0xa2, 0x71, 0xcd, 0xed, 0x6a, 0x7a, 0xa5, 0x21, 0x7e, 0x01, 0x90, 0x90, 0x34, 0xd8, 0x10,
+	0xa9, 0xa8, 0x30, 0x4e, 0x72, 0x93, 0x76, 0x42, 0xd2, 0x8f, 0x39, 0xc0, 0x03, 0xa8, 0x27, 0x82,
+	0xf3, 0x1b, 0xa3, 0x36, 0x44, 0xe3, 0x8e, 0xab, 0x05, 0x7e, 0x09, 0x9d, 0x0d, 0x55, 0xe4, 0xc1,
+	0xb9, 0x3e, 0x44, 0xe3, 0xae, 0xfb, 0x2c, 0x63, 0xa5, 0xef, 0x00, 0xea, 0xfc, 0x2e, 0xa6, 0xc2,
+	0x68, 0xe4, 0x96, 0x5a, 0xe0, 0x73, 0x68, 0x48, 0x45, 0xd4, 0x56, 0x1a, 0xcd, 0x21, 0x1a, 0xf7,
+	0xde, 0x9e, 0x4d, 0x8e, 0x02, 0x4e, 0x74, 0x38, 0x2f, 0xdf, 0xe2, 0x16, 0x5b, 0x47, 0x14, 0xba,
+	0x9a, 0x97, 0xde, 0x06, 0x34, 0xcb, 0x93, 0x51, 0x9e, 0xa9, 0xb9, 0xfb, 0x97, 0xe6, 0x2b, 0x67,
+	0x71, 0x90, 0x70, 0x16, 0xab, 0x22, 0x70, 0x3b, 0x23, 0x4e, 0x06, 0xb2, 0x81, 0x2d, 0x79, 0x48,
+	0x83, 0x15, 0x91, 0xab, 0x22, 0x6b, 0x2b, 0x03, 0x1f, 0x88, 0x5c, 0x8d, 0xde, 0x43, 0x4f, 0x1f,
+	0xe3, 0x08, 0x9e, 0x50, 0xa1, 0x52, 0xdc, 0x87, 0x93, 0x35, 0x4d, 0x8b, 0xc9, 0x66, 0xcb, 0x2c,
+	0xd5, 0x8e, 0x44, 0x5b, 0x9a, 0x5b, 0x77, 0x5c, 0x2d, 0x46, 0xdf, 0xcb, 0x06, 0x2f, 0x58, 0x1c,
+	0xb2, 0xf8, 0x36, 0x6b, 0x90, 0x84, 0xa1, 0xa0, 0x52, 0x16, 0x3f, 0x97, 0xf2, 0x71, 0xeb, 0xd5,
+	0xe3, 0xd6, 0x4f, 0xa1, 0x95, 0x08, 0xc6, 0x05, 0x53, 0x69, 0xde, 0x5a, 0xdd, 0x7d, 0xd0, 0x4f,
+	0x62, 0xd5, 0x9e, 0xc4, 0x7a, 0xfd, 0x05, 0x3a, 0x8f, 0x07, 0x87, 0x0d, 0x18, 0x58, 0x9e, 0x63,
+	0xcf, 0xfc, 0xc0, 0xf3, 0x2d, 0xff, 0xd2, 0x0b, 0xac, 0x99, 0xbf, 0xb8, 0xb2, 0xfb, 0x95, 0xff,
+	0x2b, 0x8e, 0x75, 0xe9, 0xd9, 0xf3, 0x3e, 0xc2, 0xcf, 0xc1, 0x38, 0xae, 0xcc, 0x6d, 0xc7, 0xb5,
+	0x67, 0x96, 0x6f, 0xcf, 0xfb, 0xd5, 0x8b, 0x4f, 0x3f, 0xf7, 0x26, 0xba, 0xdf, 0x9b, 0xe8, 0xf7,
+	0xde, 0x44, 0x3f, 0x0e, 0x66, 0xe5, 0xfe, 0x60, 0x56, 0x7e, 0x1d, 0xcc, 0xca, 0xe7, 0x77, 0xb7,
+	0x4c, 0xad, 0xb6, 0xd7, 0x93, 0x25, 0xdf, 0x4c, 0xf5, 0x5d, 0xbe, 0x89, 0xa9, 0xba, 0xe3, 0x62,
+	0x5d, 0x4a, 0xc1, 0xa3, 0x68, 0xcd, 0xd4, 0xf4, 0x5b, 0xf9, 0xc2, 0x55, 0x9a, 0x50, 0x79, 0xdd,
+	0xc8, 0x5f, 0xf8, 0xf9, 0xdf, 0x01, 0x00, 0x44, 0xb8, 0xce, 0xd0, 0xff, 0x02, 0x00, 0x00,
+}
+
+func (m *AspectInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AspectInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AspectInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintAspect(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAspect(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MetaVersion != 0 {
+		i = encodeVarintAspect(dAtA, i, uint64(m.MetaVersion))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintAspect(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PayMaster) > 0 {
+		i -= len(m.PayMaster)
+		copy(dAtA[i:], m.PayMaster)
+		i = encodeVarintAspect(dAtA, i, uint64(len(m.PayMaster)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LatestVersion != 0 {
+		i = encodeVarintAspect(dAtA, i, uint64(m.LatestVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AspectId) > 0 {
+		i -= len(m.AspectId)
+		copy(dAtA[i:], m.AspectId)
+		i = encodeVarintAspect(dAtA, i, uint64(len(m.AspectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AspectVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AspectVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AspectVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintAspect(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.JoinPoint != 0 {
+		i = encodeVarintAspect(dAtA, i, uint64(m.JoinPoint))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Version != 0 {
+		i = encodeVarintAspect(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AspectProperty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AspectProperty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AspectProperty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintAspect(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintAspect(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AspectBinding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AspectBinding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AspectBinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JoinPoint != 0 {
+		i = encodeVarintAspect(dAtA, i, uint64(m.JoinPoint))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Priority != 0 {
+		i = encodeVarintAspect(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Version != 0 {
+		i = encodeVarintAspect(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAspect(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAspect(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAspect(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AspectInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AspectId)
+	if l > 0 {
+		n += 1 + l + sovAspect(uint64(l))
+	}
+	if m.LatestVersion != 0 {
+		n += 1 + sovAspect(uint64(m.LatestVersion))
+	}
+	l = len(m.PayMaster)
+	if l > 0 {
+		n += 1 + l + sovAspect(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovAspect(uint64(l))
+	}
+	if m.MetaVersion != 0 {
+		n += 1 + sovAspect(uint64(m.MetaVersion))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAspect(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovAspect(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *AspectVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovAspect(uint64(m.Version))
+	}
+	if m.JoinPoint != 0 {
+		n += 1 + sovAspect(uint64(m.JoinPoint))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovAspect(uint64(l))
+	}
+	return n
+}
+
+func (m *AspectProperty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovAspect(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovAspect(uint64(l))
+	}
+	return n
+}
+
+func (m *AspectBinding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAspect(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovAspect(uint64(m.Version))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovAspect(uint64(m.Priority))
+	}
+	if m.JoinPoint != 0 {
+		n += 1 + sovAspect(uint64(m.JoinPoint))
+	}
+	return n
+}
+
+func sovAspect(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAspect(x uint64) (n int) {
+	return sovAspect(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AspectInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAspect
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AspectInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AspectInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AspectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAspect
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAspect
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAspect
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AspectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestVersion", wireType)
+			}
+			m.LatestVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAspect
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayMaster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAspect
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAspect
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAspect
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayMaster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAspect
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAspect
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAspect
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaVersion", wireType)
+			}
+			m.MetaVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAspect
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MetaVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAspect
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAspect
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAspect
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAspect
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= AspectStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAspect(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAspect
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AspectVersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAspect
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AspectVersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AspectVersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAspect
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinPoint", wireType)
+			}
+			m.JoinPoint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAspect
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JoinPoint |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAspect
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAspect
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAspect
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAspect(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAspect
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AspectProperty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAspect
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AspectProperty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AspectProperty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAspect
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAspect
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAspect
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAspect
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAspect
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAspect
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAspect(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAspect
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AspectBinding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAspect
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AspectBinding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AspectBinding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAspect
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAspect
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAspect
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAspect
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAspect
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinPoint", wireType)
+			}
+			m.JoinPoint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAspect
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JoinPoint |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAspect(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAspect
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAspect(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAspect
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAspect
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAspect
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAspect
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAspect
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAspect
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAspect        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAspect          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAspect = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,8 +0,0 @@
-package types
-
-import "math"
-
-const (
-	// AspectPropertyLimit is the maximum number of properties that can be set on an aspect
-	AspectPropertyLimit = math.MaxUint8
-)
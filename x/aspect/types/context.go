@@ -1,9 +1,12 @@
 package types
 
 import (
+	"fmt"
+
 	cstore "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	"github.com/artela-network/artela-evm/vm"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -13,6 +16,7 @@ type storeContext struct {
 	evmStoreService cstore.KVStoreService
 	storeService    cstore.KVStoreService
 	gas             uint64
+	params          *Params
 
 	chargeGas bool
 }
@@ -23,7 +27,26 @@ func (s *storeContext) clone() StoreContext {
 		evmStoreService: s.evmStoreService,
 		storeService:    s.storeService,
 		gas:             s.gas,
+		params:          s.params,
+	}
+}
+
+// Params returns the aspect module params, they are loaded from the store on first use.
+// The default params are returned if no params are stored yet, same as Keeper.GetParams.
+func (s *storeContext) Params() Params {
+	if s.params != nil {
+		return *s.params
 	}
+
+	var params Params
+	bz := runtime.KVStoreAdapter(s.storeService.OpenKVStore(s.cosmosCtx)).Get(ParamsKey)
+	if err := params.Unmarshal(bz); err != nil {
+		panic(fmt.Sprintf("failed to unmarshal aspect params: %v", err))
+	}
+	params = ParamsOrDefault(params)
+
+	s.params = &params
+	return params
 }
 
 func (s *storeContext) Logger() log.Logger {
@@ -72,6 +95,7 @@ type StoreContext interface {
 	UpdateGas(gas uint64)
 	Logger() log.Logger
 	ChargeGas() bool
+	Params() Params
 
 	clone() StoreContext
 }
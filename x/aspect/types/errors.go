@@ -8,6 +8,7 @@ import (
 
 // x/aspect module sentinel errors
 var (
-	ErrInvalidSigner = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
-	ErrSample        = sdkerrors.Register(ModuleName, 1101, "sample error")
+	ErrInvalidSigner       = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrSample              = sdkerrors.Register(ModuleName, 1101, "sample error")
+	ErrInvalidAspectStatus = sdkerrors.Register(ModuleName, 1102, "invalid aspect status")
 )
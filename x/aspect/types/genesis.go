@@ -1,5 +1,13 @@
 package types
 
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
 // this line is used by starport scaffolding # genesis/types/import
 
 // DefaultIndex is the default global index
@@ -8,6 +16,9 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
+		Aspects:             []GenesisAspect{},
+		Accounts:            []GenesisAccount{},
+		PaymasterAllowances: []GenesisPaymasterAllowance{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -16,7 +27,217 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	// limits of aspects are validated against the params
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	// deployed versions of each aspect
+	aspectVersions := make(map[common.Address]uint64, len(gs.Aspects))
+	// bindings declared on the aspect side, keyed by {aspect}{account}
+	aspectBindings := make(map[[2 * common.AddressLength]byte]struct{})
+	for _, aspect := range gs.Aspects {
+		if !common.IsHexAddress(aspect.AspectId) {
+			return fmt.Errorf("invalid aspect id: %s", aspect.AspectId)
+		}
+		aspectID := common.HexToAddress(aspect.AspectId)
+		if _, ok := aspectVersions[aspectID]; ok {
+			return fmt.Errorf("duplicated aspect %s", aspect.AspectId)
+		}
+		if err := aspect.Validate(); err != nil {
+			return err
+		}
+		for _, version := range aspect.Versions {
+			if len(version.Properties) > int(gs.Params.MaxProperties) {
+				return fmt.Errorf("aspect %s: version %d: too many properties", aspect.AspectId, version.Version)
+			}
+		}
+		aspectVersions[aspectID] = uint64(len(aspect.Versions))
+
+		for _, binding := range aspect.Bindings {
+			aspectBindings[bindingKey(aspectID, common.HexToAddress(binding.Address))] = struct{}{}
+		}
+	}
+
+	accounts := make(map[common.Address]struct{}, len(gs.Accounts))
+	for _, account := range gs.Accounts {
+		if !common.IsHexAddress(account.Account) {
+			return fmt.Errorf("invalid account: %s", account.Account)
+		}
+		accountAddr := common.HexToAddress(account.Account)
+		if _, ok := accounts[accountAddr]; ok {
+			return fmt.Errorf("duplicated account %s", account.Account)
+		}
+		accounts[accountAddr] = struct{}{}
+
+		bound := make(map[common.Address]struct{}, len(account.Bindings))
+		for _, binding := range account.Bindings {
+			if !common.IsHexAddress(binding.Address) {
+				return fmt.Errorf("account %s: invalid aspect id: %s", account.Account, binding.Address)
+			}
+			aspectID := common.HexToAddress(binding.Address)
+			if _, ok := bound[aspectID]; ok {
+				return fmt.Errorf("account %s: duplicated binding of aspect %s", account.Account, binding.Address)
+			}
+			bound[aspectID] = struct{}{}
+
+			latestVersion, ok := aspectVersions[aspectID]
+			if !ok {
+				return fmt.Errorf("account %s: bound to unknown aspect %s", account.Account, binding.Address)
+			}
+			if binding.Version == 0 || binding.Version > latestVersion {
+				return fmt.Errorf("account %s: bound to unknown version %d of aspect %s",
+					account.Account, binding.Version, binding.Address)
+			}
+			if binding.JoinPoint == 0 {
+				return fmt.Errorf("account %s: binding of aspect %s has no join point", account.Account, binding.Address)
+			}
+
+			key := bindingKey(aspectID, accountAddr)
+			if _, ok := aspectBindings[key]; !ok {
+				return fmt.Errorf("account %s: binding of aspect %s is missing on the aspect side",
+					account.Account, binding.Address)
+			}
+			delete(aspectBindings, key)
+		}
+	}
+
+	// all bindings left on the aspect side have no account side counterpart
+	for _, aspect := range gs.Aspects {
+		for _, binding := range aspect.Bindings {
+			if _, ok := aspectBindings[bindingKey(common.HexToAddress(aspect.AspectId), common.HexToAddress(binding.Address))]; ok {
+				return fmt.Errorf("aspect %s: binding of account %s is missing on the account side",
+					aspect.AspectId, binding.Address)
+			}
+		}
+	}
+
+	// the allowances are only kept for the deployed aspects
+	allowances := make(map[[2 * common.AddressLength]byte]struct{}, len(gs.PaymasterAllowances))
+	for _, allowance := range gs.PaymasterAllowances {
+		if err := allowance.Validate(); err != nil {
+			return err
+		}
+		aspectID := common.HexToAddress(allowance.AspectId)
+		if _, ok := aspectVersions[aspectID]; !ok {
+			return fmt.Errorf("paymaster %s: allowance of unknown aspect %s", allowance.Paymaster, allowance.AspectId)
+		}
+
+		key := bindingKey(common.HexToAddress(allowance.Paymaster), aspectID)
+		if _, ok := allowances[key]; ok {
+			return fmt.Errorf("paymaster %s: duplicated allowance of aspect %s", allowance.Paymaster, allowance.AspectId)
+		}
+		allowances[key] = struct{}{}
+	}
+
 	// this line is used by starport scaffolding # genesis/types/validate
 
-	return gs.Params.Validate()
+	return nil
+}
+
+// Validate performs basic validation of a genesis aspect.
+func (a GenesisAspect) Validate() error {
+	if a.PayMaster != "" && !common.IsHexAddress(a.PayMaster) {
+		return fmt.Errorf("aspect %s: invalid pay master: %s", a.AspectId, a.PayMaster)
+	}
+	if a.Owner != "" && !common.IsHexAddress(a.Owner) {
+		return fmt.Errorf("aspect %s: invalid owner: %s", a.AspectId, a.Owner)
+	}
+	if _, ok := AspectStatus_name[int32(a.Status)]; !ok {
+		return fmt.Errorf("aspect %s: invalid status: %d", a.AspectId, a.Status)
+	}
+
+	if len(a.Versions) == 0 {
+		return fmt.Errorf("aspect %s: no version deployed", a.AspectId)
+	}
+	for i, version := range a.Versions {
+		if version.Version != uint64(i+1) {
+			return fmt.Errorf("aspect %s: expected version %d, got %d", a.AspectId, i+1, version.Version)
+		}
+		if err := version.Validate(); err != nil {
+			return fmt.Errorf("aspect %s: %w", a.AspectId, err)
+		}
+	}
+
+	accounts := make(map[common.Address]struct{}, len(a.Bindings))
+	for _, binding := range a.Bindings {
+		if !common.IsHexAddress(binding.Address) {
+			return fmt.Errorf("aspect %s: invalid bound account: %s", a.AspectId, binding.Address)
+		}
+		account := common.HexToAddress(binding.Address)
+		if _, ok := accounts[account]; ok {
+			return fmt.Errorf("aspect %s: duplicated binding of account %s", a.AspectId, binding.Address)
+		}
+		accounts[account] = struct{}{}
+
+		if binding.Version == 0 || binding.Version > uint64(len(a.Versions)) {
+			return fmt.Errorf("aspect %s: account %s bound to unknown version %d",
+				a.AspectId, binding.Address, binding.Version)
+		}
+	}
+
+	for _, state := range a.State {
+		if len(state.Key) == 0 {
+			return fmt.Errorf("aspect %s: empty state key", a.AspectId)
+		}
+	}
+
+	return nil
+}
+
+// Validate performs basic validation of a genesis aspect version.
+func (v GenesisAspectVersion) Validate() error {
+	if len(v.Code) == 0 {
+		return fmt.Errorf("version %d: empty code", v.Version)
+	}
+	if codeHash := crypto.Keccak256Hash(v.Code); common.HexToHash(v.CodeHash) != codeHash {
+		return fmt.Errorf("version %d: code hash mismatch, expected %s, got %s", v.Version, codeHash.Hex(), v.CodeHash)
+	}
+
+	keys := make(map[string]struct{}, len(v.Properties))
+	for _, property := range v.Properties {
+		if _, ok := keys[property.Key]; ok {
+			return fmt.Errorf("version %d: duplicated property %s", v.Version, property.Key)
+		}
+		keys[property.Key] = struct{}{}
+	}
+
+	return nil
+}
+
+// Validate performs basic validation of a genesis paymaster allowance.
+func (a GenesisPaymasterAllowance) Validate() error {
+	if !common.IsHexAddress(a.Paymaster) {
+		return fmt.Errorf("invalid paymaster: %s", a.Paymaster)
+	}
+	if !common.IsHexAddress(a.AspectId) {
+		return fmt.Errorf("paymaster %s: invalid aspect id: %s", a.Paymaster, a.AspectId)
+	}
+
+	_, err := a.ToAllowance()
+	return err
+}
+
+// ToAllowance parses the limit and the spent of the genesis paymaster allowance, both must fit in 256 bits,
+// and the spent cannot exceed the limit.
+func (a GenesisPaymasterAllowance) ToAllowance() (*PaymasterAllowance, error) {
+	limit, ok := new(big.Int).SetString(a.Limit, 10)
+	if !ok || limit.Sign() < 0 || limit.BitLen() > 256 {
+		return nil, fmt.Errorf("paymaster %s: aspect %s: invalid limit: %s", a.Paymaster, a.AspectId, a.Limit)
+	}
+	spent, ok := new(big.Int).SetString(a.Spent, 10)
+	if !ok || spent.Sign() < 0 || spent.BitLen() > 256 {
+		return nil, fmt.Errorf("paymaster %s: aspect %s: invalid spent: %s", a.Paymaster, a.AspectId, a.Spent)
+	}
+	if spent.Cmp(limit) > 0 {
+		return nil, fmt.Errorf("paymaster %s: aspect %s: spent %s exceeds limit %s", a.Paymaster, a.AspectId, a.Spent, a.Limit)
+	}
+
+	return &PaymasterAllowance{Limit: limit, Spent: spent}, nil
+}
+
+func bindingKey(aspectID, account common.Address) (key [2 * common.AddressLength]byte) {
+	copy(key[:common.AddressLength], aspectID.Bytes())
+	copy(key[common.AddressLength:], account.Bytes())
+	return
 }
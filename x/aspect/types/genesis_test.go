@@ -1,12 +1,44 @@
 package types_test
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/artela-network/artela-rollkit/x/aspect/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 )
 
+const (
+	testAspectID  = "0x0000000000000000000000000000000000000A01"
+	testAccount   = "0x00000000000000000000000000000000000000C1"
+	testPayMaster = "0x00000000000000000000000000000000000000F1"
+)
+
+func testGenesisAllowance(limit, spent string) *types.GenesisState {
+	return &types.GenesisState{
+		Params:  types.DefaultParams(),
+		Aspects: []types.GenesisAspect{testGenesisAspect()},
+		PaymasterAllowances: []types.GenesisPaymasterAllowance{
+			{Paymaster: testPayMaster, AspectId: testAspectID, Limit: limit, Spent: spent},
+		},
+	}
+}
+
+func testGenesisAspect(bindings ...types.AspectBinding) types.GenesisAspect {
+	return types.GenesisAspect{
+		AspectId: testAspectID,
+		Versions: []types.GenesisAspectVersion{{
+			Version:    1,
+			JoinPoint:  1,
+			Code:       []byte("code"),
+			CodeHash:   crypto.Keccak256Hash([]byte("code")).Hex(),
+			Properties: []types.AspectProperty{{Key: "k", Value: []byte("v")}},
+		}},
+		Bindings: bindings,
+	}
+}
+
 func TestGenesisState_Validate(t *testing.T) {
 	tests := []struct {
 		desc     string
@@ -19,13 +51,187 @@ func TestGenesisState_Validate(t *testing.T) {
 			valid:    true,
 		},
 		{
-			desc:     "valid genesis state",
+			desc: "valid genesis state",
 			genState: &types.GenesisState{
-
+				Params: types.DefaultParams(),
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
 		},
+		{
+			desc: "valid aspects and bindings",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Aspects: []types.GenesisAspect{
+					testGenesisAspect(types.AspectBinding{Address: testAccount, Version: 1, JoinPoint: 1}),
+				},
+				Accounts: []types.GenesisAccount{{
+					Account:  testAccount,
+					Bindings: []types.AspectBinding{{Address: testAspectID, Version: 1, JoinPoint: 1}},
+				}},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated aspect",
+			genState: &types.GenesisState{
+				Params:  types.DefaultParams(),
+				Aspects: []types.GenesisAspect{testGenesisAspect(), testGenesisAspect()},
+			},
+			valid: false,
+		},
+		{
+			desc: "code hash mismatch",
+			genState: func() *types.GenesisState {
+				aspect := testGenesisAspect()
+				aspect.Versions[0].CodeHash = crypto.Keccak256Hash([]byte("other")).Hex()
+				return &types.GenesisState{Params: types.DefaultParams(), Aspects: []types.GenesisAspect{aspect}}
+			}(),
+			valid: false,
+		},
+		{
+			desc: "invalid owner",
+			genState: func() *types.GenesisState {
+				aspect := testGenesisAspect()
+				aspect.Owner = "owner"
+				return &types.GenesisState{Params: types.DefaultParams(), Aspects: []types.GenesisAspect{aspect}}
+			}(),
+			valid: false,
+		},
+		{
+			desc: "invalid status",
+			genState: func() *types.GenesisState {
+				aspect := testGenesisAspect()
+				aspect.Status = types.AspectStatus(100)
+				return &types.GenesisState{Params: types.DefaultParams(), Aspects: []types.GenesisAspect{aspect}}
+			}(),
+			valid: false,
+		},
+		{
+			desc: "non-contiguous versions",
+			genState: func() *types.GenesisState {
+				aspect := testGenesisAspect()
+				aspect.Versions[0].Version = 2
+				return &types.GenesisState{Params: types.DefaultParams(), Aspects: []types.GenesisAspect{aspect}}
+			}(),
+			valid: false,
+		},
+		{
+			desc: "account bound to unknown aspect",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Accounts: []types.GenesisAccount{{
+					Account:  testAccount,
+					Bindings: []types.AspectBinding{{Address: testAspectID, Version: 1, JoinPoint: 1}},
+				}},
+			},
+			valid: false,
+		},
+		{
+			desc: "account bound to unknown version",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Aspects: []types.GenesisAspect{
+					testGenesisAspect(types.AspectBinding{Address: testAccount, Version: 1, JoinPoint: 1}),
+				},
+				Accounts: []types.GenesisAccount{{
+					Account:  testAccount,
+					Bindings: []types.AspectBinding{{Address: testAspectID, Version: 2, JoinPoint: 1}},
+				}},
+			},
+			valid: false,
+		},
+		{
+			desc: "binding missing on account side",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Aspects: []types.GenesisAspect{
+					testGenesisAspect(types.AspectBinding{Address: testAccount, Version: 1, JoinPoint: 1}),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "binding missing on aspect side",
+			genState: &types.GenesisState{
+				Params:  types.DefaultParams(),
+				Aspects: []types.GenesisAspect{testGenesisAspect()},
+				Accounts: []types.GenesisAccount{{
+					Account:  testAccount,
+					Bindings: []types.AspectBinding{{Address: testAspectID, Version: 1, JoinPoint: 1}},
+				}},
+			},
+			valid: false,
+		},
+		{
+			desc:     "invalid params",
+			genState: &types.GenesisState{},
+			valid:    false,
+		},
+		{
+			desc: "too many properties",
+			genState: func() *types.GenesisState {
+				params := types.DefaultParams()
+				params.MaxProperties = 1
+				aspect := testGenesisAspect()
+				aspect.Versions[0].Properties = append(aspect.Versions[0].Properties, types.AspectProperty{Key: "k2"})
+				return &types.GenesisState{Params: params, Aspects: []types.GenesisAspect{aspect}}
+			}(),
+			valid: false,
+		},
+		{
+			desc:     "valid paymaster allowance",
+			genState: testGenesisAllowance("1000", "1000"),
+			valid:    true,
+		},
+		{
+			desc: "allowance of unknown aspect",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				PaymasterAllowances: []types.GenesisPaymasterAllowance{
+					{Paymaster: testPayMaster, AspectId: testAspectID, Limit: "1000", Spent: "0"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated allowance",
+			genState: func() *types.GenesisState {
+				genState := testGenesisAllowance("1000", "0")
+				genState.PaymasterAllowances = append(genState.PaymasterAllowances, genState.PaymasterAllowances[0])
+				return genState
+			}(),
+			valid: false,
+		},
+		{
+			desc: "invalid paymaster",
+			genState: func() *types.GenesisState {
+				genState := testGenesisAllowance("1000", "0")
+				genState.PaymasterAllowances[0].Paymaster = "paymaster"
+				return genState
+			}(),
+			valid: false,
+		},
+		{
+			desc:     "spent exceeds limit",
+			genState: testGenesisAllowance("1000", "1001"),
+			valid:    false,
+		},
+		{
+			desc:     "negative spent",
+			genState: testGenesisAllowance("1000", "-1"),
+			valid:    false,
+		},
+		{
+			desc:     "limit exceeds 256 bits",
+			genState: testGenesisAllowance(new(big.Int).Lsh(big.NewInt(1), 256).String(), "0"),
+			valid:    false,
+		},
+		{
+			desc:     "invalid limit",
+			genState: testGenesisAllowance("", "0"),
+			valid:    false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"math/big"
+
 	"github.com/artela-network/aspect-core/types"
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -31,6 +33,52 @@ func NewJoinPointFilter(cut types.PointCut) BindingFilter {
 	}
 }
 
+// Match returns whether a binding with the given join point passes the filter.
+// Bindings saved without join point (v0 store) are always matched,
+// since their join points are unknown to the store.
+func (f BindingFilter) Match(joinPoint uint16) bool {
+	if joinPoint == 0 {
+		return true
+	}
+
+	jp := int64(joinPoint)
+	if f.JoinPoint != nil && !types.CanExecPoint(jp, *f.JoinPoint) {
+		return false
+	}
+	if f.VerifierOnly && !types.CheckIsTxVerifier(jp) {
+		return false
+	}
+	if f.TxLevelOnly && !types.CheckIsTransactionLevel(jp) {
+		return false
+	}
+
+	return true
+}
+
+// BindingPage is the data model for holding the pagination of querying aspect bindings
+type BindingPage struct {
+	// Cursor is the position in the binding list to start scanning from, 0 starts from the beginning
+	Cursor uint64
+	// Limit is the maximum number of bindings to return, 0 returns all remaining bindings
+	Limit uint64
+}
+
+// PaginateBindings returns a page of the bindings passing the filter, together with
+// the cursor of the next page. The returned cursor is 0 if all bindings have been scanned.
+func PaginateBindings(bindings []Binding, filter BindingFilter, page BindingPage) ([]Binding, uint64) {
+	result := make([]Binding, 0)
+	for i := page.Cursor; i < uint64(len(bindings)); i++ {
+		if page.Limit > 0 && uint64(len(result)) == page.Limit {
+			return result, i
+		}
+		if filter.Match(bindings[i].JoinPoint) {
+			result = append(result, bindings[i])
+		}
+	}
+
+	return result, 0
+}
+
 // Binding is the data model for holding the binding of an aspect to an account
 type Binding struct {
 	Account   common.Address
@@ -49,6 +97,11 @@ type VersionMeta struct {
 type AspectMeta struct {
 	PayMaster common.Address
 	Proof     []byte
+	// Owner is the owner of the aspect, set on deployment and when the ownership is transferred or renounced,
+	// nil means the aspect was deployed without one and the ownership is checked with the aspect code
+	Owner *common.Address
+	// Status is the execution status of the aspect, paused or deprecated aspects are not executed
+	Status AspectStatus
 }
 
 // Property is the data model for holding the properties of an aspect
@@ -56,3 +109,31 @@ type Property struct {
 	Key   string `json:"Key"`
 	Value []byte `json:"Value"`
 }
+
+// PaymasterAllowance is the data model for holding the spending limit set by a paymaster for sponsoring
+// the gas fees of aspect verified txs, together with the fees spent so far. Zero limit disables sponsoring.
+type PaymasterAllowance struct {
+	Limit *big.Int
+	Spent *big.Int
+}
+
+func NewPaymasterAllowance() *PaymasterAllowance {
+	return &PaymasterAllowance{
+		Limit: new(big.Int),
+		Spent: new(big.Int),
+	}
+}
+
+// Enabled returns whether the paymaster sponsors the gas fees.
+func (a PaymasterAllowance) Enabled() bool {
+	return a.Limit.Sign() > 0
+}
+
+// Remaining returns the fees that can still be sponsored, it is zero if the limit is lowered below the spent.
+func (a PaymasterAllowance) Remaining() *big.Int {
+	remaining := new(big.Int).Sub(a.Limit, a.Spent)
+	if remaining.Sign() < 0 {
+		return new(big.Int)
+	}
+	return remaining
+}
@@ -0,0 +1,26 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var _ sdk.Msg = &MsgSetAspectStatus{}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSetAspectStatus) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if !common.IsHexAddress(m.AspectId) {
+		return errorsmod.Wrapf(ErrInvalidAspectStatus, "invalid aspect id %s", m.AspectId)
+	}
+
+	if _, ok := AspectStatus_name[int32(m.Status)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidAspectStatus, "unknown status %d", m.Status)
+	}
+
+	return nil
+}
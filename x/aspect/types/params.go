@@ -1,32 +1,288 @@
 package types
 
 import (
+	"fmt"
+	"math"
+
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	// DefaultMaxAccountBindings is 255
+	DefaultMaxAccountBindings = uint32(math.MaxUint8)
+	// DefaultMaxAspectBindings is 459000, the capacity of the binding filters of an aspect
+	DefaultMaxAspectBindings = MaxAspectBindingsLimit
+	// DefaultMaxProperties is 255
+	DefaultMaxProperties = uint32(math.MaxUint8)
+	// DefaultMaxCodeSize is 1MiB
+	DefaultMaxCodeSize = uint64(1024 * 1024)
+	// DefaultStorageLoadCost is 10 gas per 32 bytes
+	DefaultStorageLoadCost = uint64(10)
+	// DefaultStorageStoreCost is 1000 gas per 32 bytes
+	DefaultStorageStoreCost = uint64(1000)
+	// DefaultStorageSaveCodeCost is 1000 gas per 32 bytes
+	DefaultStorageSaveCodeCost = uint64(1000)
+	// DefaultStorageUpdateCost is 1000 gas per 32 bytes
+	DefaultStorageUpdateCost = uint64(1000)
+)
+
+// MaxAspectBindingsLimit is the maximum number of accounts the binding filters of an aspect can manage,
+// which is 15 filter managed slots * 255 filters * 120 bindings per slot.
+const MaxAspectBindingsLimit = uint32(15 * math.MaxUint8 * 120)
+
+// Parameter keys
+var (
+	ParamStoreKeyMaxAccountBindings  = []byte("MaxAccountBindings")
+	ParamStoreKeyMaxAspectBindings   = []byte("MaxAspectBindings")
+	ParamStoreKeyMaxProperties       = []byte("MaxProperties")
+	ParamStoreKeyMaxCodeSize         = []byte("MaxCodeSize")
+	ParamStoreKeyStorageLoadCost     = []byte("StorageLoadCost")
+	ParamStoreKeyStorageStoreCost    = []byte("StorageStoreCost")
+	ParamStoreKeyStorageSaveCodeCost = []byte("StorageSaveCodeCost")
+	ParamStoreKeyStorageUpdateCost   = []byte("StorageUpdateCost")
+	ParamStoreKeyAllowedCodeHashes   = []byte("AllowedCodeHashes")
+	ParamStoreKeyDeniedCodeHashes    = []byte("DeniedCodeHashes")
+	ParamStoreKeyAllowedDeployers    = []byte("AllowedDeployers")
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	maxAccountBindings,
+	maxAspectBindings,
+	maxProperties uint32,
+	maxCodeSize,
+	storageLoadCost,
+	storageStoreCost,
+	storageSaveCodeCost,
+	storageUpdateCost uint64,
+	allowedCodeHashes,
+	deniedCodeHashes,
+	allowedDeployers []string,
+) Params {
+	return Params{
+		MaxAccountBindings:  maxAccountBindings,
+		MaxAspectBindings:   maxAspectBindings,
+		MaxProperties:       maxProperties,
+		MaxCodeSize:         maxCodeSize,
+		StorageLoadCost:     storageLoadCost,
+		StorageStoreCost:    storageStoreCost,
+		StorageSaveCodeCost: storageSaveCodeCost,
+		StorageUpdateCost:   storageUpdateCost,
+		AllowedCodeHashes:   allowedCodeHashes,
+		DeniedCodeHashes:    deniedCodeHashes,
+		AllowedDeployers:    allowedDeployers,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		DefaultMaxAccountBindings,
+		DefaultMaxAspectBindings,
+		DefaultMaxProperties,
+		DefaultMaxCodeSize,
+		DefaultStorageLoadCost,
+		DefaultStorageStoreCost,
+		DefaultStorageSaveCodeCost,
+		DefaultStorageUpdateCost,
+		nil,
+		nil,
+		nil,
+	)
+}
+
+// ParamsOrDefault returns the default params if the given params are empty. Params were not stored
+// before module version 3, so stores not migrated yet decode to zero params, which are never valid.
+func ParamsOrDefault(params Params) Params {
+	if params.Equal(Params{}) {
+		return DefaultParams()
+	}
+	return params
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxAccountBindings, &p.MaxAccountBindings, validatePositiveUint32),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxAspectBindings, &p.MaxAspectBindings, validateMaxAspectBindings),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxProperties, &p.MaxProperties, validatePositiveUint32),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxCodeSize, &p.MaxCodeSize, validatePositiveUint64),
+		paramtypes.NewParamSetPair(ParamStoreKeyStorageLoadCost, &p.StorageLoadCost, validateUint64),
+		paramtypes.NewParamSetPair(ParamStoreKeyStorageStoreCost, &p.StorageStoreCost, validateUint64),
+		paramtypes.NewParamSetPair(ParamStoreKeyStorageSaveCodeCost, &p.StorageSaveCodeCost, validateUint64),
+		paramtypes.NewParamSetPair(ParamStoreKeyStorageUpdateCost, &p.StorageUpdateCost, validateUint64),
+		paramtypes.NewParamSetPair(ParamStoreKeyAllowedCodeHashes, &p.AllowedCodeHashes, validateCodeHashes),
+		paramtypes.NewParamSetPair(ParamStoreKeyDeniedCodeHashes, &p.DeniedCodeHashes, validateCodeHashes),
+		paramtypes.NewParamSetPair(ParamStoreKeyAllowedDeployers, &p.AllowedDeployers, validateAddresses),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if p.MaxAccountBindings == 0 {
+		return fmt.Errorf("max account bindings cannot be 0")
+	}
+
+	if err := validateMaxAspectBindings(p.MaxAspectBindings); err != nil {
+		return err
+	}
+
+	if p.MaxProperties == 0 {
+		return fmt.Errorf("max properties cannot be 0")
+	}
+
+	if p.MaxCodeSize == 0 {
+		return fmt.Errorf("max code size cannot be 0")
+	}
+
+	// storage costs can be 0, which makes aspect storage free, and
+	// any aspect is allowed if the allow lists are empty
+	if err := validateCodeHashes(p.AllowedCodeHashes); err != nil {
+		return err
+	}
+	if err := validateCodeHashes(p.DeniedCodeHashes); err != nil {
+		return err
+	}
+	for _, denied := range p.DeniedCodeHashes {
+		for _, allowed := range p.AllowedCodeHashes {
+			if common.HexToHash(denied) == common.HexToHash(allowed) {
+				return fmt.Errorf("code hash %s is both allowed and denied", denied)
+			}
+		}
+	}
+
+	return validateAddresses(p.AllowedDeployers)
+}
+
+// IsCodeHashAllowed returns whether aspects with the given code hash can be deployed, upgraded to and bound.
+func (p Params) IsCodeHashAllowed(codeHash common.Hash) bool {
+	for _, denied := range p.DeniedCodeHashes {
+		if common.HexToHash(denied) == codeHash {
+			return false
+		}
+	}
+
+	if len(p.AllowedCodeHashes) == 0 {
+		return true
+	}
+	for _, allowed := range p.AllowedCodeHashes {
+		if common.HexToHash(allowed) == codeHash {
+			return true
+		}
+	}
+	return false
+}
+
+// IsDeployerAllowed returns whether the given account can deploy and upgrade aspects.
+func (p Params) IsDeployerAllowed(deployer common.Address) bool {
+	if len(p.AllowedDeployers) == 0 {
+		return true
+	}
+	for _, allowed := range p.AllowedDeployers {
+		if common.HexToAddress(allowed) == deployer {
+			return true
+		}
+	}
+	return false
+}
+
+func validatePositiveUint32(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("parameter must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validatePositiveUint64(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("parameter must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateUint64(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxAspectBindings(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max aspect bindings cannot be 0")
+	}
+
+	if v > MaxAspectBindingsLimit {
+		return fmt.Errorf("max aspect bindings cannot exceed %d: %d", MaxAspectBindingsLimit, v)
+	}
+
+	return nil
+}
+
+func validateCodeHashes(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[common.Hash]struct{}, len(v))
+	for _, codeHash := range v {
+		decoded, err := hexutil.Decode(codeHash)
+		if err != nil || len(decoded) != common.HashLength {
+			return fmt.Errorf("invalid code hash: %s", codeHash)
+		}
+		if _, ok := seen[common.BytesToHash(decoded)]; ok {
+			return fmt.Errorf("duplicated code hash: %s", codeHash)
+		}
+		seen[common.BytesToHash(decoded)] = struct{}{}
+	}
+
+	return nil
+}
+
+func validateAddresses(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[common.Address]struct{}, len(v))
+	for _, address := range v {
+		if !common.IsHexAddress(address) {
+			return fmt.Errorf("invalid address: %s", address)
+		}
+		if _, ok := seen[common.HexToAddress(address)]; ok {
+			return fmt.Errorf("duplicated address: %s", address)
+		}
+		seen[common.HexToAddress(address)] = struct{}{}
+	}
+
 	return nil
 }
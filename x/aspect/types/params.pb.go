@@ -26,6 +26,32 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// max_account_bindings is the maximum number of aspects an account can be bound with.
+	MaxAccountBindings uint32 `protobuf:"varint,1,opt,name=max_account_bindings,json=maxAccountBindings,proto3" json:"max_account_bindings,omitempty"`
+	// max_aspect_bindings is the maximum number of accounts an aspect can be bound with.
+	MaxAspectBindings uint32 `protobuf:"varint,2,opt,name=max_aspect_bindings,json=maxAspectBindings,proto3" json:"max_aspect_bindings,omitempty"`
+	// max_properties is the maximum number of properties an aspect version can have.
+	MaxProperties uint32 `protobuf:"varint,3,opt,name=max_properties,json=maxProperties,proto3" json:"max_properties,omitempty"`
+	// max_code_size is the maximum size of aspect code in bytes, checked on deployment and upgrade.
+	MaxCodeSize uint64 `protobuf:"varint,4,opt,name=max_code_size,json=maxCodeSize,proto3" json:"max_code_size,omitempty"`
+	// storage_load_cost is the gas charged per 32 bytes loaded from aspect store.
+	StorageLoadCost uint64 `protobuf:"varint,5,opt,name=storage_load_cost,json=storageLoadCost,proto3" json:"storage_load_cost,omitempty"`
+	// storage_store_cost is the gas charged per 32 bytes stored to aspect store.
+	StorageStoreCost uint64 `protobuf:"varint,6,opt,name=storage_store_cost,json=storageStoreCost,proto3" json:"storage_store_cost,omitempty"`
+	// storage_save_code_cost is the gas charged per 32 bytes of aspect code saved.
+	StorageSaveCodeCost uint64 `protobuf:"varint,7,opt,name=storage_save_code_cost,json=storageSaveCodeCost,proto3" json:"storage_save_code_cost,omitempty"`
+	// storage_update_cost is the gas charged per 32 bytes updated in aspect store.
+	StorageUpdateCost uint64 `protobuf:"varint,8,opt,name=storage_update_cost,json=storageUpdateCost,proto3" json:"storage_update_cost,omitempty"`
+	// allowed_code_hashes is the list of hex-formatted aspect code hashes allowed to be deployed, upgraded to and bound.
+	// Any code hash is allowed if empty. A code hash is the keccak256 of the decompressed wasm bytecode, not of
+	// the raw deploy input, the same as the code_hash returned by the AspectCode query.
+	AllowedCodeHashes []string `protobuf:"bytes,9,rep,name=allowed_code_hashes,json=allowedCodeHashes,proto3" json:"allowed_code_hashes,omitempty"`
+	// denied_code_hashes is the list of hex-formatted aspect code hashes rejected on deployment, upgrade and binding,
+	// hashed the same way as allowed_code_hashes.
+	DeniedCodeHashes []string `protobuf:"bytes,10,rep,name=denied_code_hashes,json=deniedCodeHashes,proto3" json:"denied_code_hashes,omitempty"`
+	// allowed_deployers is the list of hex addresses allowed to deploy and upgrade aspects.
+	// Any address is allowed if empty.
+	AllowedDeployers []string `protobuf:"bytes,11,rep,name=allowed_deployers,json=allowedDeployers,proto3" json:"allowed_deployers,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,6 +87,83 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetMaxAccountBindings() uint32 {
+	if m != nil {
+		return m.MaxAccountBindings
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAspectBindings() uint32 {
+	if m != nil {
+		return m.MaxAspectBindings
+	}
+	return 0
+}
+
+func (m *Params) GetMaxProperties() uint32 {
+	if m != nil {
+		return m.MaxProperties
+	}
+	return 0
+}
+
+func (m *Params) GetMaxCodeSize() uint64 {
+	if m != nil {
+		return m.MaxCodeSize
+	}
+	return 0
+}
+
+func (m *Params) GetStorageLoadCost() uint64 {
+	if m != nil {
+		return m.StorageLoadCost
+	}
+	return 0
+}
+
+func (m *Params) GetStorageStoreCost() uint64 {
+	if m != nil {
+		return m.StorageStoreCost
+	}
+	return 0
+}
+
+func (m *Params) GetStorageSaveCodeCost() uint64 {
+	if m != nil {
+		return m.StorageSaveCodeCost
+	}
+	return 0
+}
+
+func (m *Params) GetStorageUpdateCost() uint64 {
+	if m != nil {
+		return m.StorageUpdateCost
+	}
+	return 0
+}
+
+func (m *Params) GetAllowedCodeHashes() []string {
+	if m != nil {
+		return m.AllowedCodeHashes
+	}
+	return nil
+}
+
+func (m *Params) GetDeniedCodeHashes() []string {
+	if m != nil {
+		return m.DeniedCodeHashes
+	}
+	return nil
+}
+
+func (m *Params) GetAllowedDeployers() []string {
+	if m != nil {
+		return m.AllowedDeployers
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "artela.aspect.Params")
 }
@@ -68,19 +171,35 @@ func init() {
 func init() { proto.RegisterFile("artela/aspect/params.proto", fileDescriptor_aa10fd154aeb7dff) }
 
 var fileDescriptor_aa10fd154aeb7dff = []byte{
-	// 180 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4a, 0x2c, 0x2a, 0x49,
-	0xcd, 0x49, 0xd4, 0x4f, 0x2c, 0x2e, 0x48, 0x4d, 0x2e, 0xd1, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x85, 0xc8, 0xe9, 0x41, 0xe4, 0xa4, 0x04, 0x13,
-	0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x85, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x98,
-	0xa9, 0x0f, 0x62, 0x41, 0x44, 0x95, 0x34, 0xb9, 0xd8, 0x02, 0xc0, 0xe6, 0x58, 0xc9, 0xbf, 0x58,
-	0x20, 0xcf, 0xd8, 0xf5, 0x7c, 0x83, 0x96, 0x18, 0xd4, 0x9a, 0x0a, 0x98, 0x45, 0x10, 0x05, 0x4e,
-	0x81, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7,
-	0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x9e, 0x9e, 0x59, 0x92,
-	0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0xd1, 0xac, 0x9b, 0x97, 0x5a, 0x52, 0x9e, 0x5f,
-	0x94, 0x0d, 0xe3, 0x16, 0xe5, 0xe7, 0xe4, 0x64, 0x67, 0x96, 0x20, 0xcc, 0x2c, 0xa9, 0x2c, 0x48,
-	0x2d, 0x4e, 0x62, 0x03, 0x3b, 0xc2, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x14, 0x65, 0x62, 0xcb,
-	0xda, 0x00, 0x00, 0x00,
+	// 447 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0xc7, 0xe3, 0xaf, 0xf9, 0x02, 0xdd, 0x2a, 0xd0, 0xb8, 0x55, 0x65, 0xe5, 0xe0, 0x46, 0x95,
+	0x90, 0xa2, 0x02, 0x36, 0x52, 0x0f, 0x48, 0xdc, 0x68, 0x39, 0x70, 0xe0, 0x50, 0x52, 0x71, 0xe1,
+	0x62, 0x4d, 0xec, 0x91, 0x63, 0xd5, 0xf6, 0x58, 0xbb, 0x9b, 0xd6, 0xed, 0x23, 0x70, 0xe2, 0x11,
+	0xfa, 0x08, 0x3c, 0x06, 0xc7, 0x1e, 0x39, 0xa2, 0xe4, 0x00, 0x8f, 0x81, 0x76, 0xd6, 0x9b, 0x22,
+	0x2e, 0xf6, 0x7a, 0x7e, 0xbf, 0xff, 0x78, 0x56, 0x1a, 0x31, 0x06, 0xa9, 0xb1, 0x84, 0x18, 0x54,
+	0x83, 0xa9, 0x8e, 0x1b, 0x90, 0x50, 0xa9, 0xa8, 0x91, 0xa4, 0xc9, 0x1f, 0x5a, 0x16, 0x59, 0x36,
+	0x1e, 0x41, 0x55, 0xd4, 0x14, 0xf3, 0xd3, 0x1a, 0xe3, 0xfd, 0x9c, 0x72, 0xe2, 0x63, 0x6c, 0x4e,
+	0xb6, 0x7a, 0x74, 0xd7, 0x17, 0x83, 0x73, 0x6e, 0xe4, 0xbf, 0x12, 0xfb, 0x15, 0xb4, 0x09, 0xa4,
+	0x29, 0x2d, 0x6b, 0x9d, 0xcc, 0x8b, 0x3a, 0x2b, 0xea, 0x5c, 0x05, 0xde, 0xc4, 0x9b, 0x0e, 0x67,
+	0x7e, 0x05, 0xed, 0x5b, 0x8b, 0x4e, 0x3b, 0xe2, 0x47, 0x62, 0x8f, 0x13, 0xfc, 0xcf, 0x87, 0xc0,
+	0x7f, 0x1c, 0x18, 0x99, 0x00, 0x93, 0x8d, 0xff, 0x4c, 0x3c, 0x31, 0x7e, 0x23, 0xa9, 0x41, 0xa9,
+	0x0b, 0x54, 0xc1, 0x16, 0xab, 0xc3, 0x0a, 0xda, 0xf3, 0x4d, 0xd1, 0x3f, 0x12, 0xa6, 0x90, 0xa4,
+	0x94, 0x61, 0xa2, 0x8a, 0x5b, 0x0c, 0xfa, 0x13, 0x6f, 0xda, 0x9f, 0xed, 0x54, 0xd0, 0x9e, 0x51,
+	0x86, 0x17, 0xc5, 0x2d, 0xfa, 0xc7, 0x62, 0xa4, 0x34, 0x49, 0xc8, 0x31, 0x29, 0x09, 0xb2, 0x24,
+	0x25, 0xa5, 0x83, 0xff, 0xd9, 0x7b, 0xda, 0x81, 0x0f, 0x04, 0xd9, 0x19, 0x29, 0xed, 0xbf, 0x10,
+	0xbe, 0x73, 0xcd, 0x1b, 0xad, 0x3c, 0x60, 0x79, 0xb7, 0x23, 0x17, 0x06, 0xb0, 0x7d, 0x22, 0x0e,
+	0x36, 0x36, 0x5c, 0xa1, 0x1d, 0x83, 0x13, 0x8f, 0x38, 0xb1, 0xe7, 0x12, 0x70, 0x85, 0x66, 0x1c,
+	0x0e, 0x45, 0xc2, 0x95, 0x93, 0x65, 0x93, 0x81, 0xee, 0x12, 0x8f, 0x39, 0xe1, 0x26, 0xfd, 0xc4,
+	0xc4, 0xf9, 0x50, 0x96, 0x74, 0x8d, 0x99, 0xed, 0xbf, 0x00, 0xb5, 0x40, 0x15, 0x6c, 0x4f, 0xb6,
+	0xa6, 0xdb, 0xb3, 0x51, 0x87, 0x4c, 0xf7, 0xf7, 0x0c, 0xcc, 0x15, 0x32, 0xac, 0x8b, 0x7f, 0x74,
+	0xc1, 0xfa, 0xae, 0x25, 0x7f, 0xd9, 0xcf, 0x85, 0x6b, 0x91, 0x64, 0xd8, 0x94, 0x74, 0x83, 0x52,
+	0x05, 0x3b, 0x56, 0xee, 0xc0, 0x3b, 0x57, 0x7f, 0x73, 0xf8, 0xfb, 0xee, 0xd0, 0xfb, 0xf2, 0xeb,
+	0xdb, 0xf1, 0x41, 0xb7, 0x5e, 0xad, 0x5b, 0x30, 0xbb, 0x17, 0xa7, 0x1f, 0xbf, 0xaf, 0x42, 0xef,
+	0x7e, 0x15, 0x7a, 0x3f, 0x57, 0xa1, 0xf7, 0x75, 0x1d, 0xf6, 0xee, 0xd7, 0x61, 0xef, 0xc7, 0x3a,
+	0xec, 0x7d, 0x7e, 0x9d, 0x17, 0x7a, 0xb1, 0x9c, 0x47, 0x29, 0x55, 0xb1, 0x0d, 0xbf, 0xac, 0x51,
+	0x5f, 0x93, 0xbc, 0x74, 0x9f, 0x92, 0xca, 0xf2, 0xb2, 0xd0, 0x0f, 0x3d, 0xf5, 0x4d, 0x83, 0x6a,
+	0x3e, 0xe0, 0xe5, 0x3b, 0xf9, 0x33, 0x00, 0x34, 0x98, 0xac, 0x42, 0xd2, 0x02, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -102,6 +221,54 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.MaxAccountBindings != that1.MaxAccountBindings {
+		return false
+	}
+	if this.MaxAspectBindings != that1.MaxAspectBindings {
+		return false
+	}
+	if this.MaxProperties != that1.MaxProperties {
+		return false
+	}
+	if this.MaxCodeSize != that1.MaxCodeSize {
+		return false
+	}
+	if this.StorageLoadCost != that1.StorageLoadCost {
+		return false
+	}
+	if this.StorageStoreCost != that1.StorageStoreCost {
+		return false
+	}
+	if this.StorageSaveCodeCost != that1.StorageSaveCodeCost {
+		return false
+	}
+	if this.StorageUpdateCost != that1.StorageUpdateCost {
+		return false
+	}
+	if len(this.AllowedCodeHashes) != len(that1.AllowedCodeHashes) {
+		return false
+	}
+	for i := range this.AllowedCodeHashes {
+		if this.AllowedCodeHashes[i] != that1.AllowedCodeHashes[i] {
+			return false
+		}
+	}
+	if len(this.DeniedCodeHashes) != len(that1.DeniedCodeHashes) {
+		return false
+	}
+	for i := range this.DeniedCodeHashes {
+		if this.DeniedCodeHashes[i] != that1.DeniedCodeHashes[i] {
+			return false
+		}
+	}
+	if len(this.AllowedDeployers) != len(that1.AllowedDeployers) {
+		return false
+	}
+	for i := range this.AllowedDeployers {
+		if this.AllowedDeployers[i] != that1.AllowedDeployers[i] {
+			return false
+		}
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -124,6 +291,73 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AllowedDeployers) > 0 {
+		for iNdEx := len(m.AllowedDeployers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDeployers[iNdEx])
+			copy(dAtA[i:], m.AllowedDeployers[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedDeployers[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.DeniedCodeHashes) > 0 {
+		for iNdEx := len(m.DeniedCodeHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedCodeHashes[iNdEx])
+			copy(dAtA[i:], m.DeniedCodeHashes[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.DeniedCodeHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.AllowedCodeHashes) > 0 {
+		for iNdEx := len(m.AllowedCodeHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedCodeHashes[iNdEx])
+			copy(dAtA[i:], m.AllowedCodeHashes[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedCodeHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.StorageUpdateCost != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.StorageUpdateCost))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.StorageSaveCodeCost != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.StorageSaveCodeCost))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StorageStoreCost != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.StorageStoreCost))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StorageLoadCost != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.StorageLoadCost))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxCodeSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxCodeSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxProperties != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxProperties))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxAspectBindings != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAspectBindings))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxAccountBindings != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAccountBindings))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -144,6 +378,48 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.MaxAccountBindings != 0 {
+		n += 1 + sovParams(uint64(m.MaxAccountBindings))
+	}
+	if m.MaxAspectBindings != 0 {
+		n += 1 + sovParams(uint64(m.MaxAspectBindings))
+	}
+	if m.MaxProperties != 0 {
+		n += 1 + sovParams(uint64(m.MaxProperties))
+	}
+	if m.MaxCodeSize != 0 {
+		n += 1 + sovParams(uint64(m.MaxCodeSize))
+	}
+	if m.StorageLoadCost != 0 {
+		n += 1 + sovParams(uint64(m.StorageLoadCost))
+	}
+	if m.StorageStoreCost != 0 {
+		n += 1 + sovParams(uint64(m.StorageStoreCost))
+	}
+	if m.StorageSaveCodeCost != 0 {
+		n += 1 + sovParams(uint64(m.StorageSaveCodeCost))
+	}
+	if m.StorageUpdateCost != 0 {
+		n += 1 + sovParams(uint64(m.StorageUpdateCost))
+	}
+	if len(m.AllowedCodeHashes) > 0 {
+		for _, s := range m.AllowedCodeHashes {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.DeniedCodeHashes) > 0 {
+		for _, s := range m.DeniedCodeHashes {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.AllowedDeployers) > 0 {
+		for _, s := range m.AllowedDeployers {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -182,6 +458,254 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAccountBindings", wireType)
+			}
+			m.MaxAccountBindings = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAccountBindings |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAspectBindings", wireType)
+			}
+			m.MaxAspectBindings = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAspectBindings |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxProperties", wireType)
+			}
+			m.MaxProperties = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxProperties |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCodeSize", wireType)
+			}
+			m.MaxCodeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCodeSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageLoadCost", wireType)
+			}
+			m.StorageLoadCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageLoadCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageStoreCost", wireType)
+			}
+			m.StorageStoreCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageStoreCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageSaveCodeCost", wireType)
+			}
+			m.StorageSaveCodeCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageSaveCodeCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageUpdateCost", wireType)
+			}
+			m.StorageUpdateCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageUpdateCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCodeHashes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedCodeHashes = append(m.AllowedCodeHashes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedCodeHashes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedCodeHashes = append(m.DeniedCodeHashes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDeployers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDeployers = append(m.AllowedDeployers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
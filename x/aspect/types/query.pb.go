@@ -6,7 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -114,214 +114,3644 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "artela.aspect.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "artela.aspect.QueryParamsResponse")
+// QueryAspectRequest is the request type for the Query/Aspect RPC method.
+type QueryAspectRequest struct {
+	// aspect_id is the hex address of the aspect to query.
+	AspectId string `protobuf:"bytes,1,opt,name=aspect_id,json=aspectId,proto3" json:"aspect_id,omitempty"`
 }
 
-func init() { proto.RegisterFile("artela/aspect/query.proto", fileDescriptor_8eb9a0eb98e11265) }
+func (m *QueryAspectRequest) Reset()         { *m = QueryAspectRequest{} }
+func (m *QueryAspectRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAspectRequest) ProtoMessage()    {}
+func (*QueryAspectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8eb9a0eb98e11265, []int{2}
+}
+func (m *QueryAspectRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAspectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAspectRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAspectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAspectRequest.Merge(m, src)
+}
+func (m *QueryAspectRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAspectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAspectRequest.DiscardUnknown(m)
+}
 
-var fileDescriptor_8eb9a0eb98e11265 = []byte{
-	// 326 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0x31, 0x4b, 0xc3, 0x40,
-	0x14, 0xc7, 0x73, 0x82, 0x05, 0x23, 0x0e, 0xc6, 0x0a, 0x1a, 0x24, 0x6a, 0x10, 0x91, 0x62, 0xf3,
-	0x68, 0x1d, 0x74, 0xee, 0x17, 0xd0, 0x76, 0x74, 0x7b, 0x29, 0x47, 0x0c, 0x4d, 0xee, 0x5d, 0x73,
-	0x57, 0xb5, 0x83, 0x8b, 0x83, 0xb3, 0xe0, 0x97, 0x70, 0xf4, 0x63, 0x74, 0x2c, 0xb8, 0x38, 0x89,
-	0xb4, 0x82, 0x5f, 0x43, 0x7a, 0x17, 0x87, 0x6a, 0x71, 0x09, 0x8f, 0xf7, 0xff, 0xfd, 0xff, 0xf9,
-	0xbf, 0x73, 0xb7, 0xb1, 0xd0, 0x3c, 0x43, 0x40, 0x25, 0x79, 0x57, 0x43, 0x7f, 0xc0, 0x8b, 0x61,
-	0x24, 0x0b, 0xd2, 0xe4, 0xad, 0x59, 0x29, 0xb2, 0x92, 0xbf, 0x8e, 0x79, 0x2a, 0x08, 0xcc, 0xd7,
-	0x12, 0x7e, 0x35, 0xa1, 0x84, 0xcc, 0x08, 0xb3, 0xa9, 0xdc, 0xee, 0x24, 0x44, 0x49, 0xc6, 0x01,
-	0x65, 0x0a, 0x28, 0x04, 0x69, 0xd4, 0x29, 0x09, 0x55, 0xaa, 0xb5, 0x2e, 0xa9, 0x9c, 0x14, 0xc4,
-	0xa8, 0xb8, 0xfd, 0x1d, 0x5c, 0x37, 0x62, 0xae, 0xb1, 0x01, 0x12, 0x93, 0x54, 0x18, 0xb8, 0x64,
-	0xfd, 0xf9, 0x72, 0x12, 0x0b, 0xcc, 0xcb, 0x9c, 0xb0, 0xea, 0x7a, 0xed, 0x99, 0xfb, 0xc2, 0x2c,
-	0x3b, 0xbc, 0x3f, 0xe0, 0x4a, 0x87, 0xe7, 0xee, 0xc6, 0xdc, 0x56, 0x49, 0x12, 0x8a, 0x7b, 0x67,
-	0x6e, 0xc5, 0x9a, 0xb7, 0xd8, 0x1e, 0x3b, 0x5a, 0x6d, 0x6e, 0x46, 0x73, 0xb7, 0x45, 0x16, 0x6f,
-	0xad, 0x8c, 0xde, 0x77, 0x9d, 0xe7, 0xaf, 0x97, 0x1a, 0xeb, 0x94, 0x7c, 0xf3, 0x81, 0xb9, 0xcb,
-	0x26, 0xd1, 0xbb, 0x73, 0x2b, 0x16, 0xf3, 0xf6, 0x7f, 0xb9, 0xff, 0xf6, 0xf0, 0xc3, 0xff, 0x10,
-	0x5b, 0x2a, 0x3c, 0xbe, 0x7f, 0xfd, 0x7c, 0x5a, 0x3a, 0xf4, 0x0e, 0xc0, 0xb2, 0x75, 0xc1, 0xf5,
-	0x0d, 0x15, 0x3d, 0x58, 0x74, 0x75, 0xab, 0x3d, 0x9a, 0x04, 0x6c, 0x3c, 0x09, 0xd8, 0xc7, 0x24,
-	0x60, 0x8f, 0xd3, 0xc0, 0x19, 0x4f, 0x03, 0xe7, 0x6d, 0x1a, 0x38, 0x97, 0xa7, 0x49, 0xaa, 0xaf,
-	0x06, 0x71, 0xd4, 0xa5, 0x7c, 0x71, 0x52, 0xbd, 0xa0, 0x2c, 0xeb, 0xa5, 0x1a, 0x6e, 0x7f, 0x32,
-	0xf5, 0x50, 0x72, 0x15, 0x57, 0xcc, 0x4b, 0x9e, 0x7c, 0x07, 0x00, 0x00, 0xff, 0xff, 0xf2, 0x7d,
-	0x15, 0x45, 0x04, 0x02, 0x00, 0x00,
+var xxx_messageInfo_QueryAspectRequest proto.InternalMessageInfo
+
+func (m *QueryAspectRequest) GetAspectId() string {
+	if m != nil {
+		return m.AspectId
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+// QueryAspectResponse is the response type for the Query/Aspect RPC method.
+type QueryAspectResponse struct {
+	// aspect is the metadata of the aspect.
+	Aspect AspectInfo `protobuf:"bytes,1,opt,name=aspect,proto3" json:"aspect"`
+	// versions is the metadata of all deployed versions of the aspect.
+	Versions []AspectVersion `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions"`
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryAspectResponse) Reset()         { *m = QueryAspectResponse{} }
+func (m *QueryAspectResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAspectResponse) ProtoMessage()    {}
+func (*QueryAspectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8eb9a0eb98e11265, []int{3}
+}
+func (m *QueryAspectResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAspectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAspectResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAspectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAspectResponse.Merge(m, src)
+}
+func (m *QueryAspectResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAspectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAspectResponse.DiscardUnknown(m)
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+var xxx_messageInfo_QueryAspectResponse proto.InternalMessageInfo
+
+func (m *QueryAspectResponse) GetAspect() AspectInfo {
+	if m != nil {
+		return m.Aspect
+	}
+	return AspectInfo{}
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryAspectResponse) GetVersions() []AspectVersion {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryAspectCodeRequest is the request type for the Query/AspectCode RPC method.
+type QueryAspectCodeRequest struct {
+	// aspect_id is the hex address of the aspect to query.
+	AspectId string `protobuf:"bytes,1,opt,name=aspect_id,json=aspectId,proto3" json:"aspect_id,omitempty"`
+	// version is the version of the aspect, 0 for the latest version.
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/artela.aspect.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAspectCodeRequest) Reset()         { *m = QueryAspectCodeRequest{} }
+func (m *QueryAspectCodeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAspectCodeRequest) ProtoMessage()    {}
+func (*QueryAspectCodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8eb9a0eb98e11265, []int{4}
+}
+func (m *QueryAspectCodeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAspectCodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAspectCodeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAspectCodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAspectCodeRequest.Merge(m, src)
+}
+func (m *QueryAspectCodeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAspectCodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAspectCodeRequest.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+var xxx_messageInfo_QueryAspectCodeRequest proto.InternalMessageInfo
+
+func (m *QueryAspectCodeRequest) GetAspectId() string {
+	if m != nil {
+		return m.AspectId
+	}
+	return ""
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryAspectCodeRequest) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+// QueryAspectCodeResponse is the response type for the Query/AspectCode RPC method.
+type QueryAspectCodeResponse struct {
+	// version is the version of the returned code.
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// code is the aspect bytecode.
+	Code []byte `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// code_hash is the hex-formatted keccak256 hash of the code, which is the decompressed wasm bytecode
+	// stored on deployment. It is the hash checked against the code hash lists in params.
+	CodeHash string `protobuf:"bytes,3,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryAspectCodeResponse) Reset()         { *m = QueryAspectCodeResponse{} }
+func (m *QueryAspectCodeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAspectCodeResponse) ProtoMessage()    {}
+func (*QueryAspectCodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8eb9a0eb98e11265, []int{5}
+}
+func (m *QueryAspectCodeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAspectCodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAspectCodeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAspectCodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAspectCodeResponse.Merge(m, src)
+}
+func (m *QueryAspectCodeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAspectCodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAspectCodeResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAspectCodeResponse proto.InternalMessageInfo
+
+func (m *QueryAspectCodeResponse) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+	return 0
+}
+
+func (m *QueryAspectCodeResponse) GetCode() []byte {
+	if m != nil {
+		return m.Code
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/artela.aspect.Query/Params",
+	return nil
+}
+
+func (m *QueryAspectCodeResponse) GetCodeHash() string {
+	if m != nil {
+		return m.CodeHash
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	return ""
+}
+
+// QueryAspectPropertiesRequest is the request type for the Query/AspectProperties RPC method.
+type QueryAspectPropertiesRequest struct {
+	// aspect_id is the hex address of the aspect to query.
+	AspectId string `protobuf:"bytes,1,opt,name=aspect_id,json=aspectId,proto3" json:"aspect_id,omitempty"`
+	// version is the version of the aspect, 0 for the latest version.
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *QueryAspectPropertiesRequest) Reset()         { *m = QueryAspectPropertiesRequest{} }
+func (m *QueryAspectPropertiesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAspectPropertiesRequest) ProtoMessage()    {}
+func (*QueryAspectPropertiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8eb9a0eb98e11265, []int{6}
+}
+func (m *QueryAspectPropertiesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAspectPropertiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAspectPropertiesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryAspectPropertiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAspectPropertiesRequest.Merge(m, src)
+}
+func (m *QueryAspectPropertiesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAspectPropertiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAspectPropertiesRequest.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "artela.aspect.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "artela/aspect/query.proto",
+var xxx_messageInfo_QueryAspectPropertiesRequest proto.InternalMessageInfo
+
+func (m *QueryAspectPropertiesRequest) GetAspectId() string {
+	if m != nil {
+		return m.AspectId
+	}
+	return ""
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAspectPropertiesRequest) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAspectPropertiesResponse is the response type for the Query/AspectProperties RPC method.
+type QueryAspectPropertiesResponse struct {
+	// version is the version of the returned properties.
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// properties is the list of properties sorted by key.
+	Properties []AspectProperty `protobuf:"bytes,2,rep,name=properties,proto3" json:"properties"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAspectPropertiesResponse) Reset()         { *m = QueryAspectPropertiesResponse{} }
+func (m *QueryAspectPropertiesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAspectPropertiesResponse) ProtoMessage()    {}
+func (*QueryAspectPropertiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8eb9a0eb98e11265, []int{7}
+}
+func (m *QueryAspectPropertiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAspectPropertiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAspectPropertiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAspectPropertiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAspectPropertiesResponse.Merge(m, src)
+}
+func (m *QueryAspectPropertiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAspectPropertiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAspectPropertiesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAspectPropertiesResponse proto.InternalMessageInfo
+
+func (m *QueryAspectPropertiesResponse) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAspectPropertiesResponse) GetProperties() []AspectProperty {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+// QueryAspectBindingsRequest is the request type for the Query/AspectBindings RPC method.
+type QueryAspectBindingsRequest struct {
+	// aspect_id is the hex address of the aspect to query.
+	AspectId string `protobuf:"bytes,1,opt,name=aspect_id,json=aspectId,proto3" json:"aspect_id,omitempty"`
+	// join_point optionally filters the bindings by join point name, e.g. verifyTx.
+	JoinPoint string `protobuf:"bytes,2,opt,name=join_point,json=joinPoint,proto3" json:"join_point,omitempty"`
+	// pagination defines an optional pagination for the request, the offset
+	// is the position in the binding list of the aspect to start from.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAspectBindingsRequest) Reset()         { *m = QueryAspectBindingsRequest{} }
+func (m *QueryAspectBindingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAspectBindingsRequest) ProtoMessage()    {}
+func (*QueryAspectBindingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8eb9a0eb98e11265, []int{8}
+}
+func (m *QueryAspectBindingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAspectBindingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAspectBindingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAspectBindingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAspectBindingsRequest.Merge(m, src)
+}
+func (m *QueryAspectBindingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAspectBindingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAspectBindingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAspectBindingsRequest proto.InternalMessageInfo
+
+func (m *QueryAspectBindingsRequest) GetAspectId() string {
+	if m != nil {
+		return m.AspectId
+	}
+	return ""
+}
+
+func (m *QueryAspectBindingsRequest) GetJoinPoint() string {
+	if m != nil {
+		return m.JoinPoint
+	}
+	return ""
+}
+
+func (m *QueryAspectBindingsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAspectBindingsResponse is the response type for the Query/AspectBindings RPC method.
+type QueryAspectBindingsResponse struct {
+	// bindings is the list of accounts bound to the aspect.
+	Bindings []AspectBinding `protobuf:"bytes,1,rep,name=bindings,proto3" json:"bindings"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAspectBindingsResponse) Reset()         { *m = QueryAspectBindingsResponse{} }
+func (m *QueryAspectBindingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAspectBindingsResponse) ProtoMessage()    {}
+func (*QueryAspectBindingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8eb9a0eb98e11265, []int{9}
+}
+func (m *QueryAspectBindingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAspectBindingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAspectBindingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAspectBindingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAspectBindingsResponse.Merge(m, src)
+}
+func (m *QueryAspectBindingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAspectBindingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAspectBindingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAspectBindingsResponse proto.InternalMessageInfo
+
+func (m *QueryAspectBindingsResponse) GetBindings() []AspectBinding {
+	if m != nil {
+		return m.Bindings
+	}
+	return nil
+}
+
+func (m *QueryAspectBindingsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAccountBindingsRequest is the request type for the Query/AccountBindings RPC method.
+type QueryAccountBindingsRequest struct {
+	// account is the hex address of the account to query.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// join_point optionally filters the bindings by join point name, e.g. verifyTx.
+	JoinPoint string `protobuf:"bytes,2,opt,name=join_point,json=joinPoint,proto3" json:"join_point,omitempty"`
+	// pagination defines an optional pagination for the request, the offset
+	// is the position in the binding list of the account to start from.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAccountBindingsRequest) Reset()         { *m = QueryAccountBindingsRequest{} }
+func (m *QueryAccountBindingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountBindingsRequest) ProtoMessage()    {}
+func (*QueryAccountBindingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8eb9a0eb98e11265, []int{10}
+}
+func (m *QueryAccountBindingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountBindingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountBindingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountBindingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountBindingsRequest.Merge(m, src)
+}
+func (m *QueryAccountBindingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountBindingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountBindingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountBindingsRequest proto.InternalMessageInfo
+
+func (m *QueryAccountBindingsRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *QueryAccountBindingsRequest) GetJoinPoint() string {
+	if m != nil {
+		return m.JoinPoint
+	}
+	return ""
+}
+
+func (m *QueryAccountBindingsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAccountBindingsResponse is the response type for the Query/AccountBindings RPC method.
+type QueryAccountBindingsResponse struct {
+	// bindings is the list of aspects bound to the account.
+	Bindings []AspectBinding `protobuf:"bytes,1,rep,name=bindings,proto3" json:"bindings"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAccountBindingsResponse) Reset()         { *m = QueryAccountBindingsResponse{} }
+func (m *QueryAccountBindingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountBindingsResponse) ProtoMessage()    {}
+func (*QueryAccountBindingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8eb9a0eb98e11265, []int{11}
+}
+func (m *QueryAccountBindingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountBindingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountBindingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccountBindingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountBindingsResponse.Merge(m, src)
+}
+func (m *QueryAccountBindingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountBindingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountBindingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountBindingsResponse proto.InternalMessageInfo
+
+func (m *QueryAccountBindingsResponse) GetBindings() []AspectBinding {
+	if m != nil {
+		return m.Bindings
+	}
+	return nil
+}
+
+func (m *QueryAccountBindingsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAspectsRequest is the request type for the Query/Aspects RPC method.
+type QueryAspectsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAspectsRequest) Reset()         { *m = QueryAspectsRequest{} }
+func (m *QueryAspectsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAspectsRequest) ProtoMessage()    {}
+func (*QueryAspectsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8eb9a0eb98e11265, []int{12}
+}
+func (m *QueryAspectsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAspectsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAspectsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAspectsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAspectsRequest.Merge(m, src)
+}
+func (m *QueryAspectsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAspectsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAspectsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAspectsRequest proto.InternalMessageInfo
+
+func (m *QueryAspectsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAspectsResponse is the response type for the Query/Aspects RPC method.
+type QueryAspectsResponse struct {
+	// aspects is the list of deployed aspects.
+	Aspects []AspectInfo `protobuf:"bytes,1,rep,name=aspects,proto3" json:"aspects"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAspectsResponse) Reset()         { *m = QueryAspectsResponse{} }
+func (m *QueryAspectsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAspectsResponse) ProtoMessage()    {}
+func (*QueryAspectsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8eb9a0eb98e11265, []int{13}
+}
+func (m *QueryAspectsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAspectsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAspectsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAspectsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAspectsResponse.Merge(m, src)
+}
+func (m *QueryAspectsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAspectsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAspectsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAspectsResponse proto.InternalMessageInfo
+
+func (m *QueryAspectsResponse) GetAspects() []AspectInfo {
+	if m != nil {
+		return m.Aspects
+	}
+	return nil
+}
+
+func (m *QueryAspectsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAspectStateRequest is the request type for the Query/AspectState RPC method.
+type QueryAspectStateRequest struct {
+	// aspect_id is the hex address of the aspect to query.
+	AspectId string `protobuf:"bytes,1,opt,name=aspect_id,json=aspectId,proto3" json:"aspect_id,omitempty"`
+	// key is the key of the state set by the aspect.
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (m *QueryAspectStateRequest) Reset()         { *m = QueryAspectStateRequest{} }
+func (m *QueryAspectStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAspectStateRequest) ProtoMessage()    {}
+func (*QueryAspectStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8eb9a0eb98e11265, []int{14}
+}
+func (m *QueryAspectStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAspectStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAspectStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAspectStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAspectStateRequest.Merge(m, src)
+}
+func (m *QueryAspectStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAspectStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAspectStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAspectStateRequest proto.InternalMessageInfo
+
+func (m *QueryAspectStateRequest) GetAspectId() string {
+	if m != nil {
+		return m.AspectId
+	}
+	return ""
+}
+
+func (m *QueryAspectStateRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+// QueryAspectStateResponse is the response type for the Query/AspectState RPC method.
+type QueryAspectStateResponse struct {
+	// value is the state value, empty if the key is not set.
+	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *QueryAspectStateResponse) Reset()         { *m = QueryAspectStateResponse{} }
+func (m *QueryAspectStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAspectStateResponse) ProtoMessage()    {}
+func (*QueryAspectStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8eb9a0eb98e11265, []int{15}
+}
+func (m *QueryAspectStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAspectStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAspectStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAspectStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAspectStateResponse.Merge(m, src)
+}
+func (m *QueryAspectStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAspectStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAspectStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAspectStateResponse proto.InternalMessageInfo
+
+func (m *QueryAspectStateResponse) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "artela.aspect.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "artela.aspect.QueryParamsResponse")
+	proto.RegisterType((*QueryAspectRequest)(nil), "artela.aspect.QueryAspectRequest")
+	proto.RegisterType((*QueryAspectResponse)(nil), "artela.aspect.QueryAspectResponse")
+	proto.RegisterType((*QueryAspectCodeRequest)(nil), "artela.aspect.QueryAspectCodeRequest")
+	proto.RegisterType((*QueryAspectCodeResponse)(nil), "artela.aspect.QueryAspectCodeResponse")
+	proto.RegisterType((*QueryAspectPropertiesRequest)(nil), "artela.aspect.QueryAspectPropertiesRequest")
+	proto.RegisterType((*QueryAspectPropertiesResponse)(nil), "artela.aspect.QueryAspectPropertiesResponse")
+	proto.RegisterType((*QueryAspectBindingsRequest)(nil), "artela.aspect.QueryAspectBindingsRequest")
+	proto.RegisterType((*QueryAspectBindingsResponse)(nil), "artela.aspect.QueryAspectBindingsResponse")
+	proto.RegisterType((*QueryAccountBindingsRequest)(nil), "artela.aspect.QueryAccountBindingsRequest")
+	proto.RegisterType((*QueryAccountBindingsResponse)(nil), "artela.aspect.QueryAccountBindingsResponse")
+	proto.RegisterType((*QueryAspectsRequest)(nil), "artela.aspect.QueryAspectsRequest")
+	proto.RegisterType((*QueryAspectsResponse)(nil), "artela.aspect.QueryAspectsResponse")
+	proto.RegisterType((*QueryAspectStateRequest)(nil), "artela.aspect.QueryAspectStateRequest")
+	proto.RegisterType((*QueryAspectStateResponse)(nil), "artela.aspect.QueryAspectStateResponse")
+}
+
+func init() { proto.RegisterFile("artela/aspect/query.proto", fileDescriptor_8eb9a0eb98e11265) }
+
+var fileDescriptor_8eb9a0eb98e11265 = []byte{
+	// 958 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x33, 0x49, 0xea, 0xc4, 0x2f, 0x05, 0xca, 0x34, 0xc0, 0x76, 0x9b, 0x98, 0xb0, 0xd0,
+	0x24, 0xa4, 0xcd, 0x2e, 0x76, 0x25, 0x52, 0x43, 0x65, 0x54, 0x17, 0x41, 0x7b, 0xaa, 0xbb, 0x08,
+	0x0e, 0x48, 0x28, 0x1a, 0xdb, 0xc3, 0x7a, 0x89, 0xbd, 0xb3, 0xdd, 0x59, 0x07, 0x2c, 0x54, 0x24,
+	0x38, 0x72, 0x40, 0x48, 0xdc, 0x40, 0x02, 0x4e, 0x80, 0x84, 0x90, 0x90, 0xf8, 0x27, 0x7a, 0x8c,
+	0xc4, 0x85, 0x13, 0x42, 0x09, 0x12, 0x07, 0xfe, 0x09, 0xb4, 0x33, 0xb3, 0xce, 0xae, 0xbd, 0xfe,
+	0x05, 0x08, 0x71, 0x89, 0x77, 0x67, 0xbe, 0xef, 0xbd, 0xcf, 0x7b, 0x3b, 0xf3, 0x9e, 0x02, 0x17,
+	0x48, 0x10, 0xd2, 0x36, 0xb1, 0x08, 0xf7, 0x69, 0x23, 0xb4, 0xee, 0x75, 0x69, 0xd0, 0x33, 0xfd,
+	0x80, 0x85, 0x0c, 0x3f, 0x24, 0xb7, 0x4c, 0xb9, 0xa5, 0x3f, 0x4a, 0x3a, 0xae, 0xc7, 0x2c, 0xf1,
+	0x57, 0x2a, 0xf4, 0x55, 0x87, 0x39, 0x4c, 0x3c, 0x5a, 0xd1, 0x93, 0x5a, 0x5d, 0x73, 0x18, 0x73,
+	0xda, 0xd4, 0x22, 0xbe, 0x6b, 0x11, 0xcf, 0x63, 0x21, 0x09, 0x5d, 0xe6, 0x71, 0xb5, 0xbb, 0xd3,
+	0x60, 0xbc, 0xc3, 0xb8, 0x55, 0x27, 0x9c, 0xca, 0x70, 0xd6, 0x61, 0xb1, 0x4e, 0x43, 0x52, 0xb4,
+	0x7c, 0xe2, 0xb8, 0x9e, 0x10, 0x2b, 0xad, 0x9e, 0x86, 0xf3, 0x49, 0x40, 0x3a, 0x3c, 0x7b, 0x4f,
+	0xfe, 0xc8, 0x3d, 0x63, 0x15, 0xf0, 0xdd, 0xc8, 0x73, 0x4d, 0x18, 0xd8, 0xf4, 0x5e, 0x97, 0xf2,
+	0xd0, 0xb8, 0x03, 0xe7, 0x53, 0xab, 0xdc, 0x67, 0x1e, 0xa7, 0xf8, 0x1a, 0xe4, 0xa4, 0x63, 0x0d,
+	0x6d, 0xa0, 0xed, 0x95, 0xd2, 0x63, 0x66, 0x2a, 0x6f, 0x53, 0xca, 0xab, 0xf9, 0x07, 0xbf, 0x3e,
+	0x39, 0xf7, 0xdd, 0x1f, 0x3f, 0xee, 0x20, 0x5b, 0xe9, 0x8d, 0xa2, 0x0a, 0x73, 0x43, 0x08, 0x55,
+	0x18, 0x7c, 0x11, 0xf2, 0xd2, 0x72, 0xdf, 0x6d, 0x0a, 0x97, 0x79, 0x7b, 0x59, 0x2e, 0xdc, 0x6e,
+	0x1a, 0x9f, 0x20, 0x38, 0x9f, 0xb2, 0x51, 0x10, 0x7b, 0x90, 0x93, 0x1a, 0x05, 0x71, 0x61, 0x00,
+	0x42, 0xca, 0x6f, 0x7b, 0x6f, 0xb3, 0xea, 0x62, 0x04, 0x62, 0x2b, 0x39, 0xae, 0xc0, 0xf2, 0x21,
+	0x0d, 0x78, 0x54, 0x60, 0x6d, 0x7e, 0x63, 0x61, 0x7b, 0xa5, 0xb4, 0x96, 0x69, 0xfa, 0x86, 0x14,
+	0x29, 0xeb, 0xbe, 0x8d, 0x71, 0x07, 0x1e, 0x4f, 0xf0, 0xdc, 0x64, 0x4d, 0x3a, 0x4d, 0x1e, 0x58,
+	0x83, 0x25, 0xe5, 0x42, 0x9b, 0xdf, 0x40, 0xdb, 0x8b, 0x76, 0xfc, 0x6a, 0x34, 0xe1, 0x89, 0x21,
+	0x87, 0x2a, 0xc9, 0x84, 0x11, 0x4a, 0x19, 0x61, 0x0c, 0x8b, 0x0d, 0xd6, 0xa4, 0xc2, 0xd7, 0x59,
+	0x5b, 0x3c, 0x47, 0xf1, 0xa3, 0xdf, 0xfd, 0x16, 0xe1, 0x2d, 0x6d, 0x41, 0xc6, 0x8f, 0x16, 0x6e,
+	0x11, 0xde, 0x32, 0x5e, 0x87, 0xb5, 0x44, 0x94, 0x5a, 0xc0, 0x7c, 0x1a, 0x84, 0x2e, 0xe5, 0xff,
+	0x10, 0xfe, 0x03, 0x58, 0x1f, 0xe1, 0x76, 0x62, 0x0a, 0x37, 0x01, 0xfc, 0xbe, 0x5e, 0x7d, 0x8a,
+	0xf5, 0xcc, 0x4f, 0xa1, 0xdc, 0xf6, 0xd4, 0xb7, 0x48, 0x98, 0x19, 0x5f, 0x23, 0xd0, 0x13, 0x00,
+	0x55, 0xd7, 0x6b, 0xba, 0x9e, 0x33, 0x5d, 0x56, 0xeb, 0x00, 0xef, 0x30, 0xd7, 0xdb, 0xf7, 0x99,
+	0xeb, 0x85, 0x22, 0xb1, 0xbc, 0x9d, 0x8f, 0x56, 0x6a, 0xd1, 0x02, 0x7e, 0x05, 0xe0, 0xf4, 0x7e,
+	0x89, 0x7a, 0xae, 0x94, 0x36, 0x4d, 0x79, 0x19, 0xcd, 0xe8, 0x32, 0x9a, 0xf2, 0xee, 0xab, 0xcb,
+	0x68, 0xd6, 0x88, 0x13, 0x1f, 0x05, 0x3b, 0x61, 0x69, 0x7c, 0x83, 0xe0, 0x62, 0x26, 0xa2, 0xaa,
+	0x50, 0x05, 0x96, 0xeb, 0x6a, 0x4d, 0x43, 0x63, 0x0e, 0xa4, 0x32, 0x8c, 0x0f, 0x64, 0x6c, 0x83,
+	0x5f, 0x4d, 0x71, 0xce, 0x0b, 0xce, 0xad, 0x89, 0x9c, 0x32, 0x78, 0x0a, 0xf4, 0xcb, 0x3e, 0x68,
+	0xa3, 0xc1, 0xba, 0xde, 0x50, 0x31, 0x35, 0x58, 0x22, 0x72, 0x47, 0x95, 0x32, 0x7e, 0xfd, 0xaf,
+	0x2a, 0xf9, 0x2d, 0x8a, 0x0f, 0xf1, 0x20, 0xe0, 0xff, 0xad, 0x94, 0x6f, 0xa5, 0x9a, 0x56, 0xbf,
+	0x82, 0xe9, 0x42, 0xa0, 0xbf, 0x5d, 0x88, 0xcf, 0x11, 0xac, 0xa6, 0xfd, 0xab, 0x02, 0x94, 0x61,
+	0x49, 0x26, 0x1a, 0xe7, 0x3f, 0xb1, 0x2d, 0xc6, 0xfa, 0x7f, 0x2f, 0xf7, 0x5b, 0xa9, 0x7e, 0xf6,
+	0x5a, 0x48, 0xc2, 0xe9, 0x3a, 0xe4, 0x39, 0x58, 0x38, 0xa0, 0x3d, 0x75, 0x7a, 0xa2, 0x47, 0xe3,
+	0x39, 0xd0, 0x86, 0x3d, 0xa9, 0x4c, 0x57, 0xe1, 0xcc, 0x21, 0x69, 0x77, 0xa9, 0x70, 0x73, 0xd6,
+	0x96, 0x2f, 0xa5, 0x3f, 0xf3, 0x70, 0x46, 0x98, 0xe0, 0xfb, 0x90, 0x93, 0x73, 0x08, 0x3f, 0x35,
+	0x50, 0x82, 0xe1, 0x41, 0xa7, 0x1b, 0xe3, 0x24, 0x32, 0xa0, 0x71, 0xe5, 0xa3, 0x9f, 0x7f, 0xff,
+	0x6c, 0x7e, 0x13, 0x3f, 0x63, 0x49, 0xed, 0xae, 0x47, 0xc3, 0x77, 0x59, 0x70, 0x60, 0x65, 0x8d,
+	0x5c, 0xfc, 0x31, 0x82, 0x9c, 0xc4, 0xce, 0x8e, 0x9f, 0x9a, 0x80, 0xba, 0x31, 0x4e, 0xa2, 0xe2,
+	0x97, 0x45, 0xfc, 0xab, 0xb8, 0x38, 0x3e, 0xbe, 0xfc, 0xe1, 0xd6, 0xfb, 0xfd, 0x42, 0xdf, 0xc7,
+	0x5f, 0x20, 0x80, 0xd3, 0xe9, 0x82, 0x2f, 0x8d, 0x8e, 0x96, 0x18, 0x67, 0xfa, 0xe6, 0x24, 0x99,
+	0x02, 0xab, 0x08, 0xb0, 0x6b, 0xf8, 0xf9, 0x99, 0xc1, 0x2c, 0x31, 0xb6, 0x7e, 0x42, 0x70, 0x6e,
+	0x70, 0x7c, 0xe0, 0xcb, 0xa3, 0x83, 0x0f, 0xcd, 0x2e, 0xfd, 0xca, 0x74, 0x62, 0xc5, 0xfb, 0xb2,
+	0xe0, 0xad, 0xe0, 0xeb, 0xb3, 0xf3, 0x9e, 0x0e, 0x1e, 0xfc, 0x3d, 0x82, 0x87, 0xd3, 0x0d, 0x1d,
+	0x3f, 0x3b, 0x1a, 0x63, 0xa0, 0x95, 0xea, 0x3b, 0xd3, 0x48, 0x15, 0x6f, 0x55, 0xf0, 0x5e, 0xc7,
+	0x2f, 0xcc, 0xce, 0xdb, 0x6f, 0x6c, 0x3f, 0x20, 0x78, 0x64, 0xa0, 0x69, 0xe2, 0x6c, 0x86, 0xcc,
+	0xd6, 0xaf, 0x5f, 0x9e, 0x4a, 0xab, 0x80, 0x6f, 0x08, 0xe0, 0x17, 0x71, 0x79, 0x02, 0xb0, 0x34,
+	0x8f, 0x88, 0xe5, 0x53, 0x82, 0xf7, 0x43, 0x04, 0x4b, 0xaa, 0xb7, 0xe1, 0x31, 0x97, 0xa3, 0xcf,
+	0xf7, 0xf4, 0x58, 0x8d, 0xe2, 0xda, 0x15, 0x5c, 0x5b, 0xf8, 0xd2, 0x54, 0x85, 0xc4, 0x5f, 0x21,
+	0x58, 0x49, 0x74, 0x1e, 0x3c, 0xe6, 0x3e, 0x24, 0x9b, 0x9c, 0xbe, 0x35, 0x51, 0xa7, 0x78, 0x5e,
+	0x12, 0x3c, 0x65, 0xbc, 0x37, 0xfb, 0x87, 0xe5, 0x91, 0xa3, 0xea, 0xdd, 0x07, 0xc7, 0x05, 0x74,
+	0x74, 0x5c, 0x40, 0xbf, 0x1d, 0x17, 0xd0, 0xa7, 0x27, 0x85, 0xb9, 0xa3, 0x93, 0xc2, 0xdc, 0x2f,
+	0x27, 0x85, 0xb9, 0x37, 0xf7, 0x1c, 0x37, 0x6c, 0x75, 0xeb, 0x66, 0x83, 0x75, 0xb2, 0x9d, 0xef,
+	0x06, 0xac, 0xdd, 0x3e, 0x70, 0x43, 0xeb, 0xbd, 0x38, 0x4c, 0xd8, 0xf3, 0x29, 0xaf, 0xe7, 0xc4,
+	0xff, 0x03, 0x57, 0xff, 0x1a, 0x00, 0xdc, 0x7d, 0x8f, 0xd8, 0xe6, 0x0c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Aspect queries the metadata and all versions of an aspect.
+	Aspect(ctx context.Context, in *QueryAspectRequest, opts ...grpc.CallOption) (*QueryAspectResponse, error)
+	// AspectCode queries the code of the given version of an aspect.
+	AspectCode(ctx context.Context, in *QueryAspectCodeRequest, opts ...grpc.CallOption) (*QueryAspectCodeResponse, error)
+	// AspectProperties queries the properties of the given version of an aspect.
+	AspectProperties(ctx context.Context, in *QueryAspectPropertiesRequest, opts ...grpc.CallOption) (*QueryAspectPropertiesResponse, error)
+	// AspectBindings queries the accounts bound to an aspect.
+	AspectBindings(ctx context.Context, in *QueryAspectBindingsRequest, opts ...grpc.CallOption) (*QueryAspectBindingsResponse, error)
+	// AccountBindings queries the aspects bound to an account.
+	AccountBindings(ctx context.Context, in *QueryAccountBindingsRequest, opts ...grpc.CallOption) (*QueryAccountBindingsResponse, error)
+	// Aspects queries all deployed aspects.
+	Aspects(ctx context.Context, in *QueryAspectsRequest, opts ...grpc.CallOption) (*QueryAspectsResponse, error)
+	// AspectState queries a value in the state of an aspect.
+	AspectState(ctx context.Context, in *QueryAspectStateRequest, opts ...grpc.CallOption) (*QueryAspectStateResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/artela.aspect.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Aspect(ctx context.Context, in *QueryAspectRequest, opts ...grpc.CallOption) (*QueryAspectResponse, error) {
+	out := new(QueryAspectResponse)
+	err := c.cc.Invoke(ctx, "/artela.aspect.Query/Aspect", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AspectCode(ctx context.Context, in *QueryAspectCodeRequest, opts ...grpc.CallOption) (*QueryAspectCodeResponse, error) {
+	out := new(QueryAspectCodeResponse)
+	err := c.cc.Invoke(ctx, "/artela.aspect.Query/AspectCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AspectProperties(ctx context.Context, in *QueryAspectPropertiesRequest, opts ...grpc.CallOption) (*QueryAspectPropertiesResponse, error) {
+	out := new(QueryAspectPropertiesResponse)
+	err := c.cc.Invoke(ctx, "/artela.aspect.Query/AspectProperties", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AspectBindings(ctx context.Context, in *QueryAspectBindingsRequest, opts ...grpc.CallOption) (*QueryAspectBindingsResponse, error) {
+	out := new(QueryAspectBindingsResponse)
+	err := c.cc.Invoke(ctx, "/artela.aspect.Query/AspectBindings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountBindings(ctx context.Context, in *QueryAccountBindingsRequest, opts ...grpc.CallOption) (*QueryAccountBindingsResponse, error) {
+	out := new(QueryAccountBindingsResponse)
+	err := c.cc.Invoke(ctx, "/artela.aspect.Query/AccountBindings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Aspects(ctx context.Context, in *QueryAspectsRequest, opts ...grpc.CallOption) (*QueryAspectsResponse, error) {
+	out := new(QueryAspectsResponse)
+	err := c.cc.Invoke(ctx, "/artela.aspect.Query/Aspects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AspectState(ctx context.Context, in *QueryAspectStateRequest, opts ...grpc.CallOption) (*QueryAspectStateResponse, error) {
+	out := new(QueryAspectStateResponse)
+	err := c.cc.Invoke(ctx, "/artela.aspect.Query/AspectState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Aspect queries the metadata and all versions of an aspect.
+	Aspect(context.Context, *QueryAspectRequest) (*QueryAspectResponse, error)
+	// AspectCode queries the code of the given version of an aspect.
+	AspectCode(context.Context, *QueryAspectCodeRequest) (*QueryAspectCodeResponse, error)
+	// AspectProperties queries the properties of the given version of an aspect.
+	AspectProperties(context.Context, *QueryAspectPropertiesRequest) (*QueryAspectPropertiesResponse, error)
+	// AspectBindings queries the accounts bound to an aspect.
+	AspectBindings(context.Context, *QueryAspectBindingsRequest) (*QueryAspectBindingsResponse, error)
+	// AccountBindings queries the aspects bound to an account.
+	AccountBindings(context.Context, *QueryAccountBindingsRequest) (*QueryAccountBindingsResponse, error)
+	// Aspects queries all deployed aspects.
+	Aspects(context.Context, *QueryAspectsRequest) (*QueryAspectsResponse, error)
+	// AspectState queries a value in the state of an aspect.
+	AspectState(context.Context, *QueryAspectStateRequest) (*QueryAspectStateResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Aspect(ctx context.Context, req *QueryAspectRequest) (*QueryAspectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Aspect not implemented")
+}
+func (*UnimplementedQueryServer) AspectCode(ctx context.Context, req *QueryAspectCodeRequest) (*QueryAspectCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AspectCode not implemented")
+}
+func (*UnimplementedQueryServer) AspectProperties(ctx context.Context, req *QueryAspectPropertiesRequest) (*QueryAspectPropertiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AspectProperties not implemented")
+}
+func (*UnimplementedQueryServer) AspectBindings(ctx context.Context, req *QueryAspectBindingsRequest) (*QueryAspectBindingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AspectBindings not implemented")
+}
+func (*UnimplementedQueryServer) AccountBindings(ctx context.Context, req *QueryAccountBindingsRequest) (*QueryAccountBindingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountBindings not implemented")
+}
+func (*UnimplementedQueryServer) Aspects(ctx context.Context, req *QueryAspectsRequest) (*QueryAspectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Aspects not implemented")
+}
+func (*UnimplementedQueryServer) AspectState(ctx context.Context, req *QueryAspectStateRequest) (*QueryAspectStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AspectState not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artela.aspect.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Aspect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAspectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Aspect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artela.aspect.Query/Aspect",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Aspect(ctx, req.(*QueryAspectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AspectCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAspectCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AspectCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artela.aspect.Query/AspectCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AspectCode(ctx, req.(*QueryAspectCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AspectProperties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAspectPropertiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AspectProperties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artela.aspect.Query/AspectProperties",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AspectProperties(ctx, req.(*QueryAspectPropertiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AspectBindings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAspectBindingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AspectBindings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artela.aspect.Query/AspectBindings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AspectBindings(ctx, req.(*QueryAspectBindingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountBindings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountBindingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountBindings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artela.aspect.Query/AccountBindings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountBindings(ctx, req.(*QueryAccountBindingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Aspects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAspectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Aspects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artela.aspect.Query/Aspects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Aspects(ctx, req.(*QueryAspectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AspectState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAspectStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AspectState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artela.aspect.Query/AspectState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AspectState(ctx, req.(*QueryAspectStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "artela.aspect.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Aspect",
+			Handler:    _Query_Aspect_Handler,
+		},
+		{
+			MethodName: "AspectCode",
+			Handler:    _Query_AspectCode_Handler,
+		},
+		{
+			MethodName: "AspectProperties",
+			Handler:    _Query_AspectProperties_Handler,
+		},
+		{
+			MethodName: "AspectBindings",
+			Handler:    _Query_AspectBindings_Handler,
+		},
+		{
+			MethodName: "AccountBindings",
+			Handler:    _Query_AccountBindings_Handler,
+		},
+		{
+			MethodName: "Aspects",
+			Handler:    _Query_Aspects_Handler,
+		},
+		{
+			MethodName: "AspectState",
+			Handler:    _Query_AspectState_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "artela/aspect/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAspectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAspectRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAspectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AspectId) > 0 {
+		i -= len(m.AspectId)
+		copy(dAtA[i:], m.AspectId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AspectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAspectResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAspectResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAspectResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Versions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Aspect.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAspectCodeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAspectCodeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAspectCodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AspectId) > 0 {
+		i -= len(m.AspectId)
+		copy(dAtA[i:], m.AspectId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AspectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAspectCodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAspectCodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAspectCodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAspectPropertiesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAspectPropertiesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAspectPropertiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AspectId) > 0 {
+		i -= len(m.AspectId)
+		copy(dAtA[i:], m.AspectId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AspectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAspectPropertiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAspectPropertiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAspectPropertiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Properties) > 0 {
+		for iNdEx := len(m.Properties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Properties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAspectBindingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAspectBindingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAspectBindingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JoinPoint) > 0 {
+		i -= len(m.JoinPoint)
+		copy(dAtA[i:], m.JoinPoint)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.JoinPoint)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AspectId) > 0 {
+		i -= len(m.AspectId)
+		copy(dAtA[i:], m.AspectId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AspectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAspectBindingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAspectBindingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAspectBindingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bindings) > 0 {
+		for iNdEx := len(m.Bindings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bindings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountBindingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountBindingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountBindingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JoinPoint) > 0 {
+		i -= len(m.JoinPoint)
+		copy(dAtA[i:], m.JoinPoint)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.JoinPoint)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountBindingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountBindingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountBindingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bindings) > 0 {
+		for iNdEx := len(m.Bindings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bindings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAspectsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAspectsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAspectsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAspectsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAspectsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAspectsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Aspects) > 0 {
+		for iNdEx := len(m.Aspects) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Aspects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAspectStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAspectStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAspectStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AspectId) > 0 {
+		i -= len(m.AspectId)
+		copy(dAtA[i:], m.AspectId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AspectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAspectStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAspectStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAspectStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAspectRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AspectId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAspectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Aspect.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Versions) > 0 {
+		for _, e := range m.Versions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAspectCodeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AspectId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *QueryAspectCodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAspectPropertiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AspectId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *QueryAspectPropertiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	if len(m.Properties) > 0 {
+		for _, e := range m.Properties {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAspectBindingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AspectId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.JoinPoint)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAspectBindingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bindings) > 0 {
+		for _, e := range m.Bindings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountBindingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.JoinPoint)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountBindingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bindings) > 0 {
+		for _, e := range m.Bindings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAspectsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAspectsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Aspects) > 0 {
+		for _, e := range m.Aspects {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAspectStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AspectId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAspectStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAspectRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAspectRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAspectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AspectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AspectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAspectResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAspectResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAspectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aspect", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Aspect.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Versions = append(m.Versions, AspectVersion{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAspectCodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAspectCodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAspectCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AspectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AspectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAspectCodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAspectCodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAspectCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
+			if m.Code == nil {
+				m.Code = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAspectPropertiesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAspectPropertiesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAspectPropertiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AspectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AspectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAspectPropertiesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAspectPropertiesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAspectPropertiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Properties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Properties = append(m.Properties, AspectProperty{})
+			if err := m.Properties[len(m.Properties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAspectBindingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAspectBindingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAspectBindingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AspectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AspectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinPoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JoinPoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAspectBindingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAspectBindingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAspectBindingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bindings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bindings = append(m.Bindings, AspectBinding{})
+			if err := m.Bindings[len(m.Bindings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountBindingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountBindingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountBindingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinPoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JoinPoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountBindingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountBindingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountBindingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bindings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bindings = append(m.Bindings, AspectBinding{})
+			if err := m.Bindings[len(m.Bindings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAspectsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAspectsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAspectsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAspectsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAspectsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAspectsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aspects", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aspects = append(m.Aspects, AspectInfo{})
+			if err := m.Aspects[len(m.Aspects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAspectStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -344,12 +3774,76 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAspectStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAspectStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AspectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AspectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -371,7 +3865,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAspectStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -394,17 +3888,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAspectStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAspectStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -414,23 +3908,24 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
 			iNdEx = postIndex
 		default:
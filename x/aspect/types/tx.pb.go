@@ -126,37 +126,148 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgSetAspectStatus is the Msg/SetAspectStatus request type.
+type MsgSetAspectStatus struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// aspect_id is the hex address of the aspect.
+	AspectId string `protobuf:"bytes,2,opt,name=aspect_id,json=aspectId,proto3" json:"aspect_id,omitempty"`
+	// status is the new execution status of the aspect.
+	Status AspectStatus `protobuf:"varint,3,opt,name=status,proto3,enum=artela.aspect.AspectStatus" json:"status,omitempty"`
+}
+
+func (m *MsgSetAspectStatus) Reset()         { *m = MsgSetAspectStatus{} }
+func (m *MsgSetAspectStatus) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAspectStatus) ProtoMessage()    {}
+func (*MsgSetAspectStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a6b894a50f2bb09, []int{2}
+}
+func (m *MsgSetAspectStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAspectStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAspectStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAspectStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAspectStatus.Merge(m, src)
+}
+func (m *MsgSetAspectStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAspectStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAspectStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAspectStatus proto.InternalMessageInfo
+
+func (m *MsgSetAspectStatus) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetAspectStatus) GetAspectId() string {
+	if m != nil {
+		return m.AspectId
+	}
+	return ""
+}
+
+func (m *MsgSetAspectStatus) GetStatus() AspectStatus {
+	if m != nil {
+		return m.Status
+	}
+	return AspectStatus_ASPECT_STATUS_ACTIVE
+}
+
+// MsgSetAspectStatusResponse defines the response structure for executing a
+// MsgSetAspectStatus message.
+type MsgSetAspectStatusResponse struct {
+}
+
+func (m *MsgSetAspectStatusResponse) Reset()         { *m = MsgSetAspectStatusResponse{} }
+func (m *MsgSetAspectStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAspectStatusResponse) ProtoMessage()    {}
+func (*MsgSetAspectStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a6b894a50f2bb09, []int{3}
+}
+func (m *MsgSetAspectStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAspectStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAspectStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAspectStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAspectStatusResponse.Merge(m, src)
+}
+func (m *MsgSetAspectStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAspectStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAspectStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAspectStatusResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "artela.aspect.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "artela.aspect.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetAspectStatus)(nil), "artela.aspect.MsgSetAspectStatus")
+	proto.RegisterType((*MsgSetAspectStatusResponse)(nil), "artela.aspect.MsgSetAspectStatusResponse")
 }
 
 func init() { proto.RegisterFile("artela/aspect/tx.proto", fileDescriptor_7a6b894a50f2bb09) }
 
 var fileDescriptor_7a6b894a50f2bb09 = []byte{
-	// 350 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4b, 0x2c, 0x2a, 0x49,
-	0xcd, 0x49, 0xd4, 0x4f, 0x2c, 0x2e, 0x48, 0x4d, 0x2e, 0xd1, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0xe2, 0x85, 0x88, 0xeb, 0x41, 0xc4, 0xa5, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2,
-	0xf5, 0xc1, 0x24, 0x44, 0x85, 0x94, 0x78, 0x72, 0x7e, 0x71, 0x6e, 0x7e, 0xb1, 0x7e, 0x6e, 0x71,
-	0xba, 0x7e, 0x99, 0x21, 0x88, 0x82, 0x4a, 0x48, 0x42, 0x24, 0xe2, 0xc1, 0x3c, 0x7d, 0x08, 0x07,
-	0x2a, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x11, 0x07, 0xb1, 0xa0, 0xa2, 0x52, 0xa8, 0x6e, 0x28,
-	0x48, 0x2c, 0x4a, 0xcc, 0x85, 0xea, 0x50, 0xda, 0xce, 0xc8, 0xc5, 0xef, 0x5b, 0x9c, 0x1e, 0x5a,
-	0x90, 0x92, 0x58, 0x92, 0x1a, 0x00, 0x96, 0x11, 0x32, 0xe3, 0xe2, 0x4c, 0x2c, 0x2d, 0xc9, 0xc8,
-	0x2f, 0xca, 0x2c, 0xa9, 0x94, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x74, 0x92, 0xb8, 0xb4, 0x45, 0x57,
-	0x04, 0x6a, 0x95, 0x63, 0x4a, 0x4a, 0x51, 0x6a, 0x71, 0x71, 0x70, 0x49, 0x51, 0x66, 0x5e, 0x7a,
-	0x10, 0x42, 0xa9, 0x90, 0x05, 0x17, 0x1b, 0xc4, 0x6c, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x6e, 0x23,
-	0x51, 0x3d, 0x14, 0x4f, 0xea, 0x41, 0x8c, 0x77, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3,
-	0x0d, 0x5a, 0x8c, 0x41, 0x50, 0xf5, 0x56, 0x46, 0x4d, 0xcf, 0x37, 0x68, 0x21, 0x4c, 0xea, 0x7a,
-	0xbe, 0x41, 0x4b, 0x1e, 0xea, 0xe8, 0x0a, 0x98, 0xb3, 0xd1, 0x5c, 0xa9, 0x24, 0xc9, 0x25, 0x8e,
-	0x26, 0x14, 0x94, 0x5a, 0x5c, 0x90, 0x9f, 0x57, 0x9c, 0x6a, 0x94, 0xc2, 0xc5, 0xec, 0x5b, 0x9c,
-	0x2e, 0x14, 0xc6, 0xc5, 0x83, 0xe2, 0x2f, 0x39, 0x34, 0xf7, 0xa0, 0x69, 0x97, 0x52, 0xc3, 0x2f,
-	0x0f, 0x33, 0x5e, 0x8a, 0xb5, 0x01, 0xe4, 0x78, 0xa7, 0xc0, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c,
-	0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e,
-	0x3c, 0x96, 0x63, 0x88, 0x32, 0x4f, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5,
-	0x87, 0x18, 0xa9, 0x9b, 0x97, 0x5a, 0x52, 0x9e, 0x5f, 0x94, 0x0d, 0xe3, 0x16, 0xe5, 0xe7, 0xe4,
-	0x64, 0x67, 0x96, 0x20, 0x7c, 0x57, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x8e, 0x14, 0x63,
-	0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x41, 0xfb, 0x54, 0x25, 0x36, 0x02, 0x00, 0x00,
+	// 454 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0x4d, 0xab, 0xd3, 0x40,
+	0x14, 0xcd, 0xf8, 0xb0, 0x98, 0xf1, 0xe3, 0x61, 0x78, 0xfa, 0xf2, 0xf2, 0x24, 0xaf, 0x66, 0x21,
+	0xb5, 0xd0, 0x04, 0x53, 0xfc, 0xa0, 0xbb, 0x76, 0xe7, 0xa2, 0xa0, 0x29, 0xba, 0x70, 0x53, 0xa6,
+	0xcd, 0x30, 0x0d, 0x6d, 0x32, 0x61, 0x66, 0xaa, 0xed, 0x4e, 0x5c, 0xba, 0xf2, 0x67, 0xb8, 0xec,
+	0x42, 0xf0, 0x17, 0x08, 0x5d, 0x16, 0x41, 0x70, 0x25, 0xd2, 0x2e, 0xfa, 0x37, 0xa4, 0x99, 0x84,
+	0xda, 0x69, 0x51, 0x78, 0x9b, 0x4e, 0xef, 0x39, 0xe7, 0xde, 0x39, 0xe7, 0x66, 0xe0, 0x5d, 0xc4,
+	0x04, 0x1e, 0x21, 0x0f, 0xf1, 0x14, 0xf7, 0x85, 0x27, 0x26, 0x6e, 0xca, 0xa8, 0xa0, 0xc6, 0x4d,
+	0x89, 0xbb, 0x12, 0xb7, 0x6e, 0xa3, 0x38, 0x4a, 0xa8, 0x97, 0xfd, 0x4a, 0x85, 0x75, 0xda, 0xa7,
+	0x3c, 0xa6, 0xdc, 0x8b, 0x39, 0xf1, 0xde, 0x3e, 0xda, 0x1c, 0x39, 0x71, 0x26, 0x89, 0x6e, 0x56,
+	0x79, 0xb2, 0xc8, 0xa9, 0x13, 0x42, 0x09, 0x95, 0xf8, 0xe6, 0x5f, 0x8e, 0x5a, 0xbb, 0x1e, 0x52,
+	0xc4, 0x50, 0xcc, 0x0f, 0x73, 0xf2, 0x90, 0x9c, 0xf3, 0x15, 0xc0, 0xe3, 0x36, 0x27, 0xaf, 0xd2,
+	0x10, 0x09, 0xfc, 0x22, 0xeb, 0x32, 0x9e, 0x40, 0x1d, 0x8d, 0xc5, 0x80, 0xb2, 0x48, 0x4c, 0x4d,
+	0x50, 0x06, 0x15, 0xbd, 0x65, 0x7e, 0xff, 0x52, 0x3b, 0xc9, 0x6d, 0x34, 0xc3, 0x90, 0x61, 0xce,
+	0x3b, 0x82, 0x45, 0x09, 0x09, 0xb6, 0x52, 0xe3, 0x19, 0x2c, 0xc9, 0x7b, 0xcd, 0x2b, 0x65, 0x50,
+	0xb9, 0xee, 0xdf, 0x71, 0x77, 0x16, 0xe0, 0xca, 0xf1, 0x2d, 0x7d, 0xfe, 0xeb, 0x42, 0xfb, 0xbc,
+	0x9e, 0x55, 0x41, 0x90, 0xeb, 0x1b, 0xfe, 0x87, 0xf5, 0xac, 0xba, 0x9d, 0xf4, 0x71, 0x3d, 0xab,
+	0x5e, 0xe4, 0xa6, 0x27, 0x85, 0x6d, 0xc5, 0xa5, 0x73, 0x06, 0x4f, 0x15, 0x28, 0xc0, 0x3c, 0xa5,
+	0x09, 0xc7, 0xce, 0x0f, 0x00, 0x8d, 0x36, 0x27, 0x1d, 0x2c, 0x9a, 0x59, 0x6f, 0x47, 0x20, 0x31,
+	0xbe, 0x7c, 0xae, 0x73, 0xa8, 0x4b, 0x0f, 0xdd, 0x28, 0xcc, 0xa2, 0xe9, 0xc1, 0x35, 0x09, 0x3c,
+	0x0f, 0x8d, 0x3a, 0x2c, 0xf1, 0x6c, 0xbc, 0x79, 0x54, 0x06, 0x95, 0x5b, 0xfe, 0xb9, 0x12, 0xfa,
+	0x6f, 0x07, 0x41, 0x2e, 0x6d, 0x3c, 0xde, 0xcf, 0xeb, 0x1c, 0xc8, 0xab, 0x04, 0x70, 0xee, 0x41,
+	0x6b, 0x1f, 0x2d, 0x52, 0xfb, 0xdf, 0x00, 0x3c, 0x6a, 0x73, 0x62, 0xbc, 0x86, 0x37, 0x76, 0x3e,
+	0xa7, 0xad, 0x38, 0x52, 0xb6, 0x66, 0x3d, 0xf8, 0x37, 0x5f, 0xcc, 0x37, 0xba, 0xf0, 0x58, 0xdd,
+	0xe8, 0xfd, 0xfd, 0x56, 0x45, 0x62, 0x3d, 0xfc, 0xaf, 0xa4, 0xb8, 0xc0, 0xba, 0xfa, 0x7e, 0xf3,
+	0x28, 0x5a, 0x2f, 0xe7, 0x4b, 0x1b, 0x2c, 0x96, 0x36, 0xf8, 0xbd, 0xb4, 0xc1, 0xa7, 0x95, 0xad,
+	0x2d, 0x56, 0xb6, 0xf6, 0x73, 0x65, 0x6b, 0x6f, 0x9e, 0x92, 0x48, 0x0c, 0xc6, 0x3d, 0xb7, 0x4f,
+	0x63, 0x4f, 0x4e, 0xad, 0x25, 0x58, 0xbc, 0xa3, 0x6c, 0x58, 0x94, 0x8c, 0x8e, 0x46, 0xc3, 0x48,
+	0x6c, 0xb7, 0x28, 0xa6, 0x29, 0xe6, 0xbd, 0x52, 0xf6, 0xd8, 0xeb, 0x7f, 0x06, 0x00, 0x31, 0xe8,
+	0x29, 0x4d, 0xaa, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -174,6 +285,9 @@ type MsgClient interface {
 	// UpdateParams defines a (governance) operation for updating the module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetAspectStatus defines a (governance) operation for changing the execution
+	// status of an aspect, e.g. pausing a buggy aspect chain-wide.
+	SetAspectStatus(ctx context.Context, in *MsgSetAspectStatus, opts ...grpc.CallOption) (*MsgSetAspectStatusResponse, error)
 }
 
 type msgClient struct {
@@ -193,11 +307,23 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetAspectStatus(ctx context.Context, in *MsgSetAspectStatus, opts ...grpc.CallOption) (*MsgSetAspectStatusResponse, error) {
+	out := new(MsgSetAspectStatusResponse)
+	err := c.cc.Invoke(ctx, "/artela.aspect.Msg/SetAspectStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetAspectStatus defines a (governance) operation for changing the execution
+	// status of an aspect, e.g. pausing a buggy aspect chain-wide.
+	SetAspectStatus(context.Context, *MsgSetAspectStatus) (*MsgSetAspectStatusResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -207,6 +333,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) SetAspectStatus(ctx context.Context, req *MsgSetAspectStatus) (*MsgSetAspectStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAspectStatus not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -230,6 +359,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetAspectStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAspectStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAspectStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artela.aspect.Msg/SetAspectStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAspectStatus(ctx, req.(*MsgSetAspectStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "artela.aspect.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -238,6 +385,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetAspectStatus",
+			Handler:    _Msg_SetAspectStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "artela/aspect/tx.proto",
@@ -306,6 +457,71 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetAspectStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAspectStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAspectStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AspectId) > 0 {
+		i -= len(m.AspectId)
+		copy(dAtA[i:], m.AspectId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AspectId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAspectStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAspectStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAspectStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -341,6 +557,35 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetAspectStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AspectId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *MsgSetAspectStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -512,6 +757,189 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetAspectStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAspectStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAspectStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AspectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AspectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= AspectStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAspectStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAspectStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAspectStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -36,6 +36,9 @@ func (a *aspectStateHostAPI) Set(ctx *asptypes.RunnerContext, key string, value
 		return errors.New("cannot set aspect state in current join point")
 	}
 	a.aspectRuntimeContext.SetAspectState(ctx, key, value)
+	if logger := a.aspectRuntimeContext.AspectHostLogger(); logger != nil {
+		logger.CaptureAspectStateWrite(ctx, key, value)
+	}
 	return nil
 }
 
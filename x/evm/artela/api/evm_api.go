@@ -105,6 +105,7 @@ func (e *evmHostApi) JITCall(ctx *asptypes.RunnerContext, request *asptypes.JitI
 	switch asptypes.PointCut(ctx.Point) {
 	case asptypes.PRE_CONTRACT_CALL_METHOD, asptypes.POST_CONTRACT_CALL_METHOD:
 		// FIXME: get leftover gas from last evm
+		gasIn := ctx.Gas
 		resp, gas, err := e.aspectCtx.JITManager().Submit(ctx.Ctx, ctx.AspectId, ctx.Gas, request)
 		if err != nil {
 			if resp == nil {
@@ -119,6 +120,9 @@ func (e *evmHostApi) JITCall(ctx *asptypes.RunnerContext, request *asptypes.JitI
 		}
 
 		ctx.Gas = gas
+		if logger := e.aspectCtx.AspectHostLogger(); logger != nil {
+			logger.CaptureAspectJITCall(ctx, request, resp, gasIn-gas)
+		}
 
 		return resp, nil
 	default:
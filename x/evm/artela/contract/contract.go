@@ -50,9 +50,17 @@ func (c *AspectNativeContract) Init() {
 	c.register(BindHandler{})
 	c.register(UnbindHandler{})
 	c.register(ChangeVersionHandler{})
+	c.register(TransferOwnershipHandler{})
+	c.register(SetPaymasterHandler{})
+	c.register(SetPaymasterLimitHandler{})
+	c.register(RenounceHandler{})
+	c.register(SetStatusHandler{})
 	c.register(GetVersionHandler{})
+	c.register(GetPaymasterLimitHandler{})
 	c.register(GetBindingHandler{})
 	c.register(GetBoundAddressHandler{})
+	c.register(GetBindingPagedHandler{})
+	c.register(GetBoundAddressPagedHandler{})
 	c.register(OperationHandler{})
 }
 
@@ -0,0 +1,32 @@
+package contract
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/common"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/artela-network/aspect-core/djpm/contract"
+
+	"github.com/artela-network/artela-rollkit/common/aspect"
+)
+
+// aspectContractAddr is the address of the aspect system contract the lifecycle event logs are emitted from.
+var aspectContractAddr = common.HexToAddress(contract.ARTELA_FROM_ADDR)
+
+// emitEvent emits an aspect lifecycle event both as an EVM log from the aspect system contract
+// and as a typed cosmos event, args are the arguments of the event abi in order.
+func emitEvent(ctx *HandlerContext, typedEvent proto.Message, name string, args ...interface{}) error {
+	topics, data, err := aspect.PackEvent(name, args...)
+	if err != nil {
+		return err
+	}
+
+	ctx.evmState.AddLog(&ethereum.Log{
+		Address:     aspectContractAddr,
+		Topics:      topics,
+		Data:        data,
+		BlockNumber: uint64(ctx.cosmosCtx.BlockHeight()),
+	})
+
+	return ctx.cosmosCtx.EventManager().EmitTypedEvent(typedEvent)
+}
@@ -26,10 +26,14 @@ import (
 	runtimeTypes "github.com/artela-network/aspect-runtime/types"
 
 	arttool "github.com/artela-network/artela-rollkit/common"
+	"github.com/artela-network/artela-rollkit/common/aspect"
 	"github.com/artela-network/artela-rollkit/x/evm/artela/types"
 	"github.com/artela-network/artela-rollkit/x/evm/states"
 )
 
+// maxBindingPageLimit is the maximum number of bindings returned by a paged binding query
+const maxBindingPageLimit = 1000
+
 var (
 	zero      = uint256.NewInt(0)
 	one       = uint256.NewInt(1)
@@ -71,6 +75,10 @@ func (h DeployHandler) Handle(ctx *HandlerContext, gas uint64) ([]byte, uint64,
 		return nil, 0, err
 	}
 
+	if err := checkDeployPermission(ctx, code); err != nil {
+		return nil, 0, err
+	}
+
 	// we can ignore the new store here, since new deployed aspect should not have that
 	metaStore, _, err := store.GetAspectMetaStore(buildAspectStoreCtx(ctx, aspectID, gas))
 	if err != nil {
@@ -110,9 +118,13 @@ func (h DeployHandler) Handle(ctx *HandlerContext, gas uint64) ([]byte, uint64,
 		return nil, 0, err
 	}
 
+	// the deployer is persisted as the owner, the ownership check of the aspect code is only used
+	// for the aspects deployed without one
+	owner := ctx.from
 	if err = metaStore.StoreMeta(&aspectmoduletypes.AspectMeta{
 		Proof:     proof,
 		PayMaster: paymaster,
+		Owner:     &owner,
 	}); err != nil {
 		ctx.logger.Error("store aspect meta failed", "error", err)
 		return nil, 0, err
@@ -144,7 +156,7 @@ func (h DeployHandler) Handle(ctx *HandlerContext, gas uint64) ([]byte, uint64,
 	height := ctx.cosmosCtx.BlockHeight()
 	heightU64 := uint64(height)
 
-	return runner.JoinPoint(artelasdkType.INIT_METHOD, gas, height, aspectID, &artelasdkType.InitInput{
+	ret, gas, err := runner.JoinPoint(artelasdkType.INIT_METHOD, gas, height, aspectID, &artelasdkType.InitInput{
 		Tx: &artelasdkType.WithFromTxInput{
 			Hash: txHash,
 			To:   aspectID.Bytes(),
@@ -153,6 +165,20 @@ func (h DeployHandler) Handle(ctx *HandlerContext, gas uint64) ([]byte, uint64,
 		Block:    &artelasdkType.BlockInput{Number: &heightU64},
 		CallData: initData,
 	})
+	if err != nil {
+		return ret, gas, err
+	}
+
+	if err := emitEvent(ctx, &aspectmoduletypes.EventAspectDeployed{
+		AspectId:  aspectID.Hex(),
+		Deployer:  ctx.from.Hex(),
+		Version:   newVersion,
+		JoinPoint: joinPoint.Uint64(),
+	}, aspect.EventAspectDeployed, aspectID, ctx.from, newVersion, joinPoint); err != nil {
+		return nil, 0, err
+	}
+
+	return ret, gas, nil
 }
 
 func (h DeployHandler) Method() string {
@@ -206,7 +232,7 @@ func (h DeployHandler) decodeAndValidate(ctx *HandlerContext) (
 	aspectId = crypto.CreateAddress(ctx.from, ctx.nonce)
 
 	// validate aspect code
-	code, err = validateCode(ctx.cosmosCtx, code)
+	code, err = validateCode(ctx, code)
 	return
 }
 
@@ -218,9 +244,13 @@ func (h UpgradeHandler) Handle(ctx *HandlerContext, gas uint64) ([]byte, uint64,
 		return nil, 0, err
 	}
 
+	if err := checkDeployPermission(ctx, code); err != nil {
+		return nil, 0, err
+	}
+
 	// check deployment
 	storeCtx := buildAspectStoreCtx(ctx, aspectID, gas)
-	currentStore, _, err := store.GetAspectMetaStore(storeCtx)
+	currentStore, newStore, err := store.GetAspectMetaStore(storeCtx)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -234,19 +264,22 @@ func (h UpgradeHandler) Handle(ctx *HandlerContext, gas uint64) ([]byte, uint64,
 	}
 
 	// check aspect owner
-	var currentCode []byte
-	currentCode, err = currentStore.GetCode(latestVersion)
-	if err != nil {
+	if ok, err := checkAspectOwnership(ctx, currentStore, storeCtx, aspectID, latestVersion); err != nil || !ok {
+		return nil, 0, errors.New("aspect ownership validation failed")
+	}
+
+	// deprecated aspects cannot be upgraded any more
+	if meta, err := currentStore.GetMeta(); err != nil {
 		return nil, 0, err
+	} else if meta.Status == aspectmoduletypes.AspectStatus_ASPECT_STATUS_DEPRECATED {
+		return nil, 0, errors.New("aspect deprecated")
 	}
 
-	var ok bool
-	ok, gas, err = checkAspectOwner(ctx.cosmosCtx, aspectID, ctx.from, storeCtx.Gas(), currentCode, latestVersion, ctx.commit)
-	if err != nil || !ok {
-		err = errors.New("aspect ownership validation failed")
+	// migrate to the latest store version before the first write
+	if currentStore, err = store.MigrateAspectMetaStore(currentStore, newStore); err != nil {
+		ctx.logger.Error("migrate aspect meta failed", "error", err)
 		return nil, 0, err
 	}
-	storeCtx.UpdateGas(gas)
 
 	// bump version
 	newVersion, err := currentStore.BumpVersion()
@@ -280,6 +313,14 @@ func (h UpgradeHandler) Handle(ctx *HandlerContext, gas uint64) ([]byte, uint64,
 		return nil, 0, err
 	}
 
+	if err = emitEvent(ctx, &aspectmoduletypes.EventAspectUpgraded{
+		AspectId:  aspectID.Hex(),
+		Version:   newVersion,
+		JoinPoint: jpU64,
+	}, aspect.EventAspectUpgraded, aspectID, newVersion, new(big.Int).SetUint64(jpU64)); err != nil {
+		return nil, 0, err
+	}
+
 	return nil, storeCtx.Gas(), err
 }
 
@@ -323,7 +364,7 @@ func (h UpgradeHandler) decodeAndValidate(ctx *HandlerContext) (
 	}
 
 	// validate aspect code
-	code, err = validateCode(ctx.cosmosCtx, code)
+	code, err = validateCode(ctx, code)
 	return
 }
 
@@ -335,8 +376,7 @@ func (b BindHandler) Handle(ctx *HandlerContext, gas uint64) (ret []byte, remain
 		return nil, 0, err
 	}
 
-	// no need to get new version store, since we only do auto migration during aspect upgrade
-	metaStore, _, err := store.GetAspectMetaStore(buildAspectStoreCtx(ctx, aspectID, leftover))
+	metaStore, newMetaStore, err := store.GetAspectMetaStore(buildAspectStoreCtx(ctx, aspectID, leftover))
 	if err != nil {
 		return nil, 0, err
 	}
@@ -350,6 +390,13 @@ func (b BindHandler) Handle(ctx *HandlerContext, gas uint64) (ret []byte, remain
 		return nil, 0, errors.New("aspect not deployed")
 	}
 
+	// deprecated aspects cannot be bound any more
+	if aspectMeta, err := metaStore.GetMeta(); err != nil {
+		return nil, 0, err
+	} else if aspectMeta.Status == aspectmoduletypes.AspectStatus_ASPECT_STATUS_DEPRECATED {
+		return nil, 0, errors.New("aspect deprecated")
+	}
+
 	if aspectVersion == 0 {
 		// use latest if not specified
 		aspectVersion = latestVersion
@@ -362,6 +409,10 @@ func (b BindHandler) Handle(ctx *HandlerContext, gas uint64) (ret []byte, remain
 		return nil, 0, err
 	}
 
+	if err := checkBindPermission(ctx, metaStore, aspectVersion, meta); err != nil {
+		return nil, 0, err
+	}
+
 	i64JP := int64(meta.JoinPoint)
 	txAspect := artelasdkType.CheckIsTransactionLevel(i64JP)
 	txVerifier := artelasdkType.CheckIsTxVerifier(i64JP)
@@ -375,16 +426,24 @@ func (b BindHandler) Handle(ctx *HandlerContext, gas uint64) (ret []byte, remain
 		return nil, 0, errors.New("only verifier aspect can be bound with eoa")
 	}
 
+	// migrate to the latest store version before the first write
+	if metaStore, err = store.MigrateAspectMetaStore(metaStore, newMetaStore); err != nil {
+		return nil, 0, err
+	}
+
 	// save aspect -> contract bindings
 	if err := metaStore.StoreBinding(account, aspectVersion, meta.JoinPoint, priority); err != nil {
 		return nil, 0, err
 	}
 
 	// init account store
-	accountStore, _, err := store.GetAccountStore(buildAccountStoreCtx(ctx, account, metaStore.Gas()))
+	accountStore, newAccountStore, err := store.GetAccountStore(buildAccountStoreCtx(ctx, account, metaStore.Gas()))
 	if err != nil {
 		return nil, 0, err
 	}
+	if accountStore, err = store.MigrateAccountStore(accountStore, newAccountStore); err != nil {
+		return nil, 0, err
+	}
 
 	// check if used
 	if used, err := accountStore.Used(); err != nil {
@@ -402,6 +461,15 @@ func (b BindHandler) Handle(ctx *HandlerContext, gas uint64) (ret []byte, remain
 		return nil, 0, err
 	}
 
+	if err := emitEvent(ctx, &aspectmoduletypes.EventAspectBound{
+		AspectId: aspectID.Hex(),
+		Account:  account.Hex(),
+		Version:  aspectVersion,
+		Priority: int32(priority),
+	}, aspect.EventAspectBound, aspectID, account, aspectVersion, priority); err != nil {
+		return nil, 0, err
+	}
+
 	return nil, accountStore.Gas(), nil
 }
 
@@ -468,7 +536,7 @@ func (u UnbindHandler) Handle(ctx *HandlerContext, gas uint64) (ret []byte, rema
 	}
 
 	// init account store
-	accountStore, _, err := store.GetAccountStore(buildAccountStoreCtx(ctx, account, leftover))
+	accountStore, newAccountStore, err := store.GetAccountStore(buildAccountStoreCtx(ctx, account, leftover))
 	if err != nil {
 		return nil, 0, err
 	}
@@ -493,11 +561,16 @@ func (u UnbindHandler) Handle(ctx *HandlerContext, gas uint64) (ret []byte, rema
 	}
 
 	// init aspect meta store
-	metaStore, _, err := store.GetAspectMetaStore(buildAspectStoreCtx(ctx, aspectID, accountStore.Gas()))
+	metaStore, newMetaStore, err := store.GetAspectMetaStore(buildAspectStoreCtx(ctx, aspectID, accountStore.Gas()))
 	if err != nil {
 		return nil, 0, err
 	}
 
+	// migrate to the latest store version before the first write
+	if metaStore, err = store.MigrateAspectMetaStore(metaStore, newMetaStore); err != nil {
+		return nil, 0, err
+	}
+
 	// remove account from aspect bound list
 	if err := metaStore.RemoveBinding(account); err != nil {
 		return nil, 0, err
@@ -510,10 +583,20 @@ func (u UnbindHandler) Handle(ctx *HandlerContext, gas uint64) (ret []byte, rema
 
 	// remove aspect from account bound list
 	accountStore.TransferGasFrom(metaStore)
+	if accountStore, err = store.MigrateAccountStore(accountStore, newAccountStore); err != nil {
+		return nil, 0, err
+	}
 	if err := accountStore.RemoveBinding(aspectID, meta.JoinPoint, isContract); err != nil {
 		return nil, 0, err
 	}
 
+	if err := emitEvent(ctx, &aspectmoduletypes.EventAspectUnbound{
+		AspectId: aspectID.Hex(),
+		Account:  account.Hex(),
+	}, aspect.EventAspectUnbound, aspectID, account); err != nil {
+		return nil, 0, err
+	}
+
 	return nil, accountStore.Gas(), nil
 }
 
@@ -564,7 +647,7 @@ func (c ChangeVersionHandler) Handle(ctx *HandlerContext, gas uint64) (ret []byt
 	}
 
 	// init account store
-	accountStore, _, err := store.GetAccountStore(buildAccountStoreCtx(ctx, account, leftover))
+	accountStore, newAccountStore, err := store.GetAccountStore(buildAccountStoreCtx(ctx, account, leftover))
 	if err != nil {
 		return nil, 0, err
 	}
@@ -606,6 +689,13 @@ func (c ChangeVersionHandler) Handle(ctx *HandlerContext, gas uint64) (ret []byt
 		return nil, 0, errors.New("aspect not deployed")
 	}
 
+	// deprecated aspects cannot be bound to another version any more
+	if aspectMeta, err := metaStore.GetMeta(); err != nil {
+		return nil, 0, err
+	} else if aspectMeta.Status == aspectmoduletypes.AspectStatus_ASPECT_STATUS_DEPRECATED {
+		return nil, 0, errors.New("aspect deprecated")
+	}
+
 	if version > latestVersion {
 		return nil, 0, errors.New("given version of aspect does not exist")
 	}
@@ -621,6 +711,10 @@ func (c ChangeVersionHandler) Handle(ctx *HandlerContext, gas uint64) (ret []byt
 		return nil, 0, err
 	}
 
+	if err := checkBindPermission(ctx, metaStore, version, newVersionMeta); err != nil {
+		return nil, 0, err
+	}
+
 	i64JP := int64(newVersionMeta.JoinPoint)
 	txAspect := artelasdkType.CheckIsTransactionLevel(i64JP)
 	txVerifier := artelasdkType.CheckIsTxVerifier(i64JP)
@@ -636,6 +730,9 @@ func (c ChangeVersionHandler) Handle(ctx *HandlerContext, gas uint64) (ret []byt
 
 	// remove old version aspect from account bound list
 	accountStore.TransferGasFrom(metaStore)
+	if accountStore, err = store.MigrateAccountStore(accountStore, newAccountStore); err != nil {
+		return nil, 0, err
+	}
 	if err := accountStore.RemoveBinding(aspectID, currentVersionMeta.JoinPoint, isContract); err != nil {
 		return nil, 0, err
 	}
@@ -645,6 +742,15 @@ func (c ChangeVersionHandler) Handle(ctx *HandlerContext, gas uint64) (ret []byt
 		return nil, 0, err
 	}
 
+	if err := emitEvent(ctx, &aspectmoduletypes.EventAspectVersionChanged{
+		AspectId:   aspectID.Hex(),
+		Account:    account.Hex(),
+		OldVersion: bindingInfo.Version,
+		NewVersion: version,
+	}, aspect.EventAspectVersionChanged, aspectID, account, bindingInfo.Version, version); err != nil {
+		return nil, 0, err
+	}
+
 	return nil, accountStore.Gas(), nil
 }
 
@@ -688,6 +794,295 @@ func (c ChangeVersionHandler) decodeAndValidate(ctx *HandlerContext, gas uint64)
 	return
 }
 
+type TransferOwnershipHandler struct{}
+
+func (t TransferOwnershipHandler) Handle(ctx *HandlerContext, gas uint64) (ret []byte, remainingGas uint64, err error) {
+	aspectID, newOwner, err := t.decodeAndValidate(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	metaStore, meta, err := loadOwnedAspect(ctx, aspectID, gas)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// the paymaster is set by the previous owner, it is cleared so the new owner cannot spend its balance
+	previousOwner := ctx.from
+	previousPayMaster := meta.PayMaster
+	meta.Owner = &newOwner
+	meta.PayMaster = emptyAddr
+	meta.Proof = nil
+	if err := metaStore.StoreMeta(meta); err != nil {
+		ctx.logger.Error("store aspect meta failed", "error", err)
+		return nil, 0, err
+	}
+
+	if err := emitEvent(ctx, &aspectmoduletypes.EventAspectOwnershipTransferred{
+		AspectId:      aspectID.Hex(),
+		PreviousOwner: previousOwner.Hex(),
+		NewOwner:      newOwner.Hex(),
+	}, aspect.EventAspectOwnershipTransferred, aspectID, previousOwner, newOwner); err != nil {
+		return nil, 0, err
+	}
+
+	if !bytes.Equal(emptyAddr.Bytes(), previousPayMaster.Bytes()) {
+		if err := emitEvent(ctx, &aspectmoduletypes.EventAspectPayMasterUpdated{
+			AspectId:  aspectID.Hex(),
+			PayMaster: emptyAddr.Hex(),
+		}, aspect.EventAspectPayMasterUpdated, aspectID, emptyAddr); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	return nil, metaStore.Gas(), nil
+}
+
+func (t TransferOwnershipHandler) Method() string {
+	return "transferownership"
+}
+
+func (t TransferOwnershipHandler) decodeAndValidate(ctx *HandlerContext) (aspectID common.Address, newOwner common.Address, err error) {
+	aspectID = ctx.parameters["aspectId"].(common.Address)
+	if bytes.Equal(emptyAddr.Bytes(), aspectID.Bytes()) {
+		err = errors.New("aspectId not specified")
+		return
+	}
+
+	// renounce should be used to give up the ownership explicitly
+	newOwner = ctx.parameters["newOwner"].(common.Address)
+	if bytes.Equal(emptyAddr.Bytes(), newOwner.Bytes()) {
+		err = errors.New("new owner not specified")
+	}
+
+	return
+}
+
+type SetPaymasterHandler struct{}
+
+func (s SetPaymasterHandler) Handle(ctx *HandlerContext, gas uint64) (ret []byte, remainingGas uint64, err error) {
+	aspectID, paymaster, proof, err := s.decodeAndValidate(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	metaStore, meta, err := loadOwnedAspect(ctx, aspectID, gas)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	meta.PayMaster = paymaster
+	meta.Proof = proof
+	if err := metaStore.StoreMeta(meta); err != nil {
+		ctx.logger.Error("store aspect meta failed", "error", err)
+		return nil, 0, err
+	}
+
+	if err := emitEvent(ctx, &aspectmoduletypes.EventAspectPayMasterUpdated{
+		AspectId:  aspectID.Hex(),
+		PayMaster: paymaster.Hex(),
+	}, aspect.EventAspectPayMasterUpdated, aspectID, paymaster); err != nil {
+		return nil, 0, err
+	}
+
+	return nil, metaStore.Gas(), nil
+}
+
+func (s SetPaymasterHandler) Method() string {
+	return "setpaymaster"
+}
+
+func (s SetPaymasterHandler) decodeAndValidate(ctx *HandlerContext) (aspectID common.Address, paymaster common.Address, proof []byte, err error) {
+	aspectID = ctx.parameters["aspectId"].(common.Address)
+	if bytes.Equal(emptyAddr.Bytes(), aspectID.Bytes()) {
+		err = errors.New("aspectId not specified")
+		return
+	}
+
+	// same as deployment, paymaster can only be set to the sender itself
+	paymaster = ctx.parameters["paymaster"].(common.Address)
+	if !bytes.Equal(paymaster.Bytes(), ctx.from.Bytes()) {
+		err = errors.New("account verification fail")
+		return
+	}
+
+	proof = ctx.parameters["proof"].([]byte)
+	return
+}
+
+type SetPaymasterLimitHandler struct{}
+
+func (s SetPaymasterLimitHandler) Handle(ctx *HandlerContext, gas uint64) (ret []byte, remainingGas uint64, err error) {
+	aspectID, limit, err := s.decodeAndValidate(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// the limit is set by the paymaster itself, separately for each aspect it pays for
+	storeCtx := aspectmoduletypes.NewStoreContext(ctx.cosmosCtx, ctx.storeService, ctx.aspectStoreService, gas)
+	allowance, err := store.LoadPaymasterAllowance(storeCtx, ctx.from, aspectID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	allowance.Limit = new(big.Int).Set(limit)
+	if err := store.StorePaymasterAllowance(storeCtx, ctx.from, aspectID, allowance); err != nil {
+		ctx.logger.Error("store paymaster allowance failed", "error", err)
+		return nil, 0, err
+	}
+
+	if err := emitEvent(ctx, &aspectmoduletypes.EventPaymasterLimitUpdated{
+		PayMaster: ctx.from.Hex(),
+		Limit:     limit.String(),
+		Spent:     allowance.Spent.String(),
+		AspectId:  aspectID.Hex(),
+	}, aspect.EventPaymasterLimitUpdated, ctx.from, aspectID, limit); err != nil {
+		return nil, 0, err
+	}
+
+	return nil, storeCtx.Gas(), nil
+}
+
+func (s SetPaymasterLimitHandler) Method() string {
+	return "setpaymasterlimit"
+}
+
+func (s SetPaymasterLimitHandler) decodeAndValidate(ctx *HandlerContext) (aspectID common.Address, limit *big.Int, err error) {
+	aspectID = ctx.parameters["aspectId"].(common.Address)
+	if bytes.Equal(emptyAddr.Bytes(), aspectID.Bytes()) {
+		err = errors.New("aspectId not specified")
+		return
+	}
+
+	limit = ctx.parameters["limit"].(*big.Int)
+	return
+}
+
+type GetPaymasterLimitHandler struct{}
+
+func (g GetPaymasterLimitHandler) Handle(ctx *HandlerContext, gas uint64) (ret []byte, remainingGas uint64, err error) {
+	paymaster := ctx.parameters["paymaster"].(common.Address)
+	aspectID := ctx.parameters["aspectId"].(common.Address)
+
+	storeCtx := aspectmoduletypes.NewStoreContext(ctx.cosmosCtx, ctx.storeService, ctx.aspectStoreService, gas)
+	allowance, err := store.LoadPaymasterAllowance(storeCtx, paymaster, aspectID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ret, err = ctx.abi.Outputs.Pack(allowance.Limit, allowance.Spent)
+	if err != nil {
+		return nil, gas, err
+	}
+
+	return ret, storeCtx.Gas(), nil
+}
+
+func (g GetPaymasterLimitHandler) Method() string {
+	return "paymasterlimitof"
+}
+
+type RenounceHandler struct{}
+
+func (r RenounceHandler) Handle(ctx *HandlerContext, gas uint64) (ret []byte, remainingGas uint64, err error) {
+	aspectID, err := r.decodeAndValidate(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	metaStore, meta, err := loadOwnedAspect(ctx, aspectID, gas)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// zero address owner marks the aspect as renounced, so it can no longer be managed by anyone
+	meta.Owner = &common.Address{}
+	if err := metaStore.StoreMeta(meta); err != nil {
+		ctx.logger.Error("store aspect meta failed", "error", err)
+		return nil, 0, err
+	}
+
+	if err := emitEvent(ctx, &aspectmoduletypes.EventAspectOwnershipTransferred{
+		AspectId:      aspectID.Hex(),
+		PreviousOwner: ctx.from.Hex(),
+		NewOwner:      emptyAddr.Hex(),
+	}, aspect.EventAspectOwnershipTransferred, aspectID, ctx.from, emptyAddr); err != nil {
+		return nil, 0, err
+	}
+
+	return nil, metaStore.Gas(), nil
+}
+
+func (r RenounceHandler) Method() string {
+	return "renounce"
+}
+
+func (r RenounceHandler) decodeAndValidate(ctx *HandlerContext) (aspectID common.Address, err error) {
+	aspectID = ctx.parameters["aspectId"].(common.Address)
+	if bytes.Equal(emptyAddr.Bytes(), aspectID.Bytes()) {
+		err = errors.New("aspectId not specified")
+	}
+	return
+}
+
+type SetStatusHandler struct{}
+
+func (s SetStatusHandler) Handle(ctx *HandlerContext, gas uint64) (ret []byte, remainingGas uint64, err error) {
+	aspectID, status, err := s.decodeAndValidate(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	metaStore, meta, err := loadOwnedAspect(ctx, aspectID, gas)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// deprecation is final for aspect owners, only governance can bring a deprecated aspect back
+	previousStatus := meta.Status
+	if previousStatus == aspectmoduletypes.AspectStatus_ASPECT_STATUS_DEPRECATED {
+		return nil, 0, errors.New("aspect deprecated")
+	}
+	if previousStatus == status {
+		return nil, 0, errors.New("aspect status not changed")
+	}
+
+	meta.Status = status
+	if err := metaStore.StoreMeta(meta); err != nil {
+		ctx.logger.Error("store aspect meta failed", "error", err)
+		return nil, 0, err
+	}
+
+	if err := emitEvent(ctx, &aspectmoduletypes.EventAspectStatusChanged{
+		AspectId:       aspectID.Hex(),
+		PreviousStatus: previousStatus,
+		Status:         status,
+		Operator:       ctx.from.Hex(),
+	}, aspect.EventAspectStatusChanged, aspectID, uint8(previousStatus), uint8(status)); err != nil {
+		return nil, 0, err
+	}
+
+	return nil, metaStore.Gas(), nil
+}
+
+func (s SetStatusHandler) Method() string {
+	return "setstatus"
+}
+
+func (s SetStatusHandler) decodeAndValidate(ctx *HandlerContext) (aspectID common.Address, status aspectmoduletypes.AspectStatus, err error) {
+	aspectID = ctx.parameters["aspectId"].(common.Address)
+	if bytes.Equal(emptyAddr.Bytes(), aspectID.Bytes()) {
+		err = errors.New("aspectId not specified")
+		return
+	}
+
+	status = aspectmoduletypes.AspectStatus(ctx.parameters["status"].(uint8))
+	if _, ok := aspectmoduletypes.AspectStatus_name[int32(status)]; !ok {
+		err = errors.New("invalid aspect status")
+	}
+	return
+}
+
 type GetVersionHandler struct{}
 
 func (g GetVersionHandler) Handle(ctx *HandlerContext, gas uint64) (ret []byte, remainingGas uint64, err error) {
@@ -826,6 +1221,125 @@ func (g GetBoundAddressHandler) decodeAndValidate(ctx *HandlerContext) (aspectId
 	return
 }
 
+type GetBindingPagedHandler struct{}
+
+func (g GetBindingPagedHandler) Handle(ctx *HandlerContext, gas uint64) (ret []byte, remainingGas uint64, err error) {
+	account, filter, page, err := g.decodeAndValidate(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// init account store
+	accountStore, _, err := store.GetAccountStore(buildAccountStoreCtx(ctx, account, gas))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	bindings, nextCursor, err := accountStore.LoadAccountBoundAspectsPaged(filter, page)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	aspectInfo := make([]types.AspectInfo, 0, len(bindings))
+	for _, binding := range bindings {
+		aspectInfo = append(aspectInfo, types.AspectInfo{
+			AspectId: binding.Account,
+			Version:  binding.Version,
+			Priority: binding.Priority,
+		})
+	}
+
+	ret, err = ctx.abi.Outputs.Pack(aspectInfo, nextCursor)
+	return ret, accountStore.Gas(), err
+}
+
+func (g GetBindingPagedHandler) Method() string {
+	return "aspectsofpaged"
+}
+
+func (g GetBindingPagedHandler) decodeAndValidate(ctx *HandlerContext) (account common.Address, filter aspectmoduletypes.BindingFilter, page aspectmoduletypes.BindingPage, err error) {
+	account = ctx.parameters["contract"].(common.Address)
+	if bytes.Equal(emptyAddr.Bytes(), account.Bytes()) {
+		err = errors.New("binding account not specified")
+		return
+	}
+
+	filter = aspectmoduletypes.NewDefaultFilter(len(ctx.evmState.GetCode(account)) > 0)
+	page, err = decodeBindingPage(ctx, &filter)
+	return
+}
+
+type GetBoundAddressPagedHandler struct{}
+
+func (g GetBoundAddressPagedHandler) Handle(ctx *HandlerContext, gas uint64) (ret []byte, remainingGas uint64, err error) {
+	aspectID, filter, page, err := g.decodeAndValidate(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// init meta store
+	metaStore, _, err := store.GetAspectMetaStore(buildAspectStoreCtx(ctx, aspectID, gas))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// check deployment
+	if latestVersion, err := metaStore.GetLatestVersion(); err != nil {
+		return nil, 0, err
+	} else if latestVersion == 0 {
+		return nil, 0, errors.New("aspect not deployed")
+	}
+
+	bindings, nextCursor, err := metaStore.LoadAspectBoundAccountsPaged(filter, page)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	addressArr := make([]common.Address, 0, len(bindings))
+	for _, binding := range bindings {
+		addressArr = append(addressArr, binding.Account)
+	}
+
+	ret, err = ctx.abi.Outputs.Pack(addressArr, nextCursor)
+	return ret, metaStore.Gas(), err
+}
+
+func (g GetBoundAddressPagedHandler) Method() string {
+	return "boundaddressesofpaged"
+}
+
+func (g GetBoundAddressPagedHandler) decodeAndValidate(ctx *HandlerContext) (aspectId common.Address, filter aspectmoduletypes.BindingFilter, page aspectmoduletypes.BindingPage, err error) {
+	aspectId = ctx.parameters["aspectId"].(common.Address)
+	if bytes.Equal(emptyAddr.Bytes(), aspectId.Bytes()) {
+		err = errors.New("aspect id not specified")
+		return
+	}
+
+	page, err = decodeBindingPage(ctx, &filter)
+	return
+}
+
+// decodeBindingPage decodes the join point filter and the page of the paged binding queries,
+// the page size is capped at maxBindingPageLimit to bound the cost of a single query.
+func decodeBindingPage(ctx *HandlerContext, filter *aspectmoduletypes.BindingFilter) (page aspectmoduletypes.BindingPage, err error) {
+	if joinPoint := ctx.parameters["joinPoint"].(string); joinPoint != "" {
+		if _, ok := artelasdkType.JoinPointRunType_value[joinPoint]; !ok {
+			err = fmt.Errorf("invalid join point: %s", joinPoint)
+			return
+		}
+		cut := artelasdkType.PointCut(joinPoint)
+		filter.JoinPoint = &cut
+	}
+
+	page.Cursor = ctx.parameters["cursor"].(uint64)
+	page.Limit = ctx.parameters["limit"].(uint64)
+	if page.Limit == 0 || page.Limit > maxBindingPageLimit {
+		page.Limit = maxBindingPageLimit
+	}
+
+	return
+}
+
 type OperationHandler struct{}
 
 func (o OperationHandler) Handle(ctx *HandlerContext, gas uint64) (ret []byte, remainingGas uint64, err error) {
@@ -848,6 +1362,13 @@ func (o OperationHandler) Handle(ctx *HandlerContext, gas uint64) (ret []byte, r
 		return nil, 0, errors.New("aspect not deployed")
 	}
 
+	// paused or deprecated aspects cannot be executed
+	if meta, err := metaStore.GetMeta(); err != nil {
+		return nil, 0, err
+	} else if meta.Status != aspectmoduletypes.AspectStatus_ASPECT_STATUS_ACTIVE {
+		return nil, 0, errors.New("aspect not active")
+	}
+
 	code, err := metaStore.GetCode(latestVersion)
 	if err != nil {
 		return nil, 0, err
@@ -901,7 +1422,14 @@ func (o OperationHandler) decodeAndValidate(ctx *HandlerContext) (aspectId commo
 	return
 }
 
-func validateCode(ctx sdk.Context, aspectCode []byte) ([]byte, error) {
+func validateCode(handlerCtx *HandlerContext, aspectCode []byte) ([]byte, error) {
+	// code size limit is adjustable with the aspect module params
+	params := aspectParams(handlerCtx)
+	if uint64(len(aspectCode)) > params.MaxCodeSize {
+		return nil, fmt.Errorf("aspect code size %d exceeds limit %d", len(aspectCode), params.MaxCodeSize)
+	}
+
+	ctx := handlerCtx.cosmosCtx
 	startTime := time.Now()
 	validator, err := runtime.NewValidator(ctx, arttool.WrapLogger(ctx.Logger()), runtime.WASM)
 	if err != nil {
@@ -968,6 +1496,114 @@ func checkAspectOwner(ctx sdk.Context, aspectId common.Address, sender common.Ad
 	return runner.IsOwner(ctx.BlockHeight(), gas, sender, sender.Bytes())
 }
 
+// checkAspectOwnership checks whether the sender owns the given aspect. The owner persisted in aspect meta
+// takes precedence, aspects deployed before the owner was persisted fall back to the ownership check of the
+// latest aspect code.
+func checkAspectOwnership(ctx *HandlerContext, metaStore store.AspectMetaStore,
+	storeCtx *aspectmoduletypes.AspectStoreContext, aspectID common.Address, latestVersion uint64,
+) (bool, error) {
+	meta, err := metaStore.GetMeta()
+	if err != nil {
+		return false, err
+	}
+	if meta.Owner != nil {
+		// renounced aspect is owned by no one
+		return !bytes.Equal(meta.Owner.Bytes(), emptyAddr.Bytes()) && bytes.Equal(meta.Owner.Bytes(), ctx.from.Bytes()), nil
+	}
+
+	code, err := metaStore.GetCode(latestVersion)
+	if err != nil {
+		return false, err
+	}
+
+	ok, gas, err := checkAspectOwner(ctx.cosmosCtx, aspectID, ctx.from, storeCtx.Gas(), code, latestVersion, ctx.commit)
+	if err != nil {
+		return false, err
+	}
+	storeCtx.UpdateGas(gas)
+
+	return ok, nil
+}
+
+// loadOwnedAspect loads the meta of an aspect owned by the sender, the meta store is migrated
+// to the latest version, so the returned store is ready to be written.
+func loadOwnedAspect(ctx *HandlerContext, aspectID common.Address, gas uint64) (store.AspectMetaStore, *aspectmoduletypes.AspectMeta, error) {
+	storeCtx := buildAspectStoreCtx(ctx, aspectID, gas)
+	currentStore, newStore, err := store.GetAspectMetaStore(storeCtx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// check deployment
+	latestVersion, err := currentStore.GetLatestVersion()
+	if err != nil {
+		return nil, nil, err
+	} else if latestVersion == 0 {
+		return nil, nil, errors.New("aspect not deployed")
+	}
+
+	if ok, err := checkAspectOwnership(ctx, currentStore, storeCtx, aspectID, latestVersion); err != nil || !ok {
+		return nil, nil, errors.New("aspect ownership validation failed")
+	}
+
+	// migrate to the latest store version before the first write
+	if currentStore, err = store.MigrateAspectMetaStore(currentStore, newStore); err != nil {
+		ctx.logger.Error("migrate aspect meta failed", "error", err)
+		return nil, nil, err
+	}
+
+	meta, err := currentStore.GetMeta()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return currentStore, meta, nil
+}
+
+// aspectParams loads the aspect module params, the loading is not charged.
+func aspectParams(ctx *HandlerContext) aspectmoduletypes.Params {
+	return aspectmoduletypes.NewGasFreeStoreContext(ctx.cosmosCtx, ctx.storeService, ctx.aspectStoreService).Params()
+}
+
+// checkDeployPermission checks whether the sender is allowed to deploy or upgrade to the given aspect code,
+// with the deployer and code hash lists in aspect params. The code is the one returned by validateCode,
+// so the checked hash is the keccak256 of the wasm bytecode after the compression header is stripped and
+// the code is decompressed, not of the raw deploy input. It is the same hash as the code_hash returned
+// by the AspectCode query, and can be computed off-chain with ParseByteCode in x/aspect/common.
+func checkDeployPermission(ctx *HandlerContext, code []byte) error {
+	params := aspectParams(ctx)
+	if !params.IsDeployerAllowed(ctx.from) {
+		return fmt.Errorf("account %s is not allowed to deploy aspects", ctx.from.Hex())
+	}
+	if codeHash := crypto.Keccak256Hash(code); !params.IsCodeHashAllowed(codeHash) {
+		return fmt.Errorf("aspect code hash %s is not allowed", codeHash.Hex())
+	}
+	return nil
+}
+
+// checkBindPermission checks whether the given aspect version can be bound, with the code hash lists in aspect params.
+func checkBindPermission(ctx *HandlerContext, metaStore store.AspectMetaStore, version uint64, meta *aspectmoduletypes.VersionMeta) error {
+	params := aspectParams(ctx)
+	if len(params.AllowedCodeHashes) == 0 && len(params.DeniedCodeHashes) == 0 {
+		return nil
+	}
+
+	codeHash := meta.CodeHash
+	if codeHash == (common.Hash{}) {
+		// code hash is not saved for aspects deployed with protocol v0
+		code, err := metaStore.GetCode(version)
+		if err != nil {
+			return err
+		}
+		codeHash = crypto.Keccak256Hash(code)
+	}
+
+	if !params.IsCodeHashAllowed(codeHash) {
+		return fmt.Errorf("aspect code hash %s is not allowed", codeHash.Hex())
+	}
+	return nil
+}
+
 // retrieving aspect context from sdk.Context must not fail, so we panic if it does
 func mustGetAspectContext(ctx sdk.Context) *types.AspectRuntimeContext {
 	aspectCtx, ok := ctx.Value(types.AspectContextKey).(*types.AspectRuntimeContext)
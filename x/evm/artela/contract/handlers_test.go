@@ -0,0 +1,61 @@
+package contract_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	asptypes "github.com/artela-network/aspect-core/types"
+
+	"github.com/artela-network/artela-rollkit/common/aspect"
+	keepertest "github.com/artela-network/artela-rollkit/testutil/keeper"
+	aspectmoduletypes "github.com/artela-network/artela-rollkit/x/aspect/types"
+	"github.com/artela-network/artela-rollkit/x/evm/artela/contract"
+	"github.com/artela-network/artela-rollkit/x/evm/states"
+)
+
+var (
+	testAccount = common.HexToAddress("0x00000000000000000000000000000000000000a1")
+	testAspect  = common.HexToAddress("0x0000000000000000000000000000000000000a51")
+)
+
+func TestChangeVersionHandler(t *testing.T) {
+	// changeVersion(address aspectId, address contract, uint64 version)
+	args := abi.Arguments{{Type: aspect.Address}, {Type: aspect.Address}, {Type: aspect.Uint64}}
+	packed, err := args.Pack(testAspect, testAccount, uint64(0))
+	require.NoError(t, err)
+	data := append(crypto.Keccak256([]byte("changeVersion(address,address,uint64)"))[:4], packed...)
+
+	testCases := []struct {
+		name   string
+		status aspectmoduletypes.AspectStatus
+		err    string
+	}{
+		{"active", aspectmoduletypes.AspectStatus_ASPECT_STATUS_ACTIVE, ""},
+		{"deprecated", aspectmoduletypes.AspectStatus_ASPECT_STATUS_DEPRECATED, "aspect deprecated"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			artelaApp, ctx := keepertest.ArtelaApp(t)
+			keepertest.BindAccountAspect(t, artelaApp, ctx, testAccount, testAspect, asptypes.JoinPointRunType_VerifyTx,
+				&aspectmoduletypes.AspectMeta{Status: tc.status}, false)
+
+			stateDB := states.New(ctx, artelaApp.EvmKeeper, states.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+			nativeContract := contract.NewAspectNativeContract(artelaApp.AspectKeeper.GetEVMStoreService(),
+				artelaApp.AspectKeeper.GetStoreService(), nil, stateDB, log.NewNopLogger())
+			nativeContract.Init()
+
+			_, _, err := nativeContract.ApplyMessage(ctx, &core.Message{From: testAccount, Data: data}, 1_000_000, true)
+			if tc.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.err)
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/artela-network/artela-rollkit/x/aspect/store"
 	"github.com/artela-network/artela-rollkit/x/aspect/types"
 	statedb "github.com/artela-network/artela-rollkit/x/evm/states"
+	"github.com/artela-network/artela-rollkit/x/evm/txs"
 	inherent "github.com/artela-network/aspect-core/chaincoreext/jit_inherent"
 	artelatypes "github.com/artela-network/aspect-core/types"
 )
@@ -151,6 +152,16 @@ func (c *AspectRuntimeContext) StateDb() vm.StateDB {
 	return c.EthTxContext().stateDB
 }
 
+// AspectHostLogger returns the tracer of the running evm if it records the host calls of the aspects,
+// otherwise nil is returned.
+func (c *AspectRuntimeContext) AspectHostLogger() txs.AspectHostLogger {
+	if c.ethTxContext == nil || c.ethTxContext.lastEvm == nil {
+		return nil
+	}
+	logger, _ := c.ethTxContext.lastEvm.Config.Tracer.(txs.AspectHostLogger)
+	return logger
+}
+
 func (c *AspectRuntimeContext) ClearBlockContext() {
 	if c.ethBlockContext != nil {
 		c.ethBlockContext = nil
@@ -50,6 +50,8 @@ func (k *Keeper) NewEVM(
 		BaseFee:     cfg.BaseFee,
 		Random:      nil, // not supported
 	}
+	// block overrides are only set by eth_call, eth_estimateGas and eth_simulateV1
+	cfg.BlockOverrides.Apply(&blockCtx)
 
 	txCtx := artcore.NewEVMTxContext(msg)
 	if tracer == nil {
@@ -173,6 +175,12 @@ func (k *Keeper) ApplyTransaction(ctx cosmos.Context, tx *ethereum.Transaction)
 		return nil, errorsmod.Wrap(err, "unable to process msg data")
 	}
 
+	// resolve the fee payer before the execution, the same as the one charged in the AnteHandler
+	payer, sponsor, err := k.GetFeePayer(ctx, tx, msg.From)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to resolve the fee payer")
+	}
+
 	// pass true to commit the StateDB
 	res, err := k.ApplyMessageWithConfig(tmpCtx, aspectCtx, msg, nil, true, evmConfig, txConfig, k.isCustomizedVerification(tx))
 	if err != nil {
@@ -232,8 +240,13 @@ func (k *Keeper) ApplyTransaction(ctx cosmos.Context, tx *ethereum.Transaction)
 	}
 
 	// refund gas in order to match the Ethereum gas consumption instead of the default SDK one.
-	if err = k.RefundGas(ctx, msg, msg.GasLimit-res.GasUsed, evmConfig.Params.EvmDenom); err != nil {
-		return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to sender %s", msg.From)
+	if err = k.RefundGas(ctx, msg, payer, sponsor, msg.GasLimit-res.GasUsed, evmConfig.Params.EvmDenom); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to %s", payer)
+	}
+
+	// settle the fees paid for the used gas, they are distributed by the fee module at the end of the block
+	if err = k.settleTxFees(ctx, msg, res.GasUsed, evmConfig.BaseFee, evmConfig.Params.EvmDenom); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to settle tx fees")
 	}
 
 	if len(receipt.Logs) > 0 {
@@ -333,6 +346,9 @@ func (k *Keeper) ApplyMessageWithConfig(ctx cosmos.Context,
 	}
 
 	stateDB := states.New(ctx, k, txConfig)
+	if err := cfg.Overrides.Apply(stateDB); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to apply state overrides")
+	}
 	evm := k.NewEVM(ctx, msg, cfg, tracer, stateDB)
 
 	// Aspect Runtime Context Lifecycle: set EVM params.
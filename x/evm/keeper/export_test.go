@@ -0,0 +1,12 @@
+package keeper
+
+import (
+	"math/big"
+
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/core"
+)
+
+func (k *Keeper) SettleTxFees(ctx cosmos.Context, msg *core.Message, gasUsed uint64, baseFee *big.Int, denom string) error {
+	return k.settleTxFees(ctx, msg, gasUsed, baseFee, denom)
+}
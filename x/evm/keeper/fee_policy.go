@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	authmodule "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/artela-network/artela-rollkit/x/evm/types"
+)
+
+// HasExecutionAspects returns whether the given contract is bound with active aspects executed along with its txs,
+// the txs calling such contracts are charged with the aspect surcharge of the contract fee policy. Only the contract
+// called by the tx is checked, the inner calls into other aspect bound contracts are not surcharged.
+func (k *Keeper) HasExecutionAspects(ctx cosmos.Context, contract common.Address) (bool, error) {
+	return k.aspect.HasExecutionAspects(ctx, contract)
+}
+
+// creditFeeRebates credits the rebates of the fee policy of the given contract to the contract owner, and returns
+// the base fees and the tips left after the rebates. The fees are returned as they are if the contract has no
+// fee policy or no rebate.
+func (k *Keeper) creditFeeRebates(ctx cosmos.Context, contract common.Address, baseFees, tips cosmos.Coins) (cosmos.Coins, cosmos.Coins, error) {
+	policy, found := k.feeKeeper.GetParams(ctx).GetFeePolicy(contract)
+	if !found || !policy.RebateRatio.IsPositive() {
+		return baseFees, tips, nil
+	}
+
+	baseFeeRebates := policy.Rebates(baseFees)
+	tipRebates := policy.Rebates(tips)
+	rebates := baseFeeRebates.Add(tipRebates...)
+	if !rebates.IsAllPositive() {
+		return baseFees, tips, nil
+	}
+
+	owner, err := cosmos.AccAddressFromBech32(policy.Owner)
+	if err != nil {
+		return nil, nil, errorsmod.Wrapf(err, "invalid owner of contract %s", contract)
+	}
+
+	// the rebates are paid from the fee collector module account, which has collected the tx fees
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authmodule.FeeCollectorName, owner, rebates); err != nil {
+		return nil, nil, errorsmod.Wrapf(err, "failed to credit fee rebates %s to the owner of contract %s", rebates, contract)
+	}
+
+	ctx.EventManager().EmitEvent(cosmos.NewEvent(
+		types.EventTypeFeeRebate,
+		cosmos.NewAttribute(types.AttributeKeyContractAddress, contract.Hex()),
+		cosmos.NewAttribute(types.AttributeKeyRecipient, policy.Owner),
+		cosmos.NewAttribute(cosmos.AttributeKeyAmount, rebates.String()),
+	))
+
+	return baseFees.Sub(baseFeeRebates...), tips.Sub(tipRebates...), nil
+}
@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/artela-network/artela-rollkit/testutil/keeper"
+	"github.com/artela-network/artela-rollkit/x/evm/types"
+	feetypes "github.com/artela-network/artela-rollkit/x/fee/types"
+)
+
+func TestSettleTxFeesWithRebates(t *testing.T) {
+	artelaApp, ctx := keepertest.ArtelaApp(t)
+	k := artelaApp.EvmKeeper
+	owner := sdk.AccAddress("contract_owner______")
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(types.DefaultEVMDenom, amount))
+	}
+
+	params := artelaApp.FeeKeeper.GetParams(ctx)
+	params.FeePolicies = []feetypes.FeePolicy{{
+		Contract:        testContract.Hex(),
+		MinGasPrice:     sdkmath.LegacyZeroDec(),
+		AspectSurcharge: sdkmath.LegacyZeroDec(),
+		RebateRatio:     sdkmath.LegacyNewDecWithPrec(1, 1),
+		Owner:           owner.String(),
+	}}
+	require.NoError(t, artelaApp.FeeKeeper.SetParams(ctx, params))
+
+	// the fees of the tx are collected by the fee collector in the ante handler
+	require.NoError(t, artelaApp.BankKeeper.MintCoins(ctx, types.ModuleName, coins(1000)))
+	require.NoError(t, artelaApp.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, coins(1000)))
+
+	testCases := []struct {
+		name     string
+		to       common.Address
+		rebates  int64
+		baseFees int64
+		tips     int64
+	}{
+		// a tenth of both the base fees and the tips is credited to the owner, and only the rest is added to the block fees
+		{"rebates", testContract, 100, 540, 360},
+		{"no fee policy", testCaller, 0, 600, 400},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+
+			// 100 gas used at the gas price 10, of which 6 is the base fee
+			msg := &core.Message{From: testCaller, To: &tc.to, GasPrice: big.NewInt(10)}
+			require.NoError(t, k.SettleTxFees(ctx, msg, 100, big.NewInt(6), types.DefaultEVMDenom))
+
+			require.Equal(t, coins(tc.rebates).String(), artelaApp.BankKeeper.GetAllBalances(ctx, owner).String())
+			feeCollector := artelaApp.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+			require.Equal(t, coins(1000-tc.rebates).String(), artelaApp.BankKeeper.GetAllBalances(ctx, feeCollector).String())
+
+			blockFees := artelaApp.FeeKeeper.GetTransientBlockFees(ctx)
+			require.Equal(t, coins(tc.baseFees).String(), blockFees.BaseFees.String())
+			require.Equal(t, coins(tc.tips).String(), blockFees.Tips.String())
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/artela-network/artela-rollkit/x/evm/types"
+	feemodule "github.com/artela-network/artela-rollkit/x/fee/types"
+)
+
+// GetFeeToken returns the fee token of the given denom whitelisted in the fee module params.
+func (k *Keeper) GetFeeToken(ctx cosmos.Context, denom string) (feemodule.FeeToken, error) {
+	token, found := k.feeKeeper.GetParams(ctx).GetFeeToken(denom)
+	if !found {
+		return feemodule.FeeToken{}, errorsmod.Wrapf(types.ErrInvalidFeeToken, "denom %s is not a whitelisted fee token", denom)
+	}
+	return token, nil
+}
+
+// DeductTxCostsInFeeToken converts the fees in the evm denom to the fee token, and deducts them from the user
+// balance. Returns the converted fees, or an error if the balance of the fee token is not sufficient.
+func (k *Keeper) DeductTxCostsInFeeToken(ctx cosmos.Context, fees cosmos.Coins, token feemodule.FeeToken, from common.Address) (cosmos.Coins, error) {
+	amount := token.ConvertFees(fees.AmountOf(k.GetParams(ctx).EvmDenom))
+	tokenFees := cosmos.NewCoins(cosmos.NewCoin(token.Denom, amount))
+
+	if err := k.DeductTxCostsFromUserBalance(ctx, tokenFees, from); err != nil {
+		return nil, err
+	}
+	return tokenFees, nil
+}
+
+// convertToFeeToken converts the coins in the evm denom to the fee token paying the gas of the current tx,
+// the coins are returned as they are if the gas is paid in the evm denom.
+func convertToFeeToken(ctx cosmos.Context, coins cosmos.Coins, denom string) cosmos.Coins {
+	token, ok := types.FeeTokenFromContext(ctx)
+	if !ok {
+		return coins
+	}
+	return cosmos.NewCoins(cosmos.NewCoin(token.Denom, token.ConvertRefund(coins.AmountOf(denom))))
+}
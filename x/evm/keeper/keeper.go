@@ -102,6 +102,9 @@ func NewKeeper(
 		panic("the EVM module account has not been set")
 	}
 
+	// aspects deployed with protocol v0 are kept in the evm store
+	aspectKeeper.SetEVMStoreService(storeService)
+
 	// init aspect
 	aspect := provider.NewArtelaProvider(storeService, aspectKeeper.GetStoreService(), artvmtype.GetLastBlockHeight(blockGetter))
 	// new Aspect Runtime Context
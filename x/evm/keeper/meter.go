@@ -41,11 +41,12 @@ func (k *Keeper) GetEthIntrinsicGas(ctx cosmos.Context, msg *core.Message, cfg *
 	return intrinsic, nil
 }
 
-// RefundGas transfers the leftover gas to the sender of the message, caped to half of the total gas
+// RefundGas transfers the leftover gas to the fee payer of the message, caped to half of the total gas
 // consumed in the transaction. Additionally, the function sets the total gas consumed to the value
 // returned by the EVM execution, thus ignoring the previous intrinsic gas consumed during in the
-// AnteHandler.
-func (k *Keeper) RefundGas(ctx cosmos.Context, msg *core.Message, leftoverGas uint64, denom string) error {
+// AnteHandler. The fee payer is the sender, unless the fees are sponsored by the paymaster of the given
+// aspect, whose spending limit is given back with the refund. The refund is paid in the same denom as the fees.
+func (k *Keeper) RefundGas(ctx cosmos.Context, msg *core.Message, payer, aspectID common.Address, leftoverGas uint64, denom string) error {
 	// return EVM tokens for remaining gas, exchanged at the original rate.
 	remaining := new(big.Int).Mul(new(big.Int).SetUint64(leftoverGas), msg.GasPrice)
 
@@ -54,16 +55,22 @@ func (k *Keeper) RefundGas(ctx cosmos.Context, msg *core.Message, leftoverGas ui
 		// negative refund errors
 		return errorsmod.Wrapf(types.ErrInvalidRefund, "refunded amount value cannot be negative %d", remaining.Int64())
 	case 1:
-		// positive amount refund
-		refundedCoins := cosmos.Coins{cosmos.NewCoin(denom, sdkmath.NewIntFromBigInt(remaining))}
+		// positive amount refund, paid in the fee token if the tx paid its gas with one
+		refundedCoins := convertToFeeToken(ctx, cosmos.Coins{cosmos.NewCoin(denom, sdkmath.NewIntFromBigInt(remaining))}, denom)
 
-		// refund to sender from the fee collector module account, which is the escrow account in charge of collecting tx fees
+		// refund to the payer from the fee collector module account, which is the escrow account in charge of collecting tx fees
 
-		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authmodule.FeeCollectorName, msg.From.Bytes(), refundedCoins)
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authmodule.FeeCollectorName, payer.Bytes(), refundedCoins)
 		if err != nil {
 			err = errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "fee collector account failed to refund fees: %s", err.Error())
 			return errorsmod.Wrapf(err, "failed to refund %d leftover gas (%s)", leftoverGas, refundedCoins.String())
 		}
+
+		if payer != msg.From {
+			if err := k.refundPaymasterAllowance(ctx, payer, aspectID, remaining); err != nil {
+				return errorsmod.Wrapf(err, "failed to refund the spending limit of paymaster %s", payer)
+			}
+		}
 	default:
 		// no refund, consume gas and update the tx gas meter
 	}
@@ -71,6 +78,37 @@ func (k *Keeper) RefundGas(ctx cosmos.Context, msg *core.Message, leftoverGas ui
 	return nil
 }
 
+// settleTxFees splits the fees paid for the used gas into the base fees and the priority tips, credits the
+// rebates of the fee policy of the called contract to its owner, and adds the rest to the fees collected
+// in the current block.
+func (k *Keeper) settleTxFees(ctx cosmos.Context, msg *core.Message, gasUsed uint64, baseFee *big.Int, denom string) error {
+	gas := new(big.Int).SetUint64(gasUsed)
+	total := new(big.Int).Mul(gas, msg.GasPrice)
+
+	baseFees := new(big.Int)
+	if baseFee != nil {
+		baseFees.Mul(gas, baseFee)
+		if baseFees.Cmp(total) > 0 {
+			baseFees.Set(total)
+		}
+	}
+	tips := new(big.Int).Sub(total, baseFees)
+
+	baseFeeCoins := convertToFeeToken(ctx, cosmos.NewCoins(cosmos.NewCoin(denom, sdkmath.NewIntFromBigInt(baseFees))), denom)
+	tipCoins := convertToFeeToken(ctx, cosmos.NewCoins(cosmos.NewCoin(denom, sdkmath.NewIntFromBigInt(tips))), denom)
+
+	if msg.To != nil {
+		var err error
+		baseFeeCoins, tipCoins, err = k.creditFeeRebates(ctx, *msg.To, baseFeeCoins, tipCoins)
+		if err != nil {
+			return err
+		}
+	}
+
+	k.feeKeeper.AddTransientBlockFees(ctx, baseFeeCoins, tipCoins)
+	return nil
+}
+
 // ResetGasMeterAndConsumeGas reset first the gas meter consumed value to zero and set it back to the new value
 // 'gasUsed'
 func (k *Keeper) ResetGasMeterAndConsumeGas(ctx cosmos.Context, gasUsed uint64) {
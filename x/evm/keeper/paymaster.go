@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"errors"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+
+	aspectstore "github.com/artela-network/artela-rollkit/x/aspect/store"
+	aspectmoduletypes "github.com/artela-network/artela-rollkit/x/aspect/types"
+	"github.com/artela-network/artela-rollkit/x/evm/types"
+)
+
+// GetFeePayer returns the account paying the gas fees of the tx sent by the given sender, together with the
+// aspect sponsoring the fees. The fees of a tx verified by a verifier aspect are sponsored by the paymaster of
+// the aspect, once the paymaster has set a spending limit for the aspect with the aspect system contract.
+// Otherwise, the fees are paid by the sender, and the returned aspect is the zero address.
+func (k *Keeper) GetFeePayer(ctx cosmos.Context, tx *ethereum.Transaction, sender common.Address) (payer, aspectID common.Address, err error) {
+	if !k.isAspectVerified(ctx, tx) {
+		return sender, common.Address{}, nil
+	}
+
+	aspectID, paymaster, err := k.aspect.GetVerifierPayMaster(ctx, *tx.To())
+	if err != nil {
+		return common.Address{}, common.Address{}, errorsmod.Wrapf(err, "failed to load the paymaster of contract %s", tx.To())
+	}
+	if paymaster == (common.Address{}) {
+		return sender, common.Address{}, nil
+	}
+
+	allowance, err := aspectstore.LoadPaymasterAllowance(k.newAspectStoreCtx(ctx), paymaster, aspectID)
+	if err != nil {
+		return common.Address{}, common.Address{}, errorsmod.Wrapf(err,
+			"failed to load the spending limit of paymaster %s for aspect %s", paymaster, aspectID)
+	}
+	if !allowance.Enabled() {
+		return sender, common.Address{}, nil
+	}
+
+	return paymaster, aspectID, nil
+}
+
+// DeductTxCostsFromPaymaster deducts the fees sponsored by the paymaster from its balance, and adds them to the
+// spent of its spending limit for the given aspect. Returns an error if the fees exceed the remaining spending
+// limit, or the paymaster balance is not sufficient.
+func (k *Keeper) DeductTxCostsFromPaymaster(ctx cosmos.Context, fees cosmos.Coins, paymaster, aspectID common.Address) error {
+	amount := fees.AmountOf(k.GetParams(ctx).EvmDenom).BigInt()
+	allowance, err := aspectstore.SpendPaymasterAllowance(k.newAspectStoreCtx(ctx), paymaster, aspectID, amount)
+	if errors.Is(err, aspectstore.ErrPaymasterLimitExceeded) {
+		return errorsmod.Wrapf(types.ErrPaymasterLimitExceeded,
+			"paymaster %s cannot sponsor fees %s for aspect %s (remaining limit %s)", paymaster, fees, aspectID, allowance.Remaining())
+	} else if err != nil {
+		return errorsmod.Wrapf(err, "failed to update the spending limit of paymaster %s", paymaster)
+	}
+
+	if err := k.DeductTxCostsFromUserBalance(ctx, fees, paymaster); err != nil {
+		return errorsmod.Wrapf(err, "paymaster %s failed to sponsor the tx fees", paymaster)
+	}
+
+	return nil
+}
+
+// refundPaymasterAllowance gives the refunded fees back to the spending limit of the paymaster for the given aspect.
+func (k *Keeper) refundPaymasterAllowance(ctx cosmos.Context, paymaster, aspectID common.Address, refund *big.Int) error {
+	_, err := aspectstore.SpendPaymasterAllowance(k.newAspectStoreCtx(ctx), paymaster, aspectID, new(big.Int).Neg(refund))
+	return err
+}
+
+// newAspectStoreCtx returns a gas free aspect store context, the evm gas is not charged for the fee payment.
+func (k *Keeper) newAspectStoreCtx(ctx cosmos.Context) aspectmoduletypes.StoreContext {
+	return aspectmoduletypes.NewGasFreeStoreContext(ctx, k.storeService, k.aspectKeeper.GetStoreService())
+}
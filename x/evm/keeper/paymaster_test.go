@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela-rollkit/app"
+	keepertest "github.com/artela-network/artela-rollkit/testutil/keeper"
+	aspectstore "github.com/artela-network/artela-rollkit/x/aspect/store"
+	"github.com/artela-network/artela-rollkit/x/evm/types"
+)
+
+var (
+	testAspect    = common.HexToAddress("0x0000000000000000000000000000000000000a51")
+	otherAspect   = common.HexToAddress("0x0000000000000000000000000000000000000a52")
+	testPayMaster = common.HexToAddress("0x00000000000000000000000000000000000000f1")
+)
+
+// setupPaymaster returns an app with the paymaster funded with the given balance, and testContract bound
+// with testAspect as verifier, whose paymaster is testPayMaster.
+func setupPaymaster(t *testing.T, balance int64) (*app.App, sdk.Context) {
+	artelaApp, ctx := keepertest.ArtelaApp(t, banktypes.Balance{
+		Address: sdk.AccAddress(testPayMaster.Bytes()).String(),
+		Coins:   sdk.NewCoins(sdk.NewInt64Coin(types.DefaultEVMDenom, balance)),
+	})
+	keepertest.BindVerifierAspect(t, artelaApp, ctx, testContract, testAspect, testPayMaster)
+	return artelaApp, ctx
+}
+
+func paymasterBalance(artelaApp *app.App, ctx sdk.Context) int64 {
+	return artelaApp.BankKeeper.GetBalance(ctx, sdk.AccAddress(testPayMaster.Bytes()), types.DefaultEVMDenom).Amount.Int64()
+}
+
+func TestGetFeePayer(t *testing.T) {
+	artelaApp, ctx := setupPaymaster(t, 1000)
+	k := artelaApp.EvmKeeper
+
+	// the unsigned tx to testContract, whose sender is verified by the aspect
+	verified := ethtypes.NewTx(&ethtypes.LegacyTx{
+		To:       &testContract,
+		Gas:      100_000,
+		GasPrice: big.NewInt(1),
+		Data:     keepertest.AspectVerifiedTxData([]byte("verification")),
+	})
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signed := newSignedMsg(t, key, &ethtypes.LegacyTx{To: &testContract, Gas: 100_000, GasPrice: big.NewInt(1)}).AsTransaction()
+
+	testCases := []struct {
+		name   string
+		tx     *ethtypes.Transaction
+		limits map[common.Address]int64
+		payer  common.Address
+		aspect common.Address
+	}{
+		{"signed tx", signed, map[common.Address]int64{testAspect: 1000}, testCaller, common.Address{}},
+		{"no limit", verified, nil, testCaller, common.Address{}},
+		{"limit of another aspect", verified, map[common.Address]int64{otherAspect: 1000}, testCaller, common.Address{}},
+		{"sponsored", verified, map[common.Address]int64{testAspect: 1000}, testPayMaster, testAspect},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cacheCtx, _ := ctx.CacheContext()
+			for aspectID, limit := range tc.limits {
+				keepertest.SetPaymasterLimit(t, artelaApp, cacheCtx, testPayMaster, aspectID, limit)
+			}
+
+			payer, aspectID, err := k.GetFeePayer(cacheCtx, tc.tx, testCaller)
+			require.NoError(t, err)
+			require.Equal(t, tc.payer, payer)
+			require.Equal(t, tc.aspect, aspectID)
+		})
+	}
+}
+
+func TestDeductTxCostsFromPaymaster(t *testing.T) {
+	artelaApp, ctx := setupPaymaster(t, 1000)
+	k := artelaApp.EvmKeeper
+	fees := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(types.DefaultEVMDenom, amount))
+	}
+
+	keepertest.SetPaymasterLimit(t, artelaApp, ctx, testPayMaster, testAspect, 800)
+	require.NoError(t, k.DeductTxCostsFromPaymaster(ctx, fees(600), testPayMaster, testAspect))
+	require.Equal(t, int64(400), paymasterBalance(artelaApp, ctx))
+
+	allowance, err := aspectstore.LoadPaymasterAllowance(keepertest.AspectStoreContext(artelaApp, ctx), testPayMaster, testAspect)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(600), allowance.Spent)
+
+	// the fees exceed the remaining limit
+	err = k.DeductTxCostsFromPaymaster(ctx, fees(300), testPayMaster, testAspect)
+	require.ErrorIs(t, err, types.ErrPaymasterLimitExceeded)
+	require.Equal(t, int64(400), paymasterBalance(artelaApp, ctx))
+
+	// the fees are within the limit, but exceed the paymaster balance
+	keepertest.SetPaymasterLimit(t, artelaApp, ctx, testPayMaster, testAspect, 2000)
+	err = k.DeductTxCostsFromPaymaster(ctx, fees(500), testPayMaster, testAspect)
+	require.ErrorContains(t, err, "failed to sponsor the tx fees")
+	require.Equal(t, int64(400), paymasterBalance(artelaApp, ctx))
+}
+
+func TestRefundGasToPaymaster(t *testing.T) {
+	artelaApp, ctx := setupPaymaster(t, 1000)
+	k := artelaApp.EvmKeeper
+
+	keepertest.SetPaymasterLimit(t, artelaApp, ctx, testPayMaster, testAspect, 1000)
+	require.NoError(t, k.DeductTxCostsFromPaymaster(ctx,
+		sdk.NewCoins(sdk.NewInt64Coin(types.DefaultEVMDenom, 1000)), testPayMaster, testAspect))
+	require.Zero(t, paymasterBalance(artelaApp, ctx))
+
+	// 400 of the 1000 gas are left, the refund goes back to both the balance and the limit of the paymaster
+	msg := &core.Message{From: testCaller, GasPrice: big.NewInt(1)}
+	require.NoError(t, k.RefundGas(ctx, msg, testPayMaster, testAspect, 400, types.DefaultEVMDenom))
+	require.Equal(t, int64(400), paymasterBalance(artelaApp, ctx))
+
+	allowance, err := aspectstore.LoadPaymasterAllowance(keepertest.AspectStoreContext(artelaApp, ctx), testPayMaster, testAspect)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(600), allowance.Spent)
+	require.Equal(t, big.NewInt(400), allowance.Remaining())
+}
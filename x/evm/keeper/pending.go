@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethereum "github.com/ethereum/go-ethereum/core/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	artela "github.com/artela-network/artela-rollkit/ethereum/types"
+	artelatypes "github.com/artela-network/artela-rollkit/x/evm/artela/types"
+	"github.com/artela-network/artela-rollkit/x/evm/states"
+	"github.com/artela-network/artela-rollkit/x/evm/types"
+)
+
+const (
+	// maxPendingTxs is the maximum number of pending txs processed by a simulation
+	maxPendingTxs = 1000
+	// maxPendingSimulationTime is the maximum time spent on applying the pending txs of a simulation,
+	// the simulation is a query so it doesn't need to be deterministic
+	maxPendingSimulationTime = 2 * time.Second
+)
+
+// SimulatePending speculatively applies the pending txs on top of the latest state,
+// and returns the results of the txs would be included in the next block.
+func (k Keeper) SimulatePending(c context.Context, req *types.QuerySimulatePendingRequest) (*types.QuerySimulatePendingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := cosmos.UnwrapSDKContext(c)
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
+	}
+
+	results := k.applyPendingTxs(ctx, cfg, req.Txs)
+
+	var gasUsed uint64
+	if len(results) > 0 {
+		gasUsed = results[len(results)-1].CumulativeGasUsed
+	}
+
+	return &types.QuerySimulatePendingResponse{
+		Results: results,
+		GasUsed: gasUsed,
+	}, nil
+}
+
+// applyPendingTxs applies the pending txs in order on top of the state of ctx, the state changes
+// are written into ctx. The txs with a nonce gap, not fitting in the block gas limit or failed to
+// apply are skipped, and the fees are not charged. At most maxPendingTxs txs are processed within
+// maxPendingSimulationTime, the rest are skipped. It returns the results of the applied txs,
+// with the cumulative gas used.
+func (k *Keeper) applyPendingTxs(ctx cosmos.Context, cfg *states.EVMConfig, pendingTxs []*types.MsgEthereumTx) []*types.MsgEthereumTxResponse {
+	results := make([]*types.MsgEthereumTxResponse, 0, len(pendingTxs))
+	txConfig := states.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+	gasLimit := artela.BlockGasLimit(ctx)
+	deadline := time.Now().Add(maxPendingSimulationTime)
+
+	var cumulativeGasUsed uint64
+	for i, pendingTx := range pendingTxs {
+		if i == maxPendingTxs || time.Now().After(deadline) {
+			k.logger.Debug("pending txs simulation truncated", "processed", i, "pending", len(pendingTxs))
+			break
+		}
+
+		tx := pendingTx.AsTransaction()
+		if gasLimit > 0 && tx.Gas() > gasLimit-cumulativeGasUsed {
+			k.logger.Debug("skip pending tx", "hash", tx.Hash().Hex(), "error", "exceeds block gas limit")
+			continue
+		}
+		txConfig.TxHash = tx.Hash()
+		txConfig.TxIndex = uint(len(results))
+
+		res, err := k.applyPendingTx(ctx, cfg, txConfig, tx)
+		if err != nil {
+			k.logger.Debug("skip pending tx", "hash", tx.Hash().Hex(), "error", err)
+			continue
+		}
+
+		cumulativeGasUsed += res.GasUsed
+		res.CumulativeGasUsed = cumulativeGasUsed
+		txConfig.LogIndex += uint(len(res.Logs))
+		results = append(results, res)
+	}
+
+	return results
+}
+
+// applyPendingTx applies a single pending tx, the nonce of the sender is increased even if the
+// execution is reverted, as the tx is still included in the block.
+func (k *Keeper) applyPendingTx(ctx cosmos.Context, cfg *states.EVMConfig, txConfig states.TxConfig, tx *ethereum.Transaction) (*types.MsgEthereumTxResponse, error) {
+	signer := k.MakeSigner(ctx, tx, cfg.ChainConfig, big.NewInt(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix()))
+	msg, err := types.ToMessage(tx, signer, cfg.BaseFee)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to return ethereum txs as core message")
+	}
+
+	if nonce := k.GetNonce(ctx, msg.From); nonce != msg.Nonce {
+		return nil, fmt.Errorf("invalid nonce; got %d, expected %d", msg.Nonce, nonce)
+	}
+
+	msg.Data, err = k.processMsgData(tx)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "unable to process msg data")
+	}
+
+	return k.applyIncludedMessage(ctx, cfg, txConfig, tx, msg, k.isCustomizedVerification(tx))
+}
+
+// applyIncludedMessage applies the msg like a tx included in a block on top of the state of ctx. The nonce
+// of the sender is increased like the ante handler does, and kept even if the execution is reverted, the
+// other state changes are written into ctx only if the execution succeeds.
+func (k *Keeper) applyIncludedMessage(ctx cosmos.Context, cfg *states.EVMConfig, txConfig states.TxConfig,
+	tx *ethereum.Transaction, msg *core.Message, isCustomVerification bool,
+) (*types.MsgEthereumTxResponse, error) {
+	account := k.GetAccountOrEmpty(ctx, msg.From)
+	txCtx, commitTx := ctx.CacheContext()
+	account.Nonce++
+	if err := k.SetAccount(txCtx, msg.From, account); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to increase nonce")
+	}
+
+	// Aspect Runtime Context Lifecycle: create aspect context.
+	// This marks the beginning of running an aspect of the included tx, creating the aspect context,
+	// and establishing the link with the SDK context.
+	execCtx, commitExec := txCtx.CacheContext()
+	execCtx, aspectCtx := k.WithAspectContext(execCtx, tx, cfg,
+		artelatypes.NewEthBlockContextFromQuery(execCtx, k.clientContext))
+	defer aspectCtx.Destroy()
+
+	// pass true to commit the StateDB
+	res, err := k.ApplyMessageWithConfig(execCtx, aspectCtx, msg, nil, true, cfg, txConfig, isCustomVerification)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to apply ethereum core message")
+	}
+
+	if !res.Failed() {
+		commitExec()
+	}
+	commitTx()
+
+	return res, nil
+}
@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/artela-network/artela-rollkit/testutil/keeper"
+	"github.com/artela-network/artela-rollkit/x/evm/states"
+	"github.com/artela-network/artela-rollkit/x/evm/types"
+)
+
+func TestSimulatePending(t *testing.T) {
+	artelaApp, ctx := keepertest.ArtelaApp(t)
+	k := artelaApp.EvmKeeper
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	contractAddr := crypto.CreateAddress(from, 0)
+
+	deploy := newSignedMsg(t, key, &ethtypes.LegacyTx{Nonce: 0, Gas: 200_000, GasPrice: big.NewInt(1), Data: deployReturnSlotCode})
+	// skipped for the nonce gap
+	gap := newSignedMsg(t, key, &ethtypes.LegacyTx{Nonce: 2, Gas: 100_000, GasPrice: big.NewInt(1), To: &contractAddr})
+	// skipped for not fitting in the block gas limit
+	tooLarge := newSignedMsg(t, key, &ethtypes.LegacyTx{Nonce: 1, Gas: 2_000_000, GasPrice: big.NewInt(1), To: &contractAddr})
+	// runs on top of the state changed by the deployment
+	call := newSignedMsg(t, key, &ethtypes.LegacyTx{Nonce: 1, Gas: 100_000, GasPrice: big.NewInt(1), To: &contractAddr})
+
+	ctx = ctx.WithBlockGasMeter(storetypes.NewGasMeter(1_000_000))
+	res, err := k.SimulatePending(ctx, &types.QuerySimulatePendingRequest{
+		Txs: []*types.MsgEthereumTx{deploy, gap, tooLarge, call},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Results, 2)
+
+	require.Equal(t, deploy.AsTransaction().Hash().Hex(), res.Results[0].Hash)
+	require.False(t, res.Results[0].Failed())
+	require.Equal(t, call.AsTransaction().Hash().Hex(), res.Results[1].Hash)
+	require.False(t, res.Results[1].Failed())
+	require.Equal(t, common.BigToHash(big.NewInt(0x2a)).Bytes(), res.Results[1].Ret)
+
+	require.Equal(t, res.Results[0].GasUsed, res.Results[0].CumulativeGasUsed)
+	require.Equal(t, res.Results[0].GasUsed+res.Results[1].GasUsed, res.Results[1].CumulativeGasUsed)
+	require.Equal(t, res.Results[1].CumulativeGasUsed, res.GasUsed)
+
+	// the nonce is increased by the applied txs only
+	require.Equal(t, uint64(2), k.GetNonce(ctx, from))
+}
+
+func TestEthCallPendingTxsWithOverrides(t *testing.T) {
+	artelaApp, ctx := keepertest.ArtelaApp(t)
+	k := artelaApp.EvmKeeper
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	contractAddr := crypto.CreateAddress(from, 0)
+	deploy := newSignedMsg(t, key, &ethtypes.LegacyTx{Nonce: 0, Gas: 200_000, GasPrice: big.NewInt(1), Data: deployReturnSlotCode})
+
+	// the nonce override of the deployer would make the pending deployment fail if it was applied to the pending txs
+	nonce := hexutil.Uint64(1)
+	state := map[common.Hash]common.Hash{common.BigToHash(common.Big1): common.BigToHash(big.NewInt(7))}
+	overrides, err := json.Marshal(states.StateOverride{
+		from:         {Nonce: &nonce},
+		contractAddr: {State: &state},
+	})
+	require.NoError(t, err)
+	args, err := json.Marshal(types.TransactionArgs{From: &testCaller, To: &contractAddr})
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockGasMeter(storetypes.NewGasMeter(1_000_000))
+	res, err := k.EthCall(ctx, &types.EthCallRequest{
+		Args:       args,
+		GasCap:     1_000_000,
+		Overrides:  overrides,
+		PendingTxs: []*types.MsgEthereumTx{deploy},
+	})
+	require.NoError(t, err)
+	require.False(t, res.Failed())
+	// the call runs the code deployed by the pending tx, on the overridden state
+	require.Equal(t, common.BigToHash(big.NewInt(7)).Bytes(), res.Ret)
+}
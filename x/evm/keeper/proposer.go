@@ -1,24 +1,15 @@
 package keeper
 
 import (
-	errorsmod "cosmossdk.io/errors"
 	cosmos "github.com/cosmos/cosmos-sdk/types"
-	stakingmodule "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/common"
+
+	artela "github.com/artela-network/artela-rollkit/ethereum/types"
 )
 
 // GetProposerAddress returns the block proposer's validator operator address.
 func (k Keeper) GetProposerAddress(ctx cosmos.Context, proposerAddress cosmos.ConsAddress) (common.Address, error) {
-	validator, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, GetProposerAddress(ctx, proposerAddress))
-	if err != nil {
-		return common.Address{}, errorsmod.Wrapf(
-			stakingmodule.ErrNoValidatorFound,
-			"failed to retrieve validator from block proposer address %s",
-			proposerAddress.String(),
-		)
-	}
-
-	valAddress, err := cosmos.ValAddressFromBech32(validator.GetOperator())
+	valAddress, err := artela.GetProposerOperator(ctx, k.stakingKeeper, proposerAddress)
 	if err != nil {
 		return common.Address{}, err
 	}
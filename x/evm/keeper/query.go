@@ -17,6 +17,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
 	ethereum "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	ethparams "github.com/ethereum/go-ethereum/params"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -32,6 +33,10 @@ var _ types.QueryServer = Keeper{}
 
 const (
 	defaultTraceTimeout = 5 * time.Second
+
+	// maxAccessListIterations bounds the runs of eth_createAccessList, the access list of a call
+	// accessing storage depending on its remaining gas can grow on every run and never converge
+	maxAccessListIterations = 16
 )
 
 func (k Keeper) Account(c context.Context, req *types.QueryAccountRequest) (*types.QueryAccountResponse, error) {
@@ -219,9 +224,17 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	// the state of the query context is discarded after the query, so the pending txs can be applied on it directly,
+	// they are applied before the overrides are set, which only apply to the call
+	if len(req.PendingTxs) > 0 {
+		k.applyPendingTxs(ctx, cfg, req.PendingTxs)
+	}
+	if err := setCallOverrides(cfg, req.Overrides, req.BlockOverrides); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	// ApplyMessageWithConfig expect correct nonce set in msg
-	nonce := k.GetNonce(ctx, args.GetFrom())
+	nonce := k.callNonce(ctx, cfg, args.GetFrom())
 	args.Nonce = (*hexutil.Uint64)(&nonce)
 
 	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
@@ -299,9 +312,12 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to load evm config")
 	}
+	if err := setCallOverrides(cfg, req.Overrides, req.BlockOverrides); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	// ApplyMessageWithConfig expect correct nonce set in msg
-	nonce := k.GetNonce(ctx, args.GetFrom())
+	nonce := k.callNonce(ctx, cfg, args.GetFrom())
 	args.Nonce = (*hexutil.Uint64)(&nonce)
 
 	txConfig := states.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
@@ -368,6 +384,106 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 	return &types.EstimateGasResponse{Gas: hi}, nil
 }
 
+// CreateAccessList implements the `eth_createAccessList` rpc api. The message is applied repeatedly
+// with the access list collected by the previous run, until the access list no longer changes.
+func (k Keeper) CreateAccessList(c context.Context, req *types.EthCallRequest) (*types.CreateAccessListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := cosmos.UnwrapSDKContext(c)
+
+	var args types.TransactionArgs
+	err := json.Unmarshal(req.Args, &args)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := setCallOverrides(cfg, req.Overrides, req.BlockOverrides); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	from := args.GetFrom()
+	nonce := k.callNonce(ctx, cfg, from)
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	// the created contract is the destination of contract creation
+	var to common.Address
+	if args.To != nil {
+		to = *args.To
+	} else {
+		to = crypto.CreateAddress(from, nonce)
+	}
+
+	// precompiles are always warm, so they are not added to the access list
+	rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), cfg.ChainConfig.MergeNetsplitBlock != nil, uint64(ctx.BlockTime().Unix()))
+	precompiles := vm.ActivePrecompiles(rules)
+
+	var accessList ethereum.AccessList
+	if args.AccessList != nil {
+		accessList = *args.AccessList
+	}
+	prevTracer := logger.NewAccessListTracer(accessList, from, to, precompiles)
+
+	txConfig := states.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+	isCustomVerification := len(args.GetValidationData()) > 0
+
+	apply := func(tracer *logger.AccessListTracer) (*types.MsgEthereumTxResponse, error) {
+		msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		// need to create a cache context here to avoid state change affecting each other
+		tmpCtx, _ := ctx.CacheContext()
+		// Aspect Runtime Context Lifecycle: create aspect context.
+		// This marks the beginning of running an aspect of CreateAccessList, creating the aspect context,
+		// and establishing the link with the SDK context.
+		cosmosCtx, aspectCtx := k.WithAspectContext(tmpCtx, args.ToTransaction().AsEthCallTransaction(), cfg,
+			artelatypes.NewEthBlockContextFromQuery(tmpCtx, k.clientContext))
+		defer aspectCtx.Destroy()
+
+		// pass false to not commit StateDB
+		rsp, err := k.ApplyMessageWithConfig(cosmosCtx, aspectCtx, msg, tracer, false, cfg, txConfig, isCustomVerification)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return rsp, nil
+	}
+
+	for i := 0; i < maxAccessListIterations; i++ {
+		// expand the access list collected by the previous run
+		accessList = prevTracer.AccessList()
+		args.AccessList = &accessList
+
+		tracer := logger.NewAccessListTracer(accessList, from, to, precompiles)
+		rsp, err := apply(tracer)
+		if err != nil {
+			return nil, err
+		}
+
+		if tracer.Equal(prevTracer) {
+			return &types.CreateAccessListResponse{
+				AccessList: types.NewAccessList(&accessList),
+				GasUsed:    rsp.GasUsed,
+				VmError:    rsp.VmError,
+			}, nil
+		}
+		prevTracer = tracer
+	}
+
+	return nil, status.Errorf(codes.Internal, "access list did not converge after %d runs", maxAccessListIterations)
+}
+
 func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*types.QueryTraceTxResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -514,6 +630,79 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 	}, nil
 }
 
+// TraceCall implements the `debug_traceCall` rpc api, it traces an unsigned call on top of the
+// state of the requested block, with the state and block overrides applied.
+func (k Keeper) TraceCall(c context.Context, req *types.QueryTraceCallRequest) (*types.QueryTraceCallResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.TraceConfig != nil && req.TraceConfig.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "output limit cannot be negative, got %d", req.TraceConfig.Limit)
+	}
+
+	ctx := cosmos.UnwrapSDKContext(c)
+
+	var args types.TransactionArgs
+	err := json.Unmarshal(req.Args, &args)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
+	}
+	if err := setCallOverrides(cfg, req.Overrides, req.BlockOverrides); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	nonce := k.callNonce(ctx, cfg, args.GetFrom())
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var tracerConfig json.RawMessage
+	if req.TraceConfig != nil && req.TraceConfig.TracerJsonConfig != "" {
+		// ignore error. default to no traceConfig
+		_ = json.Unmarshal([]byte(req.TraceConfig.TracerJsonConfig), &tracerConfig)
+	}
+
+	// need to create a cache context here to avoid state change affecting the query context
+	tmpCtx, _ := ctx.CacheContext()
+	// Aspect Runtime Context Lifecycle: create aspect context.
+	// This marks the beginning of running an aspect of TraceCall, creating the aspect context,
+	// and establishing the link with the SDK context.
+	cosmosCtx, aspectCtx := k.WithAspectContext(tmpCtx, args.ToTransaction().AsEthCallTransaction(), cfg,
+		artelatypes.NewEthBlockContextFromQuery(tmpCtx, k.clientContext))
+	defer aspectCtx.Destroy()
+
+	txConfig := states.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+	isCustomVerification := len(args.GetValidationData()) > 0
+	result, _, err := k.traceMsg(cosmosCtx, aspectCtx, cfg, txConfig, msg, req.TraceConfig, false, tracerConfig, isCustomVerification)
+	if err != nil {
+		// error will be returned with detail status from traceMsg
+		return nil, err
+	}
+
+	resultData, err := json.Marshal(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTraceCallResponse{
+		Data: resultData,
+	}, nil
+}
+
 // traceTx do trace on one txs, it returns a tuple: (traceResult, nextLogIndex, error).
 func (k *Keeper) traceTx(
 	ctx cosmos.Context,
@@ -525,14 +714,6 @@ func (k *Keeper) traceTx(
 	commitMessage bool,
 	tracerJSONConfig json.RawMessage,
 ) (*interface{}, uint, error) {
-	// Assemble the structured logger or the JavaScript tracer
-	var (
-		tracer    tracers.Tracer
-		overrides *ethparams.ChainConfig
-		err       error
-		timeout   = defaultTraceTimeout
-	)
-
 	// Aspect Runtime Context Lifecycle: create aspect context.
 	// This marks the beginning of running an aspect of TraceBlock or TraceTx, creating the aspect context,
 	// and establishing the link with the SDK context.
@@ -551,6 +732,31 @@ func (k *Keeper) traceTx(
 		return nil, 0, status.Error(codes.Internal, err.Error())
 	}
 
+	isCustomVerification := k.isCustomizedVerification(tx)
+	return k.traceMsg(ctx, aspectCtx, cfg, txConfig, msg, traceConfig, commitMessage, tracerJSONConfig, isCustomVerification)
+}
+
+// traceMsg do trace on one message with the tracer set by the trace config,
+// it returns a tuple: (traceResult, nextLogIndex, error).
+func (k *Keeper) traceMsg(
+	ctx cosmos.Context,
+	aspectCtx *artelatypes.AspectRuntimeContext,
+	cfg *states.EVMConfig,
+	txConfig states.TxConfig,
+	msg *core.Message,
+	traceConfig *types.TraceConfig,
+	commitMessage bool,
+	tracerJSONConfig json.RawMessage,
+	isCustomVerification bool,
+) (*interface{}, uint, error) {
+	// Assemble the structured logger or the JavaScript tracer
+	var (
+		tracer    tracers.Tracer
+		overrides *ethparams.ChainConfig
+		err       error
+		timeout   = defaultTraceTimeout
+	)
+
 	if traceConfig == nil {
 		traceConfig = &types.TraceConfig{}
 	}
@@ -601,7 +807,6 @@ func (k *Keeper) traceTx(
 		}
 	}()
 
-	isCustomVerification := k.isCustomizedVerification(tx)
 	res, err := k.ApplyMessageWithConfig(ctx, aspectCtx, msg, tracer, commitMessage, cfg, txConfig, isCustomVerification)
 	if err != nil {
 		return nil, 0, status.Error(codes.Internal, err.Error())
@@ -677,3 +882,32 @@ func getChainID(ctx cosmos.Context, chainID int64) (*big.Int, error) {
 	}
 	return big.NewInt(chainID), nil
 }
+
+// setCallOverrides decodes the state and block overrides of eth_call and eth_estimateGas into the evm config.
+func setCallOverrides(cfg *states.EVMConfig, stateOverrides, blockOverridesBz []byte) error {
+	if len(stateOverrides) > 0 {
+		var overrides states.StateOverride
+		if err := json.Unmarshal(stateOverrides, &overrides); err != nil {
+			return fmt.Errorf("invalid state overrides: %w", err)
+		}
+		cfg.Overrides = &overrides
+	}
+
+	if len(blockOverridesBz) > 0 {
+		var blockOverrides states.BlockOverrides
+		if err := json.Unmarshal(blockOverridesBz, &blockOverrides); err != nil {
+			return fmt.Errorf("invalid block overrides: %w", err)
+		}
+		cfg.BlockOverrides = &blockOverrides
+	}
+
+	return nil
+}
+
+// callNonce returns the nonce of the caller, taking the state overrides into account.
+func (k Keeper) callNonce(ctx cosmos.Context, cfg *states.EVMConfig, from common.Address) uint64 {
+	if nonce, ok := cfg.Overrides.Nonce(from); ok {
+		return nonce
+	}
+	return k.GetNonce(ctx, from)
+}
@@ -0,0 +1,212 @@
+package keeper_test
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/artela-network/artela-evm/vm"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	artela "github.com/artela-network/artela-rollkit/ethereum/types"
+	keepertest "github.com/artela-network/artela-rollkit/testutil/keeper"
+	"github.com/artela-network/artela-rollkit/x/evm/states"
+	"github.com/artela-network/artela-rollkit/x/evm/types"
+)
+
+var (
+	testCaller   = common.HexToAddress("0x00000000000000000000000000000000000000a1")
+	testContract = common.HexToAddress("0x00000000000000000000000000000000000000c1")
+)
+
+// callRequest builds the request of a call from testCaller to testContract, which runs the given code.
+func callRequest(t *testing.T, code []byte) *types.EthCallRequest {
+	args, err := json.Marshal(types.TransactionArgs{From: &testCaller, To: &testContract})
+	require.NoError(t, err)
+	overrides, err := json.Marshal(states.StateOverride{testContract: {Code: (*hexutil.Bytes)(&code)}})
+	require.NoError(t, err)
+
+	return &types.EthCallRequest{Args: args, GasCap: 1_000_000, Overrides: overrides}
+}
+
+func TestCreateAccessList(t *testing.T) {
+	artelaApp, ctx := keepertest.ArtelaApp(t)
+	k := artelaApp.EvmKeeper
+	slot := common.BigToHash(common.Big1)
+
+	testCases := []struct {
+		name    string
+		code    []byte
+		vmError string
+	}{
+		// PUSH1 1 SLOAD STOP
+		{"sload", []byte{0x60, 0x01, 0x54, 0x00}, ""},
+		// PUSH1 1 SLOAD PUSH1 0 PUSH1 0 REVERT
+		{"revert", []byte{0x60, 0x01, 0x54, 0x60, 0x00, 0x60, 0x00, 0xfd}, vm.ErrExecutionReverted.Error()},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := k.CreateAccessList(ctx, callRequest(t, tc.code))
+			require.NoError(t, err)
+			require.Equal(t, tc.vmError, res.VmError)
+			require.Equal(t, types.AccessList{{Address: testContract.Hex(), StorageKeys: []string{slot.Hex()}}}, res.AccessList)
+			require.NotZero(t, res.GasUsed)
+		})
+	}
+}
+
+func TestCreateAccessListNotConverging(t *testing.T) {
+	artelaApp, ctx := keepertest.ArtelaApp(t)
+
+	// GAS SLOAD STOP, every slot added to the access list raises the intrinsic gas,
+	// so the slot loaded by the next run is never in the access list
+	_, err := artelaApp.EvmKeeper.CreateAccessList(ctx, callRequest(t, []byte{0x5a, 0x54, 0x00}))
+	require.ErrorContains(t, err, "access list did not converge")
+}
+
+var (
+	// PUSH1 1 SLOAD PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	returnSlotCode = []byte{0x60, 0x01, 0x54, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3}
+	// NUMBER PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	returnNumberCode = []byte{0x43, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3}
+	// PUSH1 0x2a PUSH1 1 SSTORE STOP
+	storeSlotCode = []byte{0x60, 0x2a, 0x60, 0x01, 0x55, 0x00}
+	// deploys returnSlotCode with the slot 1 set to 0x2a by the constructor:
+	// PUSH1 0x2a PUSH1 1 SSTORE PUSH1 11 PUSH1 17 PUSH1 0 CODECOPY PUSH1 11 PUSH1 0 RETURN
+	deployReturnSlotCode = append([]byte{
+		0x60, 0x2a, 0x60, 0x01, 0x55,
+		0x60, 0x0b, 0x60, 0x11, 0x60, 0x00, 0x39,
+		0x60, 0x0b, 0x60, 0x00, 0xf3,
+	}, returnSlotCode...)
+)
+
+// newSignedMsg signs the tx with the key for the test chain, and returns it as a MsgEthereumTx.
+func newSignedMsg(t *testing.T, key *ecdsa.PrivateKey, txData ethtypes.TxData) *types.MsgEthereumTx {
+	chainID, err := artela.ParseChainID(keepertest.TestChainID)
+	require.NoError(t, err)
+	tx, err := ethtypes.SignNewTx(key, ethtypes.LatestSignerForChainID(chainID), txData)
+	require.NoError(t, err)
+
+	msg := &types.MsgEthereumTx{}
+	require.NoError(t, msg.FromEthereumTx(tx))
+	msg.From = crypto.PubkeyToAddress(key.PublicKey).Hex()
+	return msg
+}
+
+// traceResult decodes the result of a trace into a json object.
+func traceResult(t *testing.T, data []byte) map[string]interface{} {
+	var result map[string]interface{}
+	require.NoError(t, json.Unmarshal(data, &result))
+	return result
+}
+
+func TestTraceCall(t *testing.T) {
+	artelaApp, ctx := keepertest.ArtelaApp(t)
+	k := artelaApp.EvmKeeper
+
+	args, err := json.Marshal(types.TransactionArgs{From: &testCaller, To: &testContract})
+	require.NoError(t, err)
+	overrides := func(code []byte, state map[common.Hash]common.Hash) []byte {
+		account := states.OverrideAccount{Code: (*hexutil.Bytes)(&code)}
+		if state != nil {
+			account.State = &state
+		}
+		bz, err := json.Marshal(states.StateOverride{testContract: account})
+		require.NoError(t, err)
+		return bz
+	}
+	blockOverrides, err := json.Marshal(states.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(0x1234))})
+	require.NoError(t, err)
+	slot := common.BigToHash(common.Big1)
+
+	t.Run("state overrides", func(t *testing.T) {
+		res, err := k.TraceCall(ctx, &types.QueryTraceCallRequest{
+			Args:        args,
+			GasCap:      1_000_000,
+			Overrides:   overrides(returnSlotCode, map[common.Hash]common.Hash{slot: common.BigToHash(big.NewInt(0x2a))}),
+			TraceConfig: &types.TraceConfig{Tracer: "callTracer"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, hexutil.Encode(common.BigToHash(big.NewInt(0x2a)).Bytes()), traceResult(t, res.Data)["output"])
+	})
+
+	t.Run("block overrides", func(t *testing.T) {
+		res, err := k.TraceCall(ctx, &types.QueryTraceCallRequest{
+			Args:           args,
+			GasCap:         1_000_000,
+			Overrides:      overrides(returnNumberCode, nil),
+			BlockOverrides: blockOverrides,
+			TraceConfig:    &types.TraceConfig{Tracer: "callTracer"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, hexutil.Encode(common.BigToHash(big.NewInt(0x1234)).Bytes()), traceResult(t, res.Data)["output"])
+	})
+
+	t.Run("tracer config", func(t *testing.T) {
+		res, err := k.TraceCall(ctx, &types.QueryTraceCallRequest{
+			Args:        args,
+			GasCap:      1_000_000,
+			Overrides:   overrides(storeSlotCode, nil),
+			TraceConfig: &types.TraceConfig{Tracer: "prestateTracer", TracerJsonConfig: `{"diffMode": true}`},
+		})
+		require.NoError(t, err)
+		// the prestate tracer only splits the result into pre and post states in diff mode
+		result := traceResult(t, res.Data)
+		require.Contains(t, result, "pre")
+		require.Contains(t, result, "post")
+	})
+
+	t.Run("struct logger", func(t *testing.T) {
+		res, err := k.TraceCall(ctx, &types.QueryTraceCallRequest{
+			Args:      args,
+			GasCap:    1_000_000,
+			Overrides: overrides(storeSlotCode, nil),
+		})
+		require.NoError(t, err)
+		result := traceResult(t, res.Data)
+		require.Equal(t, false, result["failed"])
+		require.Len(t, result["structLogs"], 4)
+	})
+
+	t.Run("negative limit", func(t *testing.T) {
+		_, err := k.TraceCall(ctx, &types.QueryTraceCallRequest{
+			Args:        args,
+			GasCap:      1_000_000,
+			TraceConfig: &types.TraceConfig{Limit: -1},
+		})
+		require.ErrorContains(t, err, "output limit cannot be negative")
+	})
+}
+
+func TestTraceTx(t *testing.T) {
+	artelaApp, ctx := keepertest.ArtelaApp(t)
+	k := artelaApp.EvmKeeper
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+
+	// the predecessor deploys the contract called by the traced tx
+	deploy := newSignedMsg(t, key, &ethtypes.LegacyTx{Nonce: 0, Gas: 200_000, GasPrice: big.NewInt(1), Data: deployReturnSlotCode})
+	contractAddr := crypto.CreateAddress(from, 0)
+	call := newSignedMsg(t, key, &ethtypes.LegacyTx{Nonce: 1, Gas: 100_000, GasPrice: big.NewInt(1), To: &contractAddr})
+
+	res, err := k.TraceTx(ctx, &types.QueryTraceTxRequest{
+		Msg:          call,
+		Predecessors: []*types.MsgEthereumTx{deploy},
+		BlockNumber:  ctx.BlockHeight(),
+		BlockTime:    ctx.BlockTime(),
+		TraceConfig:  &types.TraceConfig{Tracer: "callTracer"},
+	})
+	require.NoError(t, err)
+
+	// the traced tx runs on top of the state changed by its predecessors
+	result := traceResult(t, res.Data)
+	require.Equal(t, hexutil.Encode(common.BigToHash(big.NewInt(0x2a)).Bytes()), result["output"])
+	require.NotContains(t, result, "error")
+}
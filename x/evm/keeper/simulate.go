@@ -0,0 +1,228 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	gomath "math"
+	"math/big"
+
+	"cosmossdk.io/math"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	artela "github.com/artela-network/artela-rollkit/ethereum/types"
+	"github.com/artela-network/artela-rollkit/x/evm/states"
+	"github.com/artela-network/artela-rollkit/x/evm/types"
+)
+
+// maxSimulateBlocks is the max number of blocks simulated in a single request, the same as geth.
+const maxSimulateBlocks = 256
+
+// simulateBlock is a block of calls of eth_simulateV1, the overrides are applied before the calls.
+type simulateBlock struct {
+	BlockOverrides *states.BlockOverrides  `json:"blockOverrides"`
+	StateOverrides *states.StateOverride   `json:"stateOverrides"`
+	Calls          []types.TransactionArgs `json:"calls"`
+}
+
+// SimulateV1 simulates the blocks of calls on top of the state of the requested block. The calls are
+// applied like the txs included in the blocks, so the state changes of the overrides and the calls are
+// visible to the later calls and blocks. The gas fees are checked in validation mode, but never charged.
+func (k Keeper) SimulateV1(c context.Context, req *types.QuerySimulateV1Request) (*types.QuerySimulateV1Response, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var blocks []simulateBlock
+	if err := json.Unmarshal(req.Blocks, &blocks); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid block state calls: %s", err.Error())
+	}
+	if len(blocks) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty input")
+	}
+	if len(blocks) > maxSimulateBlocks {
+		return nil, status.Errorf(codes.InvalidArgument, "too many blocks; got %d, max %d", len(blocks), maxSimulateBlocks)
+	}
+
+	ctx := cosmos.UnwrapSDKContext(c)
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
+	}
+
+	// the state of the query context is discarded after the query, so the calls are committed into it directly
+	number, time := uint64(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix()) // #nosec G701
+	gasLeft := req.GasCap
+	if gasLeft == 0 {
+		// the same default gas as the calls without a gas cap
+		gasLeft = gomath.MaxUint64 / 2
+	}
+	results := make([]*types.SimulatedBlock, 0, len(blocks))
+	for i := range blocks {
+		header, err := simulateHeader(ctx, cfg, blocks[i].BlockOverrides, number, time, req.Validation)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "block %d: %s", i, err.Error())
+		}
+		number, time = header.Number.ToInt().Uint64(), uint64(*header.Time)
+
+		result, err := k.simulateBlock(ctx, cfg, header, &blocks[i], &gasLeft, req.Validation)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "block %d: %s", i, err.Error())
+		}
+		results = append(results, result)
+	}
+
+	return &types.QuerySimulateV1Response{Blocks: results}, nil
+}
+
+// simulateHeader resolves the header fields of a simulated block, the fields not overridden are derived
+// from the previous block. The base fee is zero unless overridden if the validation is disabled, so the
+// calls without the gas price are still able to be executed.
+func simulateHeader(ctx cosmos.Context, cfg *states.EVMConfig, overrides *states.BlockOverrides,
+	prevNumber, prevTime uint64, validation bool,
+) (*states.BlockOverrides, error) {
+	var header states.BlockOverrides
+	if overrides != nil {
+		header = *overrides
+	}
+
+	if header.Number == nil {
+		header.Number = (*hexutil.Big)(new(big.Int).SetUint64(prevNumber + 1))
+	} else if number := header.Number.ToInt(); !number.IsUint64() || number.Uint64() <= prevNumber {
+		return nil, fmt.Errorf("block numbers must be in order: %s <= %d", number, prevNumber)
+	}
+
+	if header.Time == nil {
+		time := hexutil.Uint64(prevTime + 1)
+		header.Time = &time
+	} else if uint64(*header.Time) <= prevTime {
+		return nil, fmt.Errorf("block timestamps must be in order: %d <= %d", uint64(*header.Time), prevTime)
+	}
+
+	if header.GasLimit == nil {
+		gasLimit := hexutil.Uint64(artela.BlockGasLimit(ctx))
+		header.GasLimit = &gasLimit
+	}
+	if header.Coinbase == nil {
+		header.Coinbase = &cfg.CoinBase
+	}
+	if header.BaseFee == nil && cfg.BaseFee != nil {
+		baseFee := new(big.Int)
+		if validation {
+			baseFee.Set(cfg.BaseFee)
+		}
+		header.BaseFee = (*hexutil.Big)(baseFee)
+	}
+
+	return &header, nil
+}
+
+// simulateBlock applies the state overrides and the calls of a simulated block in order, gasLeft is the
+// gas left for the rest of the simulation, it's decreased by the gas used of the calls.
+func (k *Keeper) simulateBlock(ctx cosmos.Context, cfg *states.EVMConfig, header *states.BlockOverrides,
+	block *simulateBlock, gasLeft *uint64, validation bool,
+) (*types.SimulatedBlock, error) {
+	blockCfg := *cfg
+	blockCfg.BlockOverrides = header
+	blockCfg.BaseFee = header.BaseFee.ToInt()
+
+	// the state overrides are applied only once at the beginning of the block, instead of before each call
+	blockCfg.Overrides = nil
+	txConfig := states.NewEmptyTxConfig(common.Hash{})
+	if block.StateOverrides != nil {
+		stateDB := states.New(ctx, k, txConfig)
+		if err := block.StateOverrides.Apply(stateDB); err != nil {
+			return nil, err
+		}
+		if err := stateDB.Commit(); err != nil {
+			return nil, fmt.Errorf("failed to commit state overrides: %w", err)
+		}
+	}
+
+	result := &types.SimulatedBlock{
+		Number:   header.Number.ToInt().Uint64(),
+		Time:     uint64(*header.Time),
+		GasLimit: uint64(*header.GasLimit),
+		Coinbase: header.Coinbase.Hex(),
+		Calls:    make([]*types.MsgEthereumTxResponse, 0, len(block.Calls)),
+	}
+	if blockCfg.BaseFee != nil {
+		baseFee := math.NewIntFromBigInt(blockCfg.BaseFee)
+		result.BaseFee = &baseFee
+	}
+
+	for i := range block.Calls {
+		args := &block.Calls[i]
+
+		// the gas of a call is limited by both the gas left of the block and the simulation
+		if *gasLeft == 0 {
+			return nil, fmt.Errorf("call %d: gas cap reached", i)
+		}
+		callGasCap := *gasLeft
+		if result.GasLimit > 0 {
+			if result.GasUsed >= result.GasLimit {
+				return nil, fmt.Errorf("call %d: block gas limit reached", i)
+			}
+			callGasCap = min(callGasCap, result.GasLimit-result.GasUsed)
+		}
+
+		nonce := k.GetNonce(ctx, args.GetFrom())
+		if args.Nonce == nil {
+			args.Nonce = (*hexutil.Uint64)(&nonce)
+		} else if validation && uint64(*args.Nonce) != nonce {
+			return nil, fmt.Errorf("call %d: invalid nonce; got %d, expected %d", i, uint64(*args.Nonce), nonce)
+		}
+
+		msg, err := args.ToMessage(callGasCap, blockCfg.BaseFee)
+		if err != nil {
+			return nil, fmt.Errorf("call %d: %w", i, err)
+		}
+		if validation {
+			if err := k.validateSimulatedCall(ctx, &blockCfg, args.GetFrom(), msg.GasFeeCap, msg.GasLimit, msg.Value); err != nil {
+				return nil, fmt.Errorf("call %d: %w", i, err)
+			}
+		}
+
+		tx := args.ToTransaction().AsEthCallTransaction()
+		txConfig.TxHash = tx.Hash()
+		txConfig.TxIndex = uint(i)
+
+		res, err := k.applyIncludedMessage(ctx, &blockCfg, txConfig, tx, msg, len(args.GetValidationData()) > 0)
+		if err != nil {
+			return nil, fmt.Errorf("call %d: %w", i, err)
+		}
+
+		result.GasUsed += res.GasUsed
+		res.CumulativeGasUsed = result.GasUsed
+		txConfig.LogIndex += uint(len(res.Logs))
+		*gasLeft -= min(res.GasUsed, *gasLeft)
+		result.Calls = append(result.Calls, res)
+	}
+
+	return result, nil
+}
+
+// validateSimulatedCall checks the fee cap and the balance of the sender like the real txs do.
+func (k *Keeper) validateSimulatedCall(ctx cosmos.Context, cfg *states.EVMConfig, from common.Address,
+	gasFeeCap *big.Int, gasLimit uint64, value *big.Int,
+) error {
+	if cfg.BaseFee != nil && gasFeeCap.Cmp(cfg.BaseFee) < 0 {
+		return fmt.Errorf("max fee per gas less than block base fee: maxFeePerGas: %s, baseFee: %s", gasFeeCap, cfg.BaseFee)
+	}
+
+	cost := new(big.Int).Mul(gasFeeCap, new(big.Int).SetUint64(gasLimit))
+	cost.Add(cost, value)
+	if balance := k.GetBalance(ctx, from); balance.Cmp(cost) < 0 {
+		return fmt.Errorf("insufficient funds for gas * price + value: address %s have %s want %s", from.Hex(), balance, cost)
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/artela-network/artela-rollkit/testutil/keeper"
+	"github.com/artela-network/artela-rollkit/x/evm/states"
+	"github.com/artela-network/artela-rollkit/x/evm/types"
+)
+
+func TestSimulateV1StateOverrides(t *testing.T) {
+	artelaApp, ctx := keepertest.ArtelaApp(t)
+	k := artelaApp.EvmKeeper
+
+	slot1, slot2 := common.BigToHash(big.NewInt(1)), common.BigToHash(big.NewInt(2))
+	k.SetState(ctx, testContract, slot1, common.BigToHash(big.NewInt(0x11)).Bytes())
+	k.SetState(ctx, testContract, slot2, common.BigToHash(big.NewInt(0x22)).Bytes())
+
+	// PUSH1 2 SLOAD PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	returnSlot2Code := hexutil.Bytes{0x60, 0x02, 0x54, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3}
+	returnSlot1Code := hexutil.Bytes(returnSlotCode)
+	state := map[common.Hash]common.Hash{slot1: common.BigToHash(big.NewInt(0x2a))}
+	calls := []types.TransactionArgs{{From: &testCaller, To: &testContract}}
+
+	blocks, err := json.Marshal([]map[string]interface{}{
+		{
+			// the state override replaces the whole storage of the contract
+			"stateOverrides": states.StateOverride{testContract: {Code: &returnSlot1Code, State: &state}},
+			"calls":          calls,
+		},
+		{
+			// the storage replaced in the previous block is kept
+			"stateOverrides": states.StateOverride{testContract: {Code: &returnSlot2Code}},
+			"calls":          calls,
+		},
+	})
+	require.NoError(t, err)
+
+	res, err := k.SimulateV1(ctx, &types.QuerySimulateV1Request{Blocks: blocks})
+	require.NoError(t, err)
+	require.Len(t, res.Blocks, 2)
+
+	require.Len(t, res.Blocks[0].Calls, 1)
+	require.Empty(t, res.Blocks[0].Calls[0].VmError)
+	require.Equal(t, common.BigToHash(big.NewInt(0x2a)).Bytes(), res.Blocks[0].Calls[0].Ret)
+
+	require.Len(t, res.Blocks[1].Calls, 1)
+	require.Empty(t, res.Blocks[1].Calls[0].VmError)
+	require.Equal(t, common.Hash{}.Bytes(), res.Blocks[1].Calls[0].Ret)
+}
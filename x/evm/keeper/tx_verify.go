@@ -19,14 +19,10 @@ import (
 )
 
 func (k *Keeper) VerifySig(ctx cosmos.Context, tx *ethereum.Transaction) (common.Address, []byte, error) {
-	// tx without signature
-	txConfig := k.TxConfig(ctx, tx.Hash(), tx.Type())
-	stateDB := states.New(ctx, k, txConfig)
-
 	// calling a contract without signature, verify with aspect
 	// this verification method is only allowed in call contract,
 	// transactions that transfer value or creating contract must be signed
-	if k.isCustomizedVerification(tx) && (stateDB.GetCodeHash(*tx.To()) != common.Hash{}) {
+	if k.isAspectVerified(ctx, tx) {
 		return k.tryAspectVerifier(ctx, tx)
 	}
 
@@ -92,9 +88,7 @@ func (k *Keeper) tryAspectVerifier(ctx cosmos.Context, tx *ethereum.Transaction)
 }
 
 func (k *Keeper) MakeSigner(ctx cosmos.Context, tx *ethereum.Transaction, config *params.ChainConfig, blockNumber *big.Int, blockTime uint64) ethereum.Signer {
-	txConfig := k.TxConfig(ctx, tx.Hash(), tx.Type())
-	stateDB := states.New(ctx, k, txConfig)
-	if k.isCustomizedVerification(tx) && (stateDB.GetCodeHash(*tx.To()) != common.Hash{}) {
+	if k.isAspectVerified(ctx, tx) {
 		return &aspectSigner{k, ctx}
 	}
 
@@ -105,6 +99,18 @@ func (k *Keeper) isCustomizedVerification(tx *ethereum.Transaction) bool {
 	return utils.IsCustomizedVerification(tx)
 }
 
+// isAspectVerified returns whether the tx is sent without signature to a contract, whose sender is
+// verified by the verifier aspect bound to the contract.
+func (k *Keeper) isAspectVerified(ctx cosmos.Context, tx *ethereum.Transaction) bool {
+	if !k.isCustomizedVerification(tx) {
+		return false
+	}
+
+	txConfig := k.TxConfig(ctx, tx.Hash(), tx.Type())
+	stateDB := states.New(ctx, k, txConfig)
+	return stateDB.GetCodeHash(*tx.To()) != common.Hash{}
+}
+
 func (k *Keeper) processMsgData(tx *ethereum.Transaction) ([]byte, error) {
 	if k.isCustomizedVerification(tx) {
 		_, callData, err := djpm.DecodeValidationAndCallData(tx.Data())
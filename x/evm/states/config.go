@@ -16,6 +16,9 @@ type EVMConfig struct {
 	ChainConfig *params.ChainConfig
 	CoinBase    common.Address
 	BaseFee     *big.Int
+	// Overrides and BlockOverrides are only set by eth_call, eth_estimateGas and eth_simulateV1
+	Overrides      *StateOverride
+	BlockOverrides *BlockOverrides
 }
 
 // TxConfig encapulates the readonly information of current txs for `StateDB`.
@@ -112,6 +112,10 @@ type (
 		account            *common.Address
 		prevcode, prevhash []byte
 	}
+	storageResetChange struct {
+		account *common.Address
+		prev    Storage
+	}
 
 	// Changes to other states values.
 	refundChange struct {
@@ -210,6 +214,18 @@ func (ch codeChange) Dirtied() *common.Address {
 	return ch.account
 }
 
+// ----------------------------------------------------------------------------
+// 								storageResetChange
+// ----------------------------------------------------------------------------
+
+func (ch storageResetChange) Revert(s *StateDB) {
+	s.getStateObject(*ch.account).fakeStorage = ch.prev
+}
+
+func (ch storageResetChange) Dirtied() *common.Address {
+	return ch.account
+}
+
 // ----------------------------------------------------------------------------
 // 								storageChange
 // ----------------------------------------------------------------------------
@@ -0,0 +1,286 @@
+package states_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela-evm/vm"
+
+	"github.com/artela-network/artela-rollkit/x/evm/states"
+)
+
+// memKeeper is an in-memory states.Keeper.
+type memKeeper struct {
+	accounts map[common.Address]states.StateAccount
+	storage  map[common.Address]states.Storage
+	codes    map[common.Hash][]byte
+}
+
+func newMemKeeper() *memKeeper {
+	return &memKeeper{
+		accounts: make(map[common.Address]states.StateAccount),
+		storage:  make(map[common.Address]states.Storage),
+		codes:    make(map[common.Hash][]byte),
+	}
+}
+
+func (k *memKeeper) GetAccount(_ cosmos.Context, addr common.Address) *states.StateAccount {
+	account, ok := k.accounts[addr]
+	if !ok {
+		return nil
+	}
+	return &account
+}
+
+func (k *memKeeper) GetState(_ cosmos.Context, addr common.Address, key common.Hash) common.Hash {
+	return k.storage[addr][key]
+}
+
+func (k *memKeeper) GetCode(_ cosmos.Context, codeHash common.Hash) []byte {
+	return k.codes[codeHash]
+}
+
+func (k *memKeeper) ForEachStorage(_ cosmos.Context, addr common.Address, cb func(key, value common.Hash) bool) {
+	for key, value := range k.storage[addr] {
+		if !cb(key, value) {
+			return
+		}
+	}
+}
+
+func (k *memKeeper) SetAccount(_ cosmos.Context, addr common.Address, account states.StateAccount) error {
+	k.accounts[addr] = account
+	return nil
+}
+
+func (k *memKeeper) SetState(_ cosmos.Context, addr common.Address, key common.Hash, value []byte) {
+	if k.storage[addr] == nil {
+		k.storage[addr] = make(states.Storage)
+	}
+	k.storage[addr][key] = common.BytesToHash(value)
+}
+
+func (k *memKeeper) SetCode(_ cosmos.Context, codeHash []byte, code []byte) {
+	k.codes[common.BytesToHash(codeHash)] = code
+}
+
+func (k *memKeeper) DeleteAccount(_ cosmos.Context, addr common.Address) error {
+	delete(k.accounts, addr)
+	delete(k.storage, addr)
+	return nil
+}
+
+var (
+	overrideAddr = common.HexToAddress("0x0000000000000000000000000000000000001234")
+	slot1        = common.HexToHash("0x01")
+	slot2        = common.HexToHash("0x02")
+)
+
+func setupOverrideStateDB(t *testing.T) *states.StateDB {
+	return states.New(cosmos.Context{}, setupOverrideKeeper(t), states.NewEmptyTxConfig(common.Hash{}))
+}
+
+func setupOverrideKeeper(t *testing.T) *memKeeper {
+	keeper := newMemKeeper()
+	code := []byte{0x60, 0x00}
+	codeHash := crypto.Keccak256(code)
+	require.NoError(t, keeper.SetAccount(cosmos.Context{}, overrideAddr, states.StateAccount{
+		Nonce:    5,
+		Balance:  big.NewInt(100),
+		CodeHash: codeHash,
+	}))
+	keeper.SetCode(cosmos.Context{}, codeHash, code)
+	keeper.SetState(cosmos.Context{}, overrideAddr, slot1, common.HexToHash("0x11").Bytes())
+	keeper.SetState(cosmos.Context{}, overrideAddr, slot2, common.HexToHash("0x22").Bytes())
+	return keeper
+}
+
+func decodeStateOverride(t *testing.T, overrides string) *states.StateOverride {
+	var stateOverride states.StateOverride
+	require.NoError(t, json.Unmarshal([]byte(overrides), &stateOverride))
+	return &stateOverride
+}
+
+func TestStateOverrideApply(t *testing.T) {
+	for _, tc := range []struct {
+		desc      string
+		overrides string
+		check     func(t *testing.T, stateDB *states.StateDB)
+		err       string
+	}{
+		{
+			desc:      "nonce",
+			overrides: `{"0x0000000000000000000000000000000000001234":{"nonce":"0x9"}}`,
+			check: func(t *testing.T, stateDB *states.StateDB) {
+				require.Equal(t, uint64(9), stateDB.GetNonce(overrideAddr))
+				require.Equal(t, big.NewInt(100), stateDB.GetBalance(overrideAddr))
+			},
+		},
+		{
+			desc:      "code",
+			overrides: `{"0x0000000000000000000000000000000000001234":{"code":"0x6001"}}`,
+			check: func(t *testing.T, stateDB *states.StateDB) {
+				require.Equal(t, []byte{0x60, 0x01}, stateDB.GetCode(overrideAddr))
+				require.Equal(t, crypto.Keccak256Hash([]byte{0x60, 0x01}), stateDB.GetCodeHash(overrideAddr))
+			},
+		},
+		{
+			desc:      "balance",
+			overrides: `{"0x0000000000000000000000000000000000001234":{"balance":"0x3e8"}}`,
+			check: func(t *testing.T, stateDB *states.StateDB) {
+				require.Equal(t, big.NewInt(1000), stateDB.GetBalance(overrideAddr))
+				require.Equal(t, uint64(5), stateDB.GetNonce(overrideAddr))
+			},
+		},
+		{
+			desc: "state",
+			overrides: `{"0x0000000000000000000000000000000000001234":{"state":{
+				"0x0000000000000000000000000000000000000000000000000000000000000001":"0x0000000000000000000000000000000000000000000000000000000000000033"}}}`,
+			check: func(t *testing.T, stateDB *states.StateDB) {
+				require.Equal(t, common.HexToHash("0x33"), stateDB.GetState(overrideAddr, slot1))
+				// the storage not in the overrides is wiped
+				require.Equal(t, common.Hash{}, stateDB.GetState(overrideAddr, slot2))
+				require.Equal(t, common.Hash{}, stateDB.GetCommittedState(overrideAddr, slot2))
+
+				visited := make(map[common.Hash]common.Hash)
+				require.NoError(t, stateDB.ForEachStorage(overrideAddr, func(key, value common.Hash) bool {
+					visited[key] = value
+					return true
+				}))
+				require.Equal(t, map[common.Hash]common.Hash{slot1: common.HexToHash("0x33")}, visited)
+			},
+		},
+		{
+			desc: "state diff",
+			overrides: `{"0x0000000000000000000000000000000000001234":{"stateDiff":{
+				"0x0000000000000000000000000000000000000000000000000000000000000001":"0x0000000000000000000000000000000000000000000000000000000000000033"}}}`,
+			check: func(t *testing.T, stateDB *states.StateDB) {
+				require.Equal(t, common.HexToHash("0x33"), stateDB.GetState(overrideAddr, slot1))
+				// the storage not in the overrides is kept
+				require.Equal(t, common.HexToHash("0x22"), stateDB.GetState(overrideAddr, slot2))
+			},
+		},
+		{
+			desc:      "new account",
+			overrides: `{"0x0000000000000000000000000000000000005678":{"balance":"0x1","nonce":"0x1"}}`,
+			check: func(t *testing.T, stateDB *states.StateDB) {
+				addr := common.HexToAddress("0x0000000000000000000000000000000000005678")
+				require.True(t, stateDB.Exist(addr))
+				require.Equal(t, big.NewInt(1), stateDB.GetBalance(addr))
+				require.Equal(t, uint64(1), stateDB.GetNonce(addr))
+			},
+		},
+		{
+			desc:      "state and state diff",
+			overrides: `{"0x0000000000000000000000000000000000001234":{"state":{},"stateDiff":{}}}`,
+			err:       "has both 'state' and 'stateDiff'",
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			stateDB := setupOverrideStateDB(t)
+			err := decodeStateOverride(t, tc.overrides).Apply(stateDB)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			tc.check(t, stateDB)
+		})
+	}
+}
+
+func TestStateOverrideCommit(t *testing.T) {
+	keeper := setupOverrideKeeper(t)
+	stateDB := states.New(cosmos.Context{}, keeper, states.NewEmptyTxConfig(common.Hash{}))
+	require.NoError(t, decodeStateOverride(t, `{"0x0000000000000000000000000000000000001234":{"state":{
+		"0x0000000000000000000000000000000000000000000000000000000000000001":"0x0000000000000000000000000000000000000000000000000000000000000033"}}}`).Apply(stateDB))
+	slot3 := common.HexToHash("0x03")
+	stateDB.SetState(overrideAddr, slot3, common.HexToHash("0x44"))
+	require.NoError(t, stateDB.Commit())
+
+	// the committed storage is replaced by the overrides, together with the later changes
+	stateDB = states.New(cosmos.Context{}, keeper, states.NewEmptyTxConfig(common.Hash{}))
+	require.Equal(t, common.HexToHash("0x33"), stateDB.GetState(overrideAddr, slot1))
+	require.Equal(t, common.Hash{}, stateDB.GetState(overrideAddr, slot2))
+	require.Equal(t, common.HexToHash("0x44"), stateDB.GetState(overrideAddr, slot3))
+	require.Equal(t, uint64(5), stateDB.GetNonce(overrideAddr))
+}
+
+func TestStateOverrideRevert(t *testing.T) {
+	stateDB := setupOverrideStateDB(t)
+	snapshot := stateDB.Snapshot()
+	require.NoError(t, decodeStateOverride(t, `{"0x0000000000000000000000000000000000001234":{"state":{}}}`).Apply(stateDB))
+	require.Equal(t, common.Hash{}, stateDB.GetState(overrideAddr, slot1))
+
+	// reverting the overrides makes the committed storage visible again
+	stateDB.RevertToSnapshot(snapshot)
+	require.Equal(t, common.HexToHash("0x11"), stateDB.GetState(overrideAddr, slot1))
+}
+
+func TestStateOverrideNil(t *testing.T) {
+	var stateOverride *states.StateOverride
+	stateDB := setupOverrideStateDB(t)
+	require.NoError(t, stateOverride.Apply(stateDB))
+	require.Equal(t, uint64(5), stateDB.GetNonce(overrideAddr))
+
+	_, ok := stateOverride.Nonce(overrideAddr)
+	require.False(t, ok)
+
+	nonce, ok := decodeStateOverride(t, `{"0x0000000000000000000000000000000000001234":{"nonce":"0x9"}}`).Nonce(overrideAddr)
+	require.True(t, ok)
+	require.Equal(t, uint64(9), nonce)
+
+	_, ok = decodeStateOverride(t, `{"0x0000000000000000000000000000000000001234":{"balance":"0x1"}}`).Nonce(overrideAddr)
+	require.False(t, ok)
+}
+
+func TestBlockOverridesApply(t *testing.T) {
+	coinbase := common.HexToAddress("0x0000000000000000000000000000000000000c0b")
+	random := common.HexToHash("0x0a")
+	newBlockCtx := func() vm.BlockContext {
+		return vm.BlockContext{
+			Coinbase:    common.HexToAddress("0x01"),
+			GasLimit:    1000,
+			BlockNumber: big.NewInt(10),
+			Time:        100,
+			Difficulty:  big.NewInt(0),
+			BaseFee:     big.NewInt(7),
+		}
+	}
+
+	for _, tc := range []struct {
+		desc      string
+		overrides string
+		expected  func(blockCtx *vm.BlockContext)
+	}{
+		{"number", `{"number":"0x14"}`, func(blockCtx *vm.BlockContext) { blockCtx.BlockNumber = big.NewInt(20) }},
+		{"difficulty", `{"difficulty":"0x2"}`, func(blockCtx *vm.BlockContext) { blockCtx.Difficulty = big.NewInt(2) }},
+		{"time", `{"time":"0xc8"}`, func(blockCtx *vm.BlockContext) { blockCtx.Time = 200 }},
+		{"gas limit", `{"gasLimit":"0x7d0"}`, func(blockCtx *vm.BlockContext) { blockCtx.GasLimit = 2000 }},
+		{"coinbase", `{"coinbase":"0x0000000000000000000000000000000000000c0b"}`, func(blockCtx *vm.BlockContext) { blockCtx.Coinbase = coinbase }},
+		{"random", `{"random":"0x000000000000000000000000000000000000000000000000000000000000000a"}`, func(blockCtx *vm.BlockContext) { blockCtx.Random = &random }},
+		{"base fee", `{"baseFee":"0x9"}`, func(blockCtx *vm.BlockContext) { blockCtx.BaseFee = big.NewInt(9) }},
+		{"empty", `{}`, func(*vm.BlockContext) {}},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			var blockOverrides states.BlockOverrides
+			require.NoError(t, json.Unmarshal([]byte(tc.overrides), &blockOverrides))
+
+			blockCtx, expected := newBlockCtx(), newBlockCtx()
+			blockOverrides.Apply(&blockCtx)
+			tc.expected(&expected)
+			require.Equal(t, expected, blockCtx)
+		})
+	}
+
+	var blockOverrides *states.BlockOverrides
+	blockCtx := newBlockCtx()
+	blockOverrides.Apply(&blockCtx)
+	require.Equal(t, newBlockCtx(), blockCtx)
+}
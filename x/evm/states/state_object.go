@@ -23,6 +23,8 @@ type stateObject struct {
 	originStorage Storage
 	// Storage entries that have been modified in the current transaction execution
 	dirtyStorage Storage
+	// Storage entries replacing the whole committed storage, only set by state overrides
+	fakeStorage Storage
 
 	address common.Address
 
@@ -100,6 +102,10 @@ func (s *stateObject) setBalance(amount *big.Int) {
 
 // GetCommittedState query the committed states
 func (s *stateObject) GetCommittedState(key common.Hash) common.Hash {
+	// If the fake storage is set, only lookup the state here
+	if s.fakeStorage != nil {
+		return s.fakeStorage[key]
+	}
 	if value, cached := s.originStorage[key]; cached {
 		return value
 	}
@@ -137,6 +143,27 @@ func (s *stateObject) setState(key, value common.Hash) {
 	s.dirtyStorage[key] = value
 }
 
+// SetStorage replaces the entire states storage with the given one.
+//
+// After this function is called, all original states will be ignored and states
+// lookup only happens in the fake states storage.
+//
+// Note this function should only be used for debugging purpose. The change is
+// journaled, so the account is dirty and the whole storage is replaced on commit.
+func (s *stateObject) SetStorage(storage map[common.Hash]common.Hash) {
+	s.db.journal.append(storageResetChange{
+		account: &s.address,
+		prev:    s.fakeStorage.Copy(),
+	})
+	// Allocate fake storage if it's nil.
+	if s.fakeStorage == nil {
+		s.fakeStorage = make(Storage)
+	}
+	for key, value := range storage {
+		s.fakeStorage[key] = value
+	}
+}
+
 // ----------------------------------------------------------------------------
 // 							 attribute accessors
 // ----------------------------------------------------------------------------
@@ -315,6 +315,17 @@ func (s *StateDB) ForEachStorage(addr common.Address, cb func(key, value common.
 	if so == nil {
 		return nil
 	}
+	if so.fakeStorage != nil {
+		for key, value := range so.fakeStorage {
+			if dirtyValue, dirty := so.dirtyStorage[key]; dirty {
+				value = dirtyValue
+			}
+			if !cb(key, value) {
+				break
+			}
+		}
+		return nil
+	}
 	s.keeper.ForEachStorage(s.ctx, addr, func(key, value common.Hash) bool {
 		if value, dirty := so.dirtyStorage[key]; dirty {
 			return cb(key, value)
@@ -371,6 +382,25 @@ func (s *StateDB) SetState(addr common.Address, key, value common.Hash) {
 	}
 }
 
+// SetBalance sets the balance of account.
+func (s *StateDB) SetBalance(addr common.Address, amount *big.Int) {
+	stateObject := s.getOrNewStateObject(addr)
+	if stateObject != nil {
+		stateObject.SetBalance(amount)
+	}
+}
+
+// SetStorage replaces the entire storage of account with the given one,
+// the storage kept by keeper is no longer visible to this StateDB, and is
+// replaced by the given one if the StateDB is committed afterward.
+// This should only be used for debug purposes, e.g. state overrides of eth_call.
+func (s *StateDB) SetStorage(addr common.Address, storage map[common.Hash]common.Hash) {
+	stateObject := s.getOrNewStateObject(addr)
+	if stateObject != nil {
+		stateObject.SetStorage(storage)
+	}
+}
+
 // Suicide marks the given account as suicided.
 // This clears the account balance.
 //
@@ -493,6 +523,10 @@ func (s *StateDB) Commit() error {
 			if err := s.keeper.SetAccount(s.ctx, obj.Address(), obj.account); err != nil {
 				return errorsmod.Wrap(err, "failed to set account")
 			}
+			if obj.fakeStorage != nil {
+				s.commitFakeStorage(obj)
+				continue
+			}
 			for _, key := range obj.dirtyStorage.SortedKeys() {
 				value := obj.dirtyStorage[key]
 				// Skip noop changes, persist actual changes
@@ -505,3 +539,26 @@ func (s *StateDB) Commit() error {
 	}
 	return nil
 }
+
+// commitFakeStorage replaces the storage of the account kept by keeper with the fake storage,
+// together with the dirty storage on top of it.
+func (s *StateDB) commitFakeStorage(obj *stateObject) {
+	var staleKeys []common.Hash
+	s.keeper.ForEachStorage(s.ctx, obj.Address(), func(key, _ common.Hash) bool {
+		staleKeys = append(staleKeys, key)
+		return true
+	})
+	for _, key := range staleKeys {
+		s.keeper.SetState(s.ctx, obj.Address(), key, nil)
+	}
+
+	storage := obj.fakeStorage.Copy()
+	for key, value := range obj.dirtyStorage {
+		storage[key] = value
+	}
+	for _, key := range storage.SortedKeys() {
+		if value := storage[key]; value != (common.Hash{}) {
+			s.keeper.SetState(s.ctx, obj.Address(), key, value.Bytes())
+		}
+	}
+}
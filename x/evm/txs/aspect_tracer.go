@@ -0,0 +1,318 @@
+package txs
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/artela-network/artela-evm/tracers"
+	"github.com/artela-network/artela-evm/vm"
+	asptypes "github.com/artela-network/aspect-core/types"
+	"google.golang.org/protobuf/proto"
+)
+
+// TracerAspect is the name of the tracer reporting the aspect executions, select it with TraceConfig.Tracer.
+const TracerAspect = "aspectTracer"
+
+func init() {
+	tracers.DefaultDirectory.Register(TracerAspect, newAspectTracer, false)
+}
+
+var (
+	_ tracers.Tracer        = (*aspectTracer)(nil)
+	_ asptypes.AspectLogger = (*aspectTracer)(nil)
+	_ AspectHostLogger      = (*aspectTracer)(nil)
+)
+
+// AspectHostLogger is implemented by the tracers which also record what the aspects
+// did through the host apis while they are executed.
+type AspectHostLogger interface {
+	// CaptureAspectCodes is called with the aspects loaded for a join point, before they are executed.
+	CaptureAspectCodes(codes []*asptypes.AspectCode)
+	// CaptureAspectStateWrite is called when the running aspect sets its state.
+	CaptureAspectStateWrite(ctx *asptypes.RunnerContext, key string, value []byte)
+	// CaptureAspectJITCall is called when the running aspect has submitted a JIT call.
+	CaptureAspectJITCall(ctx *asptypes.RunnerContext, request *asptypes.JitInherentRequest, response *asptypes.JitInherentResponse, gasUsed uint64)
+}
+
+type aspectStateWrite struct {
+	Key   string        `json:"key"`
+	Value hexutil.Bytes `json:"value"`
+}
+
+type aspectJITCall struct {
+	Sender            common.Address `json:"sender"`
+	CallData          hexutil.Bytes  `json:"callData"`
+	GasUsed           hexutil.Uint64 `json:"gasUsed"`
+	Success           bool           `json:"success"`
+	TxHash            *common.Hash   `json:"txHash,omitempty"`
+	JITInherentHashes []common.Hash  `json:"jitInherentHashes,omitempty"`
+	Ret               hexutil.Bytes  `json:"ret,omitempty"`
+	Error             string         `json:"error,omitempty"`
+}
+
+// aspectFrame is an aspect invocation at a join point.
+type aspectFrame struct {
+	JoinPoint   string             `json:"joinPoint"`
+	AspectID    common.Address     `json:"aspectId"`
+	Version     uint64             `json:"version"`
+	GasIn       hexutil.Uint64     `json:"gasIn"`
+	GasOut      hexutil.Uint64     `json:"gasOut"`
+	Output      hexutil.Bytes      `json:"output,omitempty"`
+	Error       string             `json:"error,omitempty"`
+	Reverted    bool               `json:"reverted,omitempty"`
+	StateWrites []aspectStateWrite `json:"stateWrites,omitempty"`
+	JITCalls    []aspectJITCall    `json:"jitCalls,omitempty"`
+	// Calls are the static calls made by the aspect
+	Calls []*aspectCallFrame `json:"calls,omitempty"`
+
+	joinPoint asptypes.JoinPointRunType
+}
+
+// aspectCallFrame is an evm call frame, with the aspects executed at the join points of the call.
+type aspectCallFrame struct {
+	Type         string             `json:"type"`
+	From         common.Address     `json:"from"`
+	To           *common.Address    `json:"to,omitempty"`
+	Gas          hexutil.Uint64     `json:"gas"`
+	GasUsed      hexutil.Uint64     `json:"gasUsed"`
+	Value        *hexutil.Big       `json:"value,omitempty"`
+	Input        hexutil.Bytes      `json:"input"`
+	Output       hexutil.Bytes      `json:"output,omitempty"`
+	Error        string             `json:"error,omitempty"`
+	RevertReason string             `json:"revertReason,omitempty"`
+	Aspects      []*aspectFrame     `json:"aspects,omitempty"`
+	Calls        []*aspectCallFrame `json:"calls,omitempty"`
+}
+
+func (f *aspectCallFrame) processOutput(output []byte, err error) {
+	output = common.CopyBytes(output)
+	if err == nil {
+		f.Output = output
+		return
+	}
+	f.Error = err.Error()
+	if f.Type == vm.CREATE.String() || f.Type == vm.CREATE2.String() {
+		f.To = nil
+	}
+	if !errors.Is(err, vm.ErrExecutionReverted) || len(output) == 0 {
+		return
+	}
+	f.Output = output
+	if len(output) < 4 {
+		return
+	}
+	if unpacked, err := abi.UnpackRevert(output); err == nil {
+		f.RevertReason = unpacked
+	}
+}
+
+// aspectTracer records the call tree of a tx like the callTracer does, and reports every
+// aspect invocation in the call frame of its join point: the pre/post tx execute aspects
+// and the verifiers are in the top call frame, the pre/post contract call aspects are
+// in the frame of the call they are bound to.
+type aspectTracer struct {
+	callstack []*aspectCallFrame
+	aspects   []*aspectFrame
+	// aspectDepths are the sizes of the call stack when the running aspects were entered
+	aspectDepths []int
+	versions     map[common.Address]uint64
+	gasLimit     uint64
+	reason       error // Textual reason for the interruption
+}
+
+func newAspectTracer(_ *tracers.Context, _ json.RawMessage) (tracers.Tracer, error) {
+	// First call frame contains tx context info and is populated on start and end,
+	// it is created here so that the verifiers and the pre tx execute aspects have a frame to go to.
+	return &aspectTracer{
+		callstack: []*aspectCallFrame{{Type: vm.CALL.String()}},
+		versions:  make(map[common.Address]uint64),
+	}, nil
+}
+
+// CaptureTxStart implements the EVMLogger interface.
+func (t *aspectTracer) CaptureTxStart(gasLimit uint64) {
+	t.gasLimit = gasLimit
+}
+
+// CaptureTxEnd implements the EVMLogger interface.
+func (t *aspectTracer) CaptureTxEnd(restGas uint64) {
+	t.callstack[0].GasUsed = hexutil.Uint64(t.gasLimit - restGas)
+}
+
+// CaptureStart implements the EVMLogger interface to initialize the tracing operation.
+func (t *aspectTracer) CaptureStart(_ *vm.EVM, from common.Address, to common.Address, create bool, input []byte, _ uint64, value *big.Int) {
+	toCopy := to
+	root := t.callstack[0]
+	root.From = from
+	root.To = &toCopy
+	root.Input = common.CopyBytes(input)
+	root.Gas = hexutil.Uint64(t.gasLimit)
+	if value != nil {
+		root.Value = (*hexutil.Big)(new(big.Int).Set(value))
+	}
+	if create {
+		root.Type = vm.CREATE.String()
+	}
+}
+
+// CaptureEnd is called after the call finishes to finalize the tracing.
+func (t *aspectTracer) CaptureEnd(output []byte, _ uint64, err error) {
+	t.callstack[0].processOutput(output, err)
+}
+
+// CaptureState implements the EVMLogger interface to trace a single step of VM execution.
+func (t *aspectTracer) CaptureState(_ uint64, _ vm.OpCode, _, _ uint64, _ *vm.ScopeContext, _ []byte, _ int, _ error) {
+}
+
+// CaptureFault implements the EVMLogger interface to trace an execution fault.
+func (t *aspectTracer) CaptureFault(_ uint64, _ vm.OpCode, _, _ uint64, _ *vm.ScopeContext, _ int, _ error) {
+}
+
+// CaptureEnter is called when EVM enters a new scope (via call, create or selfdestruct).
+// The calls made by an aspect are nested into the aspect invocation.
+func (t *aspectTracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	toCopy := to
+	call := &aspectCallFrame{
+		Type:  typ.String(),
+		From:  from,
+		To:    &toCopy,
+		Input: common.CopyBytes(input),
+		Gas:   hexutil.Uint64(gas),
+	}
+	if value != nil {
+		call.Value = (*hexutil.Big)(new(big.Int).Set(value))
+	}
+
+	if aspect := t.currentAspect(); aspect != nil && t.aspectDepths[len(t.aspectDepths)-1] == len(t.callstack) {
+		aspect.Calls = append(aspect.Calls, call)
+	} else {
+		parent := t.callstack[len(t.callstack)-1]
+		parent.Calls = append(parent.Calls, call)
+	}
+	t.callstack = append(t.callstack, call)
+}
+
+// CaptureExit is called when EVM exits a scope, even if the scope didn't
+// execute any code.
+func (t *aspectTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	if len(t.callstack) <= 1 {
+		return
+	}
+
+	size := len(t.callstack)
+	call := t.callstack[size-1]
+	t.callstack = t.callstack[:size-1]
+
+	call.GasUsed = hexutil.Uint64(gasUsed)
+	call.processOutput(output, err)
+}
+
+// CaptureAspectCodes implements AspectHostLogger, the versions of the aspects to execute are recorded.
+func (t *aspectTracer) CaptureAspectCodes(codes []*asptypes.AspectCode) {
+	for _, code := range codes {
+		t.versions[common.HexToAddress(code.AspectId)] = code.Version
+	}
+}
+
+// CaptureAspectEnter implements the AspectLogger interface, it is called before an aspect is executed.
+func (t *aspectTracer) CaptureAspectEnter(joinPoint asptypes.JoinPointRunType, _, _, aspectID common.Address, _ []byte, gas uint64, _ *big.Int, _ proto.Message) {
+	aspect := &aspectFrame{
+		JoinPoint: joinPoint.String(),
+		AspectID:  aspectID,
+		Version:   t.versions[aspectID],
+		GasIn:     hexutil.Uint64(gas),
+		joinPoint: joinPoint,
+	}
+
+	call := t.callstack[len(t.callstack)-1]
+	call.Aspects = append(call.Aspects, aspect)
+	t.aspects = append(t.aspects, aspect)
+	t.aspectDepths = append(t.aspectDepths, len(t.callstack))
+}
+
+// CaptureAspectExit implements the AspectLogger interface, it is called after an aspect is executed.
+// The execution of the join point stops at the first failed aspect, which reverts the call or the tx.
+func (t *aspectTracer) CaptureAspectExit(joinPoint asptypes.JoinPointRunType, result *asptypes.AspectExecutionResult) {
+	size := len(t.aspects)
+	if size == 0 || t.aspects[size-1].joinPoint != joinPoint {
+		return
+	}
+
+	aspect := t.aspects[size-1]
+	t.aspects = t.aspects[:size-1]
+	t.aspectDepths = t.aspectDepths[:size-1]
+
+	aspect.GasOut = hexutil.Uint64(result.Gas)
+	aspect.Output = common.CopyBytes(result.Ret)
+	if result.Err != nil {
+		aspect.Error = result.Err.Error()
+		aspect.Reverted = true
+	}
+}
+
+// CaptureAspectStateWrite implements AspectHostLogger.
+func (t *aspectTracer) CaptureAspectStateWrite(ctx *asptypes.RunnerContext, key string, value []byte) {
+	aspect := t.currentAspect()
+	if aspect == nil || aspect.AspectID != ctx.AspectId {
+		return
+	}
+	aspect.Version = ctx.AspectVersion
+	aspect.StateWrites = append(aspect.StateWrites, aspectStateWrite{
+		Key:   key,
+		Value: common.CopyBytes(value),
+	})
+}
+
+// CaptureAspectJITCall implements AspectHostLogger.
+func (t *aspectTracer) CaptureAspectJITCall(ctx *asptypes.RunnerContext, request *asptypes.JitInherentRequest, response *asptypes.JitInherentResponse, gasUsed uint64) {
+	aspect := t.currentAspect()
+	if aspect == nil || aspect.AspectID != ctx.AspectId {
+		return
+	}
+	aspect.Version = ctx.AspectVersion
+
+	jitCall := aspectJITCall{
+		Sender:   common.BytesToAddress(request.Sender),
+		CallData: common.CopyBytes(request.CallData),
+		GasUsed:  hexutil.Uint64(gasUsed),
+	}
+	if response != nil {
+		jitCall.Success = response.GetSuccess()
+		jitCall.Ret = common.CopyBytes(response.Ret)
+		jitCall.Error = response.GetErrorMsg()
+		if len(response.TxHash) > 0 {
+			txHash := common.BytesToHash(response.TxHash)
+			jitCall.TxHash = &txHash
+		}
+		for _, hash := range response.JitInherentHashes {
+			jitCall.JITInherentHashes = append(jitCall.JITInherentHashes, common.BytesToHash(hash))
+		}
+	}
+	aspect.JITCalls = append(aspect.JITCalls, jitCall)
+}
+
+// GetResult returns the json-encoded call tree with the aspect invocations.
+func (t *aspectTracer) GetResult() (json.RawMessage, error) {
+	res, err := json.Marshal(t.callstack[0])
+	if err != nil {
+		return nil, err
+	}
+	return res, t.reason
+}
+
+// Stop records the reason of the interruption, which is returned with the result.
+func (t *aspectTracer) Stop(err error) {
+	t.reason = err
+}
+
+func (t *aspectTracer) currentAspect() *aspectFrame {
+	if len(t.aspects) == 0 {
+		return nil
+	}
+	return t.aspects[len(t.aspects)-1]
+}
@@ -0,0 +1,120 @@
+package txs_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela-evm/tracers"
+	"github.com/artela-network/artela-evm/vm"
+	asptypes "github.com/artela-network/aspect-core/types"
+
+	"github.com/artela-network/artela-rollkit/x/evm/txs"
+)
+
+func TestAspectTracer(t *testing.T) {
+	tracer, err := tracers.DefaultDirectory.New(txs.TracerAspect, &tracers.Context{}, nil)
+	require.NoError(t, err)
+
+	aspectLogger, ok := tracer.(asptypes.AspectLogger)
+	require.True(t, ok)
+	hostLogger, ok := tracer.(txs.AspectHostLogger)
+	require.True(t, ok)
+
+	var (
+		from     = common.HexToAddress("0x01")
+		contract = common.HexToAddress("0x02")
+		callee   = common.HexToAddress("0x03")
+		txAspect = common.HexToAddress("0xa1")
+		aspect   = common.HexToAddress("0xa2")
+	)
+
+	tracer.CaptureTxStart(100000)
+
+	// pre tx execute aspect goes to the top call frame
+	hostLogger.CaptureAspectCodes([]*asptypes.AspectCode{{AspectId: txAspect.Hex(), Version: 2}})
+	aspectLogger.CaptureAspectEnter(asptypes.JoinPointRunType_PreTxExecute, from, contract, txAspect, nil, 5000, nil, nil)
+	hostLogger.CaptureAspectStateWrite(&asptypes.RunnerContext{AspectId: txAspect, AspectVersion: 2}, "key", []byte{0x01})
+	aspectLogger.CaptureAspectExit(asptypes.JoinPointRunType_PreTxExecute, &asptypes.AspectExecutionResult{Gas: 4000})
+
+	tracer.CaptureStart(nil, from, contract, false, nil, 90000, big.NewInt(0))
+	tracer.CaptureEnter(vm.CALL, contract, callee, nil, 50000, big.NewInt(0))
+
+	// pre contract call aspect goes to the frame of the call, and the calls it makes are nested into it
+	success := true
+	aspectLogger.CaptureAspectEnter(asptypes.JoinPointRunType_PreContractCall, contract, callee, aspect, nil, 3000, nil, nil)
+	tracer.CaptureEnter(vm.STATICCALL, contract, callee, nil, 1000, nil)
+	tracer.CaptureExit(nil, 100, nil)
+	hostLogger.CaptureAspectJITCall(&asptypes.RunnerContext{AspectId: aspect, AspectVersion: 1},
+		&asptypes.JitInherentRequest{Sender: from.Bytes(), CallData: []byte{0x02}},
+		&asptypes.JitInherentResponse{Success: &success}, 500)
+	aspectLogger.CaptureAspectExit(asptypes.JoinPointRunType_PreContractCall, &asptypes.AspectExecutionResult{
+		Gas: 1000,
+		Err: errors.New("rejected"),
+	})
+
+	tracer.CaptureExit(nil, 20000, vm.ErrExecutionReverted)
+	tracer.CaptureEnd(nil, 30000, vm.ErrExecutionReverted)
+	tracer.CaptureTxEnd(60000)
+
+	res, err := tracer.GetResult()
+	require.NoError(t, err)
+
+	var frame struct {
+		GasUsed string `json:"gasUsed"`
+		Aspects []struct {
+			JoinPoint   string `json:"joinPoint"`
+			AspectID    common.Address
+			Version     uint64
+			GasIn       string
+			GasOut      string
+			StateWrites []json.RawMessage
+		}
+		Calls []struct {
+			Type    string
+			Aspects []struct {
+				AspectID common.Address
+				Version  uint64
+				Error    string
+				Reverted bool
+				JITCalls []struct {
+					Success bool
+					GasUsed string
+				}
+				Calls []struct {
+					Type string
+				}
+			}
+			Calls []json.RawMessage
+		}
+	}
+	require.NoError(t, json.Unmarshal(res, &frame))
+	require.Equal(t, "0x9c40", frame.GasUsed)
+
+	require.Len(t, frame.Aspects, 1)
+	require.Equal(t, asptypes.JoinPointRunType_PreTxExecute.String(), frame.Aspects[0].JoinPoint)
+	require.Equal(t, txAspect, frame.Aspects[0].AspectID)
+	require.Equal(t, uint64(2), frame.Aspects[0].Version)
+	require.Equal(t, "0x1388", frame.Aspects[0].GasIn)
+	require.Equal(t, "0xfa0", frame.Aspects[0].GasOut)
+	require.Len(t, frame.Aspects[0].StateWrites, 1)
+
+	require.Len(t, frame.Calls, 1)
+	call := frame.Calls[0]
+	require.Equal(t, "CALL", call.Type)
+	require.Empty(t, call.Calls)
+	require.Len(t, call.Aspects, 1)
+	require.Equal(t, aspect, call.Aspects[0].AspectID)
+	require.Equal(t, uint64(1), call.Aspects[0].Version)
+	require.Equal(t, "rejected", call.Aspects[0].Error)
+	require.True(t, call.Aspects[0].Reverted)
+	require.Len(t, call.Aspects[0].JITCalls, 1)
+	require.True(t, call.Aspects[0].JITCalls[0].Success)
+	require.Equal(t, "0x1f4", call.Aspects[0].JITCalls[0].GasUsed)
+	require.Len(t, call.Aspects[0].Calls, 1)
+	require.Equal(t, "STATICCALL", call.Aspects[0].Calls[0].Type)
+}
@@ -31,6 +31,8 @@ const (
 	codeErrInvalidGasLimit
 	codeErrCallContract
 	codeErrAspectNotFound
+	codeErrPaymasterLimitExceeded
+	codeErrInvalidFeeToken
 )
 
 var (
@@ -82,6 +84,12 @@ var (
 	ErrCallContract = errorsmod.Register(ModuleName, codeErrCallContract, "call contract error")
 
 	ErrAspectNotFound = errorsmod.Register(ModuleName, codeErrAspectNotFound, "aspect not found error")
+
+	// ErrPaymasterLimitExceeded returns an error if the sponsored fees exceed the spending limit of the paymaster.
+	ErrPaymasterLimitExceeded = errorsmod.Register(ModuleName, codeErrPaymasterLimitExceeded, "paymaster spending limit exceeded")
+
+	// ErrInvalidFeeToken returns an error if the gas is paid in a denom not whitelisted as fee token.
+	ErrInvalidFeeToken = errorsmod.Register(ModuleName, codeErrInvalidFeeToken, "invalid fee token")
 )
 
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
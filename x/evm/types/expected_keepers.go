@@ -55,6 +55,7 @@ type FeeKeeper interface {
 	GetBaseFee(ctx sdk.Context) *big.Int
 	GetParams(ctx sdk.Context) feemodule.Params
 	AddTransientGasWanted(ctx context.Context, gasWanted uint64) (uint64, error)
+	AddTransientBlockFees(ctx context.Context, baseFees, tips sdk.Coins)
 }
 
 // ParamSubspace defines the expected Subspace interface for parameters.
@@ -65,4 +66,5 @@ type ParamSubspace interface {
 
 type AspectKeeper interface {
 	GetStoreService() cstore.KVStoreService
+	SetEVMStoreService(evmStoreService cstore.KVStoreService)
 }
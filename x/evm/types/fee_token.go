@@ -0,0 +1,17 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	feemodule "github.com/artela-network/artela-rollkit/x/fee/types"
+)
+
+// FeeTokenContextKey is the context key of the fee token paying the gas of the current tx,
+// it is set by the AnteHandler, and absent if the gas is paid in the evm denom.
+const FeeTokenContextKey sdk.ContextKey = "fee-token"
+
+// FeeTokenFromContext returns the fee token paying the gas of the current tx.
+func FeeTokenFromContext(ctx sdk.Context) (feemodule.FeeToken, bool) {
+	token, ok := ctx.Value(FeeTokenContextKey).(feemodule.FeeToken)
+	return token, ok
+}
@@ -56,6 +56,7 @@ const (
 	EventTypeEthereumTx = TypeMsgEthereumTx
 	EventTypeBlockBloom = "block_bloom"
 	EventTypeTxLog      = "tx_log"
+	EventTypeFeeRebate  = "fee_rebate"
 
 	AttributeKeyContractAddress = "contract"
 	AttributeKeyRecipient       = "recipient"
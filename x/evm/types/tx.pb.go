@@ -353,6 +353,8 @@ var xxx_messageInfo_DynamicFeeTx proto.InternalMessageInfo
 
 // ExtensionOptionsEthereumTx is an extension option for ethereum transactions
 type ExtensionOptionsEthereumTx struct {
+	// fee_denom is the whitelisted fee token paying the gas of the tx, the evm denom is used if empty
+	FeeDenom string `protobuf:"bytes,1,opt,name=fee_denom,json=feeDenom,proto3" json:"fee_denom,omitempty"`
 }
 
 func (m *ExtensionOptionsEthereumTx) Reset()         { *m = ExtensionOptionsEthereumTx{} }
@@ -455,71 +457,71 @@ func init() {
 func init() { proto.RegisterFile("artela/evm/tx.proto", fileDescriptor_241208ee41ce5f03) }
 
 var fileDescriptor_241208ee41ce5f03 = []byte{
-	// 1009 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xda, 0xeb, 0xaf, 0xb1, 0xdb, 0xd2, 0x6d, 0xaa, 0xac, 0x4d, 0xe5, 0x35, 0xdb, 0x8b,
-	0x15, 0x29, 0xbb, 0x22, 0x7c, 0x48, 0xf5, 0x2d, 0x6e, 0xd2, 0xaa, 0x28, 0x81, 0x68, 0x71, 0x2e,
-	0x5c, 0xac, 0xc9, 0x7a, 0xb2, 0x1e, 0xc5, 0xbb, 0xb3, 0xda, 0x99, 0x5d, 0xec, 0x03, 0x12, 0xea,
-	0x09, 0x71, 0x02, 0xf1, 0x0f, 0x70, 0x44, 0x9c, 0x82, 0xd4, 0x03, 0x7f, 0x42, 0x05, 0x12, 0xaa,
-	0xe0, 0x82, 0x38, 0x18, 0xe4, 0x20, 0x45, 0xea, 0x91, 0x03, 0x67, 0x34, 0x33, 0xeb, 0xaf, 0x54,
-	0x0e, 0x50, 0x21, 0x2e, 0xc9, 0xbc, 0xf9, 0xbd, 0x37, 0xef, 0xbd, 0xdf, 0xcf, 0xfb, 0x1e, 0xb8,
-	0x05, 0x23, 0x86, 0x06, 0xd0, 0x46, 0x89, 0x6f, 0xb3, 0xa1, 0x15, 0x46, 0x84, 0x11, 0x0d, 0xc8,
-	0x4b, 0x0b, 0x25, 0x7e, 0xed, 0x26, 0xf4, 0x71, 0x40, 0x6c, 0xf1, 0x57, 0xc2, 0xb5, 0x0d, 0x97,
-	0x50, 0x9f, 0x50, 0xdb, 0xa7, 0x9e, 0x9d, 0xbc, 0xce, 0xff, 0xa5, 0x40, 0x55, 0x02, 0x5d, 0x61,
-	0xd9, 0xd2, 0x48, 0xa1, 0x75, 0x8f, 0x78, 0x44, 0xde, 0xf3, 0x53, 0x7a, 0x7b, 0xc7, 0x23, 0xc4,
-	0x1b, 0x20, 0x1b, 0x86, 0xd8, 0x86, 0x41, 0x40, 0x18, 0x64, 0x98, 0x04, 0xd3, 0x98, 0x6a, 0x8a,
-	0x0a, 0xeb, 0x38, 0x3e, 0xb1, 0x61, 0x30, 0x9a, 0x96, 0xb0, 0x50, 0x76, 0x08, 0x23, 0xe8, 0xcf,
-	0xf2, 0x2c, 0x00, 0x28, 0xf1, 0xe5, 0xad, 0xf9, 0x8d, 0x02, 0x6e, 0x1c, 0x50, 0xef, 0x28, 0xec,
-	0x41, 0x86, 0x0e, 0x85, 0xbf, 0xf6, 0x36, 0x28, 0xc1, 0x98, 0xf5, 0x49, 0x84, 0xd9, 0x48, 0x57,
-	0x1a, 0x4a, 0xb3, 0xd4, 0xd6, 0x7f, 0x7c, 0xb2, 0xb5, 0x9e, 0x96, 0xbd, 0xd3, 0xeb, 0x45, 0x88,
-	0xd2, 0xf7, 0x59, 0x84, 0x03, 0xcf, 0x99, 0xbb, 0x6a, 0x6f, 0x81, 0xbc, 0xcc, 0xa8, 0x67, 0x1a,
-	0x4a, 0xb3, 0xbc, 0xad, 0x59, 0x73, 0xb6, 0x2c, 0xf9, 0x76, 0xbb, 0xf4, 0x74, 0x6c, 0xac, 0x7d,
-	0x75, 0x71, 0xb6, 0xa9, 0x38, 0xa9, 0x73, 0xcb, 0x7e, 0x7c, 0x71, 0xb6, 0x39, 0x7f, 0xe6, 0xd3,
-	0x8b, 0xb3, 0xcd, 0x3b, 0x69, 0xad, 0x43, 0x51, 0xed, 0xa5, 0xfa, 0xcc, 0x2a, 0xd8, 0xb8, 0x74,
-	0xe5, 0x20, 0x1a, 0x92, 0x80, 0x22, 0xf3, 0x23, 0x70, 0xed, 0x80, 0x7a, 0x7b, 0xac, 0x8f, 0x22,
-	0x14, 0xfb, 0x9d, 0xa1, 0xd6, 0x04, 0x6a, 0x0f, 0x32, 0x28, 0xda, 0x28, 0x6f, 0xaf, 0x5b, 0x92,
-	0x38, 0x6b, 0x4a, 0x9c, 0xb5, 0x13, 0x8c, 0x1c, 0xe1, 0xa1, 0x19, 0x40, 0xed, 0x43, 0xda, 0x17,
-	0xb5, 0x97, 0xda, 0xe5, 0x3f, 0xc6, 0x46, 0x21, 0x1a, 0x84, 0x2d, 0x73, 0xcb, 0x74, 0x04, 0xa0,
-	0x69, 0x40, 0x3d, 0x89, 0x88, 0xaf, 0x67, 0xb9, 0x83, 0x23, 0xce, 0xad, 0x6b, 0x9f, 0x7c, 0x69,
-	0xac, 0xf1, 0xfa, 0x85, 0x69, 0x7e, 0x9e, 0x01, 0xc5, 0x7d, 0xe4, 0x41, 0x77, 0xd4, 0x19, 0x6a,
-	0xeb, 0x20, 0x17, 0x90, 0xc0, 0x45, 0x22, 0xb7, 0xea, 0x48, 0x83, 0x93, 0xeb, 0x41, 0xfe, 0x43,
-	0xc0, 0x2e, 0x4a, 0x73, 0x55, 0x7f, 0x19, 0x1b, 0xb7, 0x25, 0xb9, 0xb4, 0x77, 0x6a, 0x61, 0x62,
-	0xfb, 0x90, 0xf5, 0xad, 0x47, 0x01, 0x73, 0x8a, 0x1e, 0xa4, 0x87, 0xdc, 0x55, 0xab, 0x83, 0xac,
-	0x07, 0xa9, 0x48, 0xae, 0xb6, 0x2b, 0x93, 0xb1, 0x51, 0x7c, 0x08, 0xe9, 0x3e, 0xf6, 0x31, 0x73,
-	0x38, 0xa0, 0x5d, 0x07, 0x19, 0x46, 0x74, 0x55, 0xd4, 0x96, 0x61, 0x44, 0xbb, 0x07, 0x72, 0x09,
-	0x1c, 0xc4, 0x48, 0xcf, 0x89, 0x1c, 0x77, 0x57, 0xe6, 0x98, 0x8c, 0x8d, 0xfc, 0x8e, 0x4f, 0xe2,
-	0x80, 0x39, 0x32, 0x82, 0x37, 0x2a, 0x38, 0xcb, 0x37, 0x94, 0x66, 0x25, 0x65, 0xa7, 0x02, 0x94,
-	0x44, 0x2f, 0x88, 0x0b, 0x25, 0xe1, 0x56, 0xa4, 0x17, 0xa5, 0x15, 0x71, 0x8b, 0xea, 0x25, 0x69,
-	0xd1, 0xd6, 0x75, 0x4e, 0xc9, 0x77, 0x4f, 0xb6, 0xf2, 0x9d, 0xe1, 0x2e, 0x64, 0xd0, 0xfc, 0x36,
-	0x0b, 0x2a, 0x3b, 0xae, 0x8b, 0x28, 0xdd, 0xc7, 0x94, 0x75, 0x86, 0xda, 0x3b, 0xa0, 0xe8, 0xf6,
-	0x21, 0x0e, 0xba, 0xb8, 0x97, 0xfe, 0xba, 0xec, 0xab, 0x8a, 0x2b, 0xdc, 0xe7, 0xce, 0x8f, 0x76,
-	0x9f, 0x8f, 0x8d, 0x82, 0x2b, 0x8f, 0x4e, 0x7a, 0xe8, 0xcd, 0x39, 0xce, 0xac, 0xe4, 0x38, 0xfb,
-	0xaf, 0x39, 0x56, 0xaf, 0xe6, 0x38, 0xf7, 0x22, 0xc7, 0xf9, 0x97, 0xe6, 0xb8, 0xb0, 0xc0, 0xf1,
-	0x11, 0x28, 0x42, 0x41, 0x14, 0xa2, 0x7a, 0xb1, 0x91, 0x6d, 0x96, 0xb7, 0x37, 0x16, 0xbf, 0x20,
-	0x49, 0x62, 0x27, 0x0e, 0x07, 0xa8, 0xdd, 0xe0, 0x9f, 0xd1, 0xf3, 0xb1, 0x01, 0xe0, 0x8c, 0xd9,
-	0xaf, 0x7f, 0x35, 0xc0, 0x9c, 0x67, 0x67, 0xf6, 0x94, 0x94, 0xae, 0xb4, 0x24, 0x1d, 0x58, 0x92,
-	0xae, 0xbc, 0x4a, 0xba, 0x3f, 0xb3, 0xa0, 0xb2, 0x3b, 0x0a, 0xa0, 0x8f, 0xdd, 0x07, 0x08, 0xfd,
-	0x2f, 0xd2, 0xdd, 0x03, 0x65, 0x2e, 0x1d, 0xc3, 0x61, 0xd7, 0x85, 0xe1, 0xdf, 0x8b, 0xc7, 0x85,
-	0xee, 0xe0, 0xf0, 0x3e, 0x0c, 0xa7, 0xa1, 0x27, 0x08, 0x89, 0x50, 0xf5, 0x9f, 0x84, 0x3e, 0x40,
-	0x88, 0x87, 0xa6, 0xc2, 0xe7, 0xae, 0x16, 0x3e, 0xff, 0xa2, 0xf0, 0x85, 0x97, 0x16, 0xbe, 0xb8,
-	0x42, 0xf8, 0xd2, 0x7f, 0x2c, 0x3c, 0x58, 0x12, 0xbe, 0xbc, 0x24, 0x7c, 0x65, 0x95, 0xf0, 0x26,
-	0xa8, 0xed, 0x0d, 0x19, 0x0a, 0x28, 0x26, 0xc1, 0x7b, 0xa1, 0xd8, 0x3c, 0xf3, 0x99, 0xda, 0x52,
-	0xb9, 0xb7, 0xf9, 0x83, 0x02, 0x6e, 0x2f, 0xcd, 0xda, 0xe9, 0x10, 0xe6, 0x2d, 0x8a, 0x49, 0xaa,
-	0xc8, 0x41, 0x29, 0x86, 0xe7, 0x5d, 0xa0, 0x0e, 0x88, 0xc7, 0x37, 0x03, 0x6f, 0xef, 0xc6, 0x62,
-	0x7b, 0xfb, 0xc4, 0x73, 0x04, 0xa8, 0xbd, 0x02, 0xb2, 0x11, 0x62, 0x42, 0xf4, 0x8a, 0xc3, 0x8f,
-	0x5a, 0x15, 0x14, 0x13, 0xbf, 0x8b, 0xa2, 0x88, 0x44, 0xe9, 0x6c, 0x2b, 0x24, 0xfe, 0x1e, 0x37,
-	0x39, 0xc4, 0xe5, 0x8e, 0x29, 0xea, 0x49, 0xe1, 0x9c, 0x82, 0x07, 0xe9, 0x11, 0x45, 0x3d, 0xcd,
-	0x02, 0xb7, 0xdc, 0xd8, 0x8f, 0x07, 0x90, 0xe1, 0x04, 0x75, 0x67, 0x5e, 0x79, 0xe1, 0x75, 0x73,
-	0x0e, 0x3d, 0x94, 0xfe, 0xb2, 0xa1, 0xed, 0xef, 0x15, 0x90, 0x3d, 0xa0, 0x9e, 0x76, 0x08, 0x2a,
-	0x4b, 0xeb, 0xf0, 0xd5, 0xc5, 0x62, 0x2f, 0x2d, 0x9e, 0xda, 0xdd, 0x2b, 0xc0, 0x19, 0x21, 0xa7,
-	0x00, 0x2c, 0xac, 0xa4, 0xea, 0xa5, 0x90, 0x39, 0x54, 0x7b, 0x6d, 0x25, 0x34, 0xdb, 0x70, 0xc6,
-	0xe3, 0x9f, 0x7e, 0xff, 0x22, 0x53, 0x35, 0x37, 0xec, 0xc5, 0x7d, 0x9e, 0xfa, 0x75, 0xd9, 0xb0,
-	0x96, 0xfb, 0x98, 0x6f, 0xd7, 0xf6, 0xbb, 0x4f, 0x27, 0x75, 0xe5, 0xd9, 0xa4, 0xae, 0xfc, 0x36,
-	0xa9, 0x2b, 0x9f, 0x9d, 0xd7, 0xd7, 0x9e, 0x9d, 0xd7, 0xd7, 0x7e, 0x3e, 0xaf, 0xaf, 0x7d, 0xf0,
-	0xa6, 0x87, 0x59, 0x3f, 0x3e, 0xb6, 0x5c, 0xe2, 0xa7, 0x6f, 0x6c, 0x05, 0x88, 0x7d, 0x48, 0xa2,
-	0xd3, 0xa9, 0x19, 0x91, 0xc1, 0xe0, 0x14, 0xb3, 0x74, 0xfd, 0xb2, 0x51, 0x88, 0xe8, 0x71, 0x5e,
-	0xac, 0xcc, 0x37, 0xfe, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xd9, 0x50, 0x81, 0x58, 0x17, 0x09, 0x00,
-	0x00,
+	// 1023 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0x13, 0x27, 0x71, 0x26, 0xd9, 0x5d, 0xd6, 0xdb, 0x55, 0x9d, 0xec, 0x2a, 0x0e, 0xe9,
+	0x25, 0xaa, 0x54, 0x5b, 0x94, 0x1f, 0xd2, 0xe6, 0x82, 0x9a, 0x6d, 0x77, 0xb5, 0xa8, 0x85, 0xca,
+	0xa4, 0x17, 0x2e, 0xd1, 0xd4, 0x9e, 0x3a, 0x56, 0x63, 0x8f, 0xe5, 0x19, 0x9b, 0xe4, 0x80, 0x84,
+	0xf6, 0x84, 0x38, 0x81, 0xf8, 0x07, 0x38, 0x22, 0x4e, 0x45, 0xda, 0x03, 0x7f, 0xc2, 0x0a, 0x24,
+	0xb4, 0x82, 0x0b, 0xe2, 0x10, 0x50, 0x8a, 0x54, 0x69, 0x8f, 0x1c, 0x38, 0xa3, 0x99, 0x71, 0x7e,
+	0x75, 0x95, 0x02, 0x2b, 0xc4, 0x25, 0x99, 0x37, 0xdf, 0x7b, 0xf3, 0xde, 0xfb, 0xbe, 0xf1, 0x3c,
+	0x70, 0x0b, 0x46, 0x14, 0x0d, 0xa0, 0x89, 0x12, 0xdf, 0xa4, 0x43, 0x23, 0x8c, 0x30, 0xc5, 0x2a,
+	0x10, 0x9b, 0x06, 0x4a, 0xfc, 0xda, 0x4d, 0xe8, 0x7b, 0x01, 0x36, 0xf9, 0xaf, 0x80, 0x6b, 0xeb,
+	0x36, 0x26, 0x3e, 0x26, 0xa6, 0x4f, 0x5c, 0x33, 0x79, 0x8d, 0xfd, 0xa5, 0x40, 0x55, 0x00, 0x3d,
+	0x6e, 0x99, 0xc2, 0x48, 0xa1, 0x35, 0x17, 0xbb, 0x58, 0xec, 0xb3, 0x55, 0xba, 0x7b, 0xd7, 0xc5,
+	0xd8, 0x1d, 0x20, 0x13, 0x86, 0x9e, 0x09, 0x83, 0x00, 0x53, 0x48, 0x3d, 0x1c, 0x4c, 0x63, 0xaa,
+	0x29, 0xca, 0xad, 0xe3, 0xf8, 0xc4, 0x84, 0xc1, 0x68, 0x5a, 0xc2, 0x42, 0xd9, 0x21, 0x8c, 0xa0,
+	0x3f, 0xcb, 0xb3, 0x00, 0xa0, 0xc4, 0x17, 0xbb, 0xcd, 0x6f, 0x24, 0x70, 0xe3, 0x80, 0xb8, 0x47,
+	0xa1, 0x03, 0x29, 0x3a, 0xe4, 0xfe, 0xea, 0x5b, 0xa0, 0x04, 0x63, 0xda, 0xc7, 0x91, 0x47, 0x47,
+	0x9a, 0xd4, 0x90, 0x5a, 0xa5, 0x8e, 0xf6, 0xe3, 0x93, 0xad, 0xb5, 0xb4, 0xec, 0x1d, 0xc7, 0x89,
+	0x10, 0x21, 0xef, 0xd3, 0xc8, 0x0b, 0x5c, 0x6b, 0xee, 0xaa, 0xbe, 0x09, 0x0a, 0x22, 0xa3, 0x96,
+	0x6d, 0x48, 0xad, 0xf2, 0xb6, 0x6a, 0xcc, 0xd9, 0x32, 0xc4, 0xd9, 0x9d, 0xd2, 0xd3, 0xb1, 0x9e,
+	0xf9, 0xea, 0xe2, 0x6c, 0x53, 0xb2, 0x52, 0xe7, 0xb6, 0xf9, 0xf8, 0xe2, 0x6c, 0x73, 0x7e, 0xcc,
+	0xa7, 0x17, 0x67, 0x9b, 0x77, 0xd3, 0x5a, 0x87, 0xbc, 0xda, 0x4b, 0xf5, 0x35, 0xab, 0x60, 0xfd,
+	0xd2, 0x96, 0x85, 0x48, 0x88, 0x03, 0x82, 0x9a, 0x1f, 0x81, 0x6b, 0x07, 0xc4, 0xdd, 0xa3, 0x7d,
+	0x14, 0xa1, 0xd8, 0xef, 0x0e, 0xd5, 0x16, 0x90, 0x1d, 0x48, 0x21, 0x6f, 0xa3, 0xbc, 0xbd, 0x66,
+	0x08, 0xe2, 0x8c, 0x29, 0x71, 0xc6, 0x4e, 0x30, 0xb2, 0xb8, 0x87, 0xaa, 0x03, 0xb9, 0x0f, 0x49,
+	0x9f, 0xd7, 0x5e, 0xea, 0x94, 0xff, 0x18, 0xeb, 0xc5, 0x68, 0x10, 0xb6, 0x9b, 0x5b, 0x4d, 0x8b,
+	0x03, 0xaa, 0x0a, 0xe4, 0x93, 0x08, 0xfb, 0x5a, 0x8e, 0x39, 0x58, 0x7c, 0xdd, 0xbe, 0xf6, 0xc9,
+	0x97, 0x7a, 0x86, 0xd5, 0xcf, 0xcd, 0xe6, 0xe7, 0x59, 0xa0, 0xec, 0x23, 0x17, 0xda, 0xa3, 0xee,
+	0x50, 0x5d, 0x03, 0xf9, 0x00, 0x07, 0x36, 0xe2, 0xb9, 0x65, 0x4b, 0x18, 0x8c, 0x5c, 0x17, 0xb2,
+	0x8b, 0xe0, 0xd9, 0x28, 0xcd, 0x55, 0xfd, 0x65, 0xac, 0xdf, 0x16, 0xe4, 0x12, 0xe7, 0xd4, 0xf0,
+	0xb0, 0xe9, 0x43, 0xda, 0x37, 0x1e, 0x05, 0xd4, 0x52, 0x5c, 0x48, 0x0e, 0x99, 0xab, 0x5a, 0x07,
+	0x39, 0x17, 0x12, 0x9e, 0x5c, 0xee, 0x54, 0x26, 0x63, 0x5d, 0x79, 0x08, 0xc9, 0xbe, 0xe7, 0x7b,
+	0xd4, 0x62, 0x80, 0x7a, 0x1d, 0x64, 0x29, 0xd6, 0x64, 0x5e, 0x5b, 0x96, 0x62, 0xf5, 0x1e, 0xc8,
+	0x27, 0x70, 0x10, 0x23, 0x2d, 0xcf, 0x73, 0x6c, 0xac, 0xcc, 0x31, 0x19, 0xeb, 0x85, 0x1d, 0x1f,
+	0xc7, 0x01, 0xb5, 0x44, 0x04, 0x6b, 0x94, 0x73, 0x56, 0x68, 0x48, 0xad, 0x4a, 0xca, 0x4e, 0x05,
+	0x48, 0x89, 0x56, 0xe4, 0x1b, 0x52, 0xc2, 0xac, 0x48, 0x53, 0x84, 0x15, 0x31, 0x8b, 0x68, 0x25,
+	0x61, 0x91, 0xf6, 0x75, 0x46, 0xc9, 0x77, 0x4f, 0xb6, 0x0a, 0xdd, 0xe1, 0x2e, 0xa4, 0xb0, 0xf9,
+	0x6d, 0x0e, 0x54, 0x76, 0x6c, 0x1b, 0x11, 0xb2, 0xef, 0x11, 0xda, 0x1d, 0xaa, 0xef, 0x00, 0xc5,
+	0xee, 0x43, 0x2f, 0xe8, 0x79, 0x4e, 0x7a, 0xbb, 0xcc, 0xab, 0x8a, 0x2b, 0xde, 0x67, 0xce, 0x8f,
+	0x76, 0x9f, 0x8f, 0xf5, 0xa2, 0x2d, 0x96, 0x56, 0xba, 0x70, 0xe6, 0x1c, 0x67, 0x57, 0x72, 0x9c,
+	0xfb, 0xd7, 0x1c, 0xcb, 0x57, 0x73, 0x9c, 0x7f, 0x91, 0xe3, 0xc2, 0x4b, 0x73, 0x5c, 0x5c, 0xe0,
+	0xf8, 0x08, 0x28, 0x90, 0x13, 0x85, 0x88, 0xa6, 0x34, 0x72, 0xad, 0xf2, 0xf6, 0xfa, 0xe2, 0x17,
+	0x24, 0x48, 0xec, 0xc6, 0xe1, 0x00, 0x75, 0x1a, 0xec, 0x33, 0x7a, 0x3e, 0xd6, 0x01, 0x9c, 0x31,
+	0xfb, 0xf5, 0xaf, 0x3a, 0x98, 0xf3, 0x6c, 0xcd, 0x8e, 0x12, 0xd2, 0x95, 0x96, 0xa4, 0x03, 0x4b,
+	0xd2, 0x95, 0x57, 0x49, 0xf7, 0x67, 0x0e, 0x54, 0x76, 0x47, 0x01, 0xf4, 0x3d, 0xfb, 0x01, 0x42,
+	0xff, 0x8b, 0x74, 0xf7, 0x40, 0x99, 0x49, 0x47, 0xbd, 0xb0, 0x67, 0xc3, 0xf0, 0xef, 0xc5, 0x63,
+	0x42, 0x77, 0xbd, 0xf0, 0x3e, 0x0c, 0xa7, 0xa1, 0x27, 0x08, 0xf1, 0x50, 0xf9, 0x9f, 0x84, 0x3e,
+	0x40, 0x88, 0x85, 0xa6, 0xc2, 0xe7, 0xaf, 0x16, 0xbe, 0xf0, 0xa2, 0xf0, 0xc5, 0x97, 0x16, 0x5e,
+	0x59, 0x21, 0x7c, 0xe9, 0x3f, 0x16, 0x1e, 0x2c, 0x09, 0x5f, 0x5e, 0x12, 0xbe, 0xb2, 0x4a, 0xf8,
+	0xb7, 0x41, 0x6d, 0x6f, 0x48, 0x51, 0x40, 0x3c, 0x1c, 0xbc, 0x17, 0xf2, 0xc9, 0xb3, 0xf0, 0xa6,
+	0xde, 0x01, 0x25, 0x46, 0xb2, 0x83, 0x02, 0xec, 0x8b, 0x6b, 0x60, 0x29, 0x27, 0x08, 0xed, 0x32,
+	0xbb, 0x2d, 0xb3, 0xa3, 0x9a, 0x3f, 0x48, 0xe0, 0xf6, 0xd2, 0x43, 0x3c, 0x7d, 0xa1, 0x59, 0xff,
+	0xfc, 0x99, 0x15, 0x71, 0x7c, 0xad, 0x6e, 0x00, 0x79, 0x80, 0x5d, 0x36, 0x36, 0x58, 0xef, 0x37,
+	0x16, 0x7b, 0xdf, 0xc7, 0xae, 0xc5, 0x41, 0xf5, 0x15, 0x90, 0x8b, 0x10, 0xe5, 0x37, 0xa2, 0x62,
+	0xb1, 0xa5, 0x5a, 0x05, 0x4a, 0xe2, 0xf7, 0x50, 0x14, 0xe1, 0x28, 0x7d, 0xf8, 0x8a, 0x89, 0xbf,
+	0xc7, 0x4c, 0x06, 0xb1, 0xbb, 0x10, 0x13, 0xe4, 0x08, 0x55, 0xad, 0xa2, 0x0b, 0xc9, 0x11, 0x41,
+	0x8e, 0x6a, 0x80, 0x5b, 0x76, 0xec, 0xc7, 0x03, 0x48, 0xbd, 0x04, 0xf5, 0x66, 0x5e, 0x05, 0xee,
+	0x75, 0x73, 0x0e, 0x3d, 0x14, 0xfe, 0xa2, 0xa1, 0xed, 0xef, 0x25, 0x90, 0x3b, 0x20, 0xae, 0x7a,
+	0x08, 0x2a, 0x4b, 0xb3, 0xf2, 0xce, 0x62, 0xb1, 0x97, 0xa6, 0x52, 0x6d, 0xe3, 0x0a, 0x70, 0x46,
+	0xc8, 0x29, 0x00, 0x0b, 0xdc, 0x56, 0x2f, 0x85, 0xcc, 0xa1, 0xda, 0xab, 0x2b, 0xa1, 0xd9, 0xf8,
+	0xd3, 0x1f, 0xff, 0xf4, 0xfb, 0x17, 0xd9, 0x6a, 0x73, 0xdd, 0x5c, 0x1c, 0xf6, 0xa9, 0x5f, 0x8f,
+	0x0e, 0x6b, 0xf9, 0x8f, 0xd9, 0xe8, 0xed, 0xbc, 0xfb, 0x74, 0x52, 0x97, 0x9e, 0x4d, 0xea, 0xd2,
+	0x6f, 0x93, 0xba, 0xf4, 0xd9, 0x79, 0x3d, 0xf3, 0xec, 0xbc, 0x9e, 0xf9, 0xf9, 0xbc, 0x9e, 0xf9,
+	0xe0, 0x0d, 0xd7, 0xa3, 0xfd, 0xf8, 0xd8, 0xb0, 0xb1, 0x9f, 0x9e, 0xb1, 0x15, 0x20, 0xfa, 0x21,
+	0x8e, 0x4e, 0xa7, 0x66, 0x84, 0x07, 0x83, 0x53, 0x8f, 0xa6, 0xb3, 0x99, 0x8e, 0x42, 0x44, 0x8e,
+	0x0b, 0x7c, 0x9e, 0xbe, 0xfe, 0xd7, 0x00, 0x06, 0x6f, 0x56, 0x8c, 0x34, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1116,6 +1118,13 @@ func (m *ExtensionOptionsEthereumTx) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeDenom) > 0 {
+		i -= len(m.FeeDenom)
+		copy(dAtA[i:], m.FeeDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeeDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -1402,6 +1411,10 @@ func (m *ExtensionOptionsEthereumTx) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = len(m.FeeDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2948,6 +2961,38 @@ func (m *ExtensionOptionsEthereumTx) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: ExtensionOptionsEthereumTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
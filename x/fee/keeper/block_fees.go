@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	artela "github.com/artela-network/artela-rollkit/ethereum/types"
+	"github.com/artela-network/artela-rollkit/x/fee/types"
+)
+
+// ----------------------------------------------------------------------------
+// Block Fees
+// The fees collected in a block, distributed with the fee params during EndBlock.
+// Only the fees of Ethereum txs are collected, the fees of Cosmos txs are not deducted
+// by their ante handlers.
+// ----------------------------------------------------------------------------
+
+// GetTransientBlockFees returns the fees collected in the current block from the transient store.
+func (k Keeper) GetTransientBlockFees(ctx context.Context) types.BlockFees {
+	transientStore := k.transientStoreService.OpenTransientStore(ctx)
+	bz, _ := transientStore.Get(types.KeyPrefixTransientBlockFees)
+
+	var fees types.BlockFees
+	if len(bz) == 0 {
+		return fees
+	}
+
+	k.cdc.MustUnmarshal(bz, &fees)
+	return fees
+}
+
+// AddTransientBlockFees adds the base fees and the tips paid by a tx to the fees collected in the current block.
+func (k Keeper) AddTransientBlockFees(ctx context.Context, baseFees, tips sdk.Coins) {
+	fees := k.GetTransientBlockFees(ctx)
+	fees.BaseFees = fees.BaseFees.Add(baseFees...)
+	fees.Tips = fees.Tips.Add(tips...)
+
+	transientStore := k.transientStoreService.OpenTransientStore(ctx)
+	_ = transientStore.Set(types.KeyPrefixTransientBlockFees, k.cdc.MustMarshal(&fees))
+}
+
+// SetBlockFees sets the fees distributed in the last block to the store.
+// CONTRACT: this should be only called during EndBlock.
+func (k Keeper) SetBlockFees(ctx context.Context, fees types.BlockFees) {
+	kvStore := k.storeService.OpenKVStore(ctx)
+	_ = kvStore.Set(types.KeyPrefixBlockFees, k.cdc.MustMarshal(&fees))
+
+	k.Logger().Debug("setState: SetBlockFees",
+		"key", "KeyPrefixBlockFees",
+		"height", fees.Height)
+}
+
+// GetBlockFees returns the fees distributed in the last block from the store.
+func (k Keeper) GetBlockFees(ctx context.Context) types.BlockFees {
+	kvStore := k.storeService.OpenKVStore(ctx)
+	bz, _ := kvStore.Get(types.KeyPrefixBlockFees)
+
+	var fees types.BlockFees
+	if len(bz) == 0 {
+		return fees
+	}
+
+	k.cdc.MustUnmarshal(bz, &fees)
+	return fees
+}
+
+// DistributeBlockFees distributes the fees collected in the current block with the fee params: a share
+// of the base fees is burned, a share is sent to the treasury, and the tips are sent to the configured
+// recipient. The rest of the fees is kept in the fee collector. The distribution is skipped if any
+// of the transfers fails, so a misconfiguration never halts the chain, the failure is reported with
+// an EventFeeDistributionFailed event.
+// CONTRACT: this should be only called during EndBlock.
+func (k Keeper) DistributeBlockFees(ctx sdk.Context) types.BlockFees {
+	fees := k.GetTransientBlockFees(ctx)
+	fees.Height = ctx.BlockHeight()
+	k.GetParams(ctx).SplitFees(&fees)
+
+	if fees.Proposer.IsAllPositive() {
+		proposer, err := artela.GetProposerOperator(ctx, k.stakingKeeper, nil)
+		if err != nil {
+			k.Logger().Error("failed to resolve the block proposer, tips are kept in the fee collector", "error", err)
+			k.emitDistributionFailure(ctx, err)
+			fees.Proposer = sdk.Coins{}
+		} else {
+			fees.ProposerAddress = sdk.AccAddress(proposer).String()
+		}
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.distributeBlockFees(cacheCtx, fees); err != nil {
+		k.Logger().Error("failed to distribute block fees, fees are kept in the fee collector", "error", err)
+		k.emitDistributionFailure(ctx, err)
+		fees.Burned, fees.Treasury, fees.Proposer, fees.ProposerAddress = sdk.Coins{}, sdk.Coins{}, sdk.Coins{}, ""
+	} else {
+		write()
+	}
+
+	k.SetBlockFees(ctx, fees)
+	return fees
+}
+
+// emitDistributionFailure reports the failure of the fee distribution with an event.
+func (k Keeper) emitDistributionFailure(ctx sdk.Context, err error) {
+	if emitErr := ctx.EventManager().EmitTypedEvent(&types.EventFeeDistributionFailed{
+		Height: ctx.BlockHeight(),
+		Error:  err.Error(),
+	}); emitErr != nil {
+		k.Logger().Error("failed to emit fee distribution failure event", "error", emitErr)
+	}
+}
+
+// distributeBlockFees moves the split fees out of the fee collector.
+func (k Keeper) distributeBlockFees(ctx sdk.Context, fees types.BlockFees) error {
+	if fees.Burned.IsAllPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, fees.Burned); err != nil {
+			return errorsmod.Wrapf(err, "failed to collect the fees to burn %s", fees.Burned)
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, fees.Burned); err != nil {
+			return errorsmod.Wrapf(err, "failed to burn fees %s", fees.Burned)
+		}
+	}
+
+	if fees.Treasury.IsAllPositive() {
+		treasury, err := sdk.AccAddressFromBech32(k.GetParams(ctx).TreasuryAddress)
+		if err != nil {
+			return errorsmod.Wrap(err, "invalid treasury address")
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, treasury, fees.Treasury); err != nil {
+			return errorsmod.Wrapf(err, "failed to send fees %s to the treasury", fees.Treasury)
+		}
+	}
+
+	if fees.Proposer.IsAllPositive() {
+		proposer, err := sdk.AccAddressFromBech32(fees.ProposerAddress)
+		if err != nil {
+			return errorsmod.Wrap(err, "invalid proposer address")
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, proposer, fees.Proposer); err != nil {
+			return errorsmod.Wrapf(err, "failed to send tips %s to the proposer", fees.Proposer)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/artela-network/artela-rollkit/testutil/keeper"
+	"github.com/artela-network/artela-rollkit/x/fee/types"
+)
+
+func TestDistributeBlockFees(t *testing.T) {
+	consAddr := sdk.ConsAddress("proposer_cons_addr__")
+	operator := sdk.ValAddress("proposer_operator___")
+	treasury := sdk.AccAddress("treasury____________")
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin("aart", amount))
+	}
+
+	testCases := []struct {
+		name         string
+		collected    int64
+		hasProposer  bool
+		burned       int64
+		treasury     int64
+		proposer     int64
+		feeCollector int64
+		failed       bool
+	}{
+		// base fees 1000 and tips 100, half of the base fees is burned and a fifth is sent to the treasury
+		{"distributed", 1100, true, 500, 200, 100, 300, false},
+		{"unknown proposer", 1100, false, 500, 200, 0, 400, true},
+		// the burn succeeds but the treasury transfer fails, the burn is rolled back with it
+		{"insufficient fee collector balance", 600, true, 0, 0, 0, 600, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx, bankKeeper, stakingKeeper := keepertest.FeeKeeperWithMocks(t)
+			ctx = ctx.WithBlockHeader(cmtproto.Header{Height: 10, ProposerAddress: consAddr}).WithEventManager(sdk.NewEventManager())
+
+			params := types.DefaultParams()
+			params.BaseFeeBurnRatio = sdkmath.LegacyNewDecWithPrec(5, 1)
+			params.TreasuryRatio = sdkmath.LegacyNewDecWithPrec(2, 1)
+			params.TreasuryAddress = treasury.String()
+			params.TipRecipient = types.TipRecipient_TIP_RECIPIENT_PROPOSER
+			require.NoError(t, k.SetParams(ctx, params))
+
+			if tc.hasProposer {
+				stakingKeeper.Validators[consAddr.String()] = stakingtypes.Validator{OperatorAddress: operator.String()}
+			}
+			bankKeeper.SetBalance(ctx, authtypes.FeeCollectorName, coins(tc.collected))
+			k.AddTransientBlockFees(ctx, coins(1000), coins(100))
+
+			fees := k.DistributeBlockFees(ctx)
+			require.Equal(t, int64(10), fees.Height)
+			require.Equal(t, coins(tc.burned).String(), fees.Burned.String())
+			require.Equal(t, coins(tc.treasury).String(), fees.Treasury.String())
+			require.Equal(t, coins(tc.proposer).String(), fees.Proposer.String())
+			stored := k.GetBlockFees(ctx)
+			require.Equal(t, fees.String(), stored.String())
+
+			require.Equal(t, coins(tc.feeCollector).String(), bankKeeper.GetBalance(ctx, authtypes.FeeCollectorName).String())
+			require.Equal(t, coins(tc.treasury).String(), bankKeeper.GetBalance(ctx, treasury.String()).String())
+			require.Equal(t, coins(tc.proposer).String(), bankKeeper.GetBalance(ctx, sdk.AccAddress(operator).String()).String())
+			require.True(t, bankKeeper.GetBalance(ctx, types.ModuleName).IsZero())
+			if tc.proposer > 0 {
+				require.Equal(t, sdk.AccAddress(operator).String(), fees.ProposerAddress)
+			}
+
+			var failed bool
+			for _, event := range ctx.EventManager().Events() {
+				failed = failed || event.Type == "artela.fee.EventFeeDistributionFailed"
+			}
+			require.Equal(t, tc.failed, failed)
+		})
+	}
+}
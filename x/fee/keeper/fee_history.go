@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/artela-network/artela-rollkit/x/fee/types"
+)
+
+// ----------------------------------------------------------------------------
+// Fee History
+// A ring buffer of the base fee and the gas of the latest blocks, the record of
+// a block is kept in the slot height % fee_history_size, and is overwritten once
+// the height is out of the history.
+// ----------------------------------------------------------------------------
+
+// SetBlockFeeRecord saves the fee record of a block in the fee history.
+// CONTRACT: this should be only called during EndBlock.
+func (k Keeper) SetBlockFeeRecord(ctx sdk.Context, record types.BlockFeeRecord) {
+	size := k.GetParams(ctx).FeeHistorySize
+	if size == 0 || record.Height <= 0 {
+		return
+	}
+
+	store := k.feeHistoryStore(ctx)
+	store.Set(feeHistorySlot(record.Height, size), k.cdc.MustMarshal(&record))
+
+	k.Logger().Debug("setState: SetBlockFeeRecord",
+		"key", "KeyPrefixFeeHistory",
+		"height", record.Height)
+}
+
+// GetBlockFeeRecord returns the fee record of a block from the fee history, returns false if the block
+// is out of the history.
+func (k Keeper) GetBlockFeeRecord(ctx sdk.Context, height int64) (types.BlockFeeRecord, bool) {
+	var record types.BlockFeeRecord
+	size := k.GetParams(ctx).FeeHistorySize
+	if size == 0 || height <= 0 {
+		return record, false
+	}
+
+	bz := k.feeHistoryStore(ctx).Get(feeHistorySlot(height, size))
+	if len(bz) == 0 {
+		return record, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &record)
+	// the slot may have been overwritten by a later block, or left by a different history size
+	return record, record.Height == height
+}
+
+// RecordBlockFee saves the base fee and the gas of the current block in the fee history.
+// CONTRACT: this should be only called during EndBlock.
+func (k Keeper) RecordBlockFee(ctx sdk.Context, gasWanted, gasUsed uint64) {
+	record := types.BlockFeeRecord{
+		Height:    ctx.BlockHeight(),
+		GasWanted: gasWanted,
+		GasUsed:   gasUsed,
+		GasLimit:  blockGasLimit(ctx),
+	}
+
+	params := k.GetParams(ctx)
+	if params.IsBaseFeeEnabled(ctx.BlockHeight()) {
+		baseFee := params.BaseFee
+		record.BaseFee = &baseFee
+	}
+
+	k.SetBlockFeeRecord(ctx, record)
+}
+
+func (k Keeper) feeHistoryStore(ctx context.Context) prefix.Store {
+	return prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.KeyPrefixFeeHistory)
+}
+
+// feeHistorySlot returns the key of the ring buffer slot keeping the record of the given height.
+func feeHistorySlot(height int64, size uint64) []byte {
+	return sdk.Uint64ToBigEndian(uint64(height) % size) // #nosec G115
+}
+
+// blockGasLimit returns the gas limit of the current block from the consensus params,
+// the unlimited block gas is reported as max uint32, the same as the json rpc.
+func blockGasLimit(ctx sdk.Context) uint64 {
+	consParams := ctx.ConsensusParams()
+	if consParams.Block == nil || consParams.Block.MaxGas <= 0 {
+		return uint64(^uint32(0))
+	}
+	return uint64(consParams.Block.MaxGas)
+}
+
+// feeHistoryRecords returns the records of the consecutive blocks ending at the last height, the blocks
+// out of the history are left out.
+func (k Keeper) feeHistoryRecords(ctx sdk.Context, lastHeight int64, count uint64) []types.BlockFeeRecord {
+	records := make([]types.BlockFeeRecord, 0, count)
+	first := lastHeight - int64(count) + 1 // #nosec G115
+	if first < 1 {
+		first = 1
+	}
+
+	for height := first; height <= lastHeight; height++ {
+		if record, found := k.GetBlockFeeRecord(ctx, height); found {
+			records = append(records, record)
+		}
+	}
+	return records
+}
@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/artela-network/artela-rollkit/testutil/keeper"
+	"github.com/artela-network/artela-rollkit/x/fee/types"
+)
+
+func TestFeeHistory(t *testing.T) {
+	k, ctx := keepertest.FeeKeeper(t)
+	params := types.DefaultParams()
+	params.FeeHistorySize = 4
+	require.NoError(t, k.SetParams(ctx, params))
+
+	for height := int64(1); height <= 6; height++ {
+		baseFee := sdkmath.NewInt(height * 100)
+		k.SetBlockFeeRecord(ctx, types.BlockFeeRecord{
+			Height:   height,
+			BaseFee:  &baseFee,
+			GasUsed:  uint64(height),
+			GasLimit: 10,
+		})
+	}
+	ctx = ctx.WithBlockHeight(6)
+
+	// the blocks out of the history are overwritten by the later ones
+	_, found := k.GetBlockFeeRecord(ctx, 2)
+	require.False(t, found)
+	record, found := k.GetBlockFeeRecord(ctx, 3)
+	require.True(t, found)
+	require.Equal(t, uint64(3), record.GasUsed)
+
+	res, err := k.BaseFeeAtHeight(ctx, &types.QueryBaseFeeAtHeightRequest{Height: 5})
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(500), *res.BaseFee)
+
+	_, err = k.BaseFeeAtHeight(ctx, &types.QueryBaseFeeAtHeightRequest{Height: 1})
+	require.Error(t, err)
+
+	history, err := k.FeeHistory(ctx, &types.QueryFeeHistoryRequest{BlockCount: 3})
+	require.NoError(t, err)
+	require.Len(t, history.Records, 3)
+	require.Equal(t, int64(4), history.Records[0].Height)
+	require.Equal(t, int64(6), history.Records[2].Height)
+
+	// the blocks out of the history are left out
+	history, err = k.FeeHistory(ctx, &types.QueryFeeHistoryRequest{LastHeight: 4, BlockCount: 4})
+	require.NoError(t, err)
+	require.Len(t, history.Records, 2)
+	require.Equal(t, int64(3), history.Records[0].Height)
+
+	_, err = k.FeeHistory(ctx, &types.QueryFeeHistoryRequest{BlockCount: 5})
+	require.Error(t, err)
+}
@@ -17,6 +17,8 @@ type (
 		cdc                   codec.BinaryCodec
 		storeService          store.KVStoreService
 		transientStoreService store.TransientStoreService
+		bankKeeper            types.BankKeeper
+		stakingKeeper         types.StakingKeeper
 		logger                log.Logger
 
 		// the address capable of executing a MsgUpdateParams message. Typically, this
@@ -29,6 +31,8 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeService store.KVStoreService,
 	transientStoreService store.TransientStoreService,
+	bankKeeper types.BankKeeper,
+	stakingKeeper types.StakingKeeper,
 	logger log.Logger,
 	authority string,
 
@@ -41,6 +45,8 @@ func NewKeeper(
 		cdc:                   cdc,
 		storeService:          storeService,
 		transientStoreService: transientStoreService,
+		bankKeeper:            bankKeeper,
+		stakingKeeper:         stakingKeeper,
 		authority:             authority,
 		logger:                logger,
 	}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/artela-network/artela-rollkit/x/fee/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 initializes the fee distribution params, the collected fees are kept in the fee collector
+// as before until governance updates them.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.BaseFeeBurnRatio = types.DefaultBaseFeeBurnRatio
+	params.TreasuryRatio = types.DefaultTreasuryRatio
+	params.TipRecipient = types.TipRecipient_TIP_RECIPIENT_FEE_COLLECTOR
+	return m.keeper.SetParams(ctx, params)
+}
+
+// Migrate2to3 initializes the size of the fee history, the history starts with the blocks after the upgrade.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.FeeHistorySize = types.DefaultFeeHistorySize
+	return m.keeper.SetParams(ctx, params)
+}
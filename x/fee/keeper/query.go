@@ -6,6 +6,8 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/artela-network/artela-rollkit/x/fee/types"
 )
@@ -38,3 +40,48 @@ func (k Keeper) BlockGas(c context.Context, request *types.QueryBlockGasRequest)
 		Gas: gas.Int64(),
 	}, nil
 }
+
+func (k Keeper) BlockFees(c context.Context, _ *types.QueryBlockFeesRequest) (*types.QueryBlockFeesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryBlockFeesResponse{
+		Fees: k.GetBlockFees(ctx),
+	}, nil
+}
+
+func (k Keeper) BaseFeeAtHeight(c context.Context, req *types.QueryBaseFeeAtHeightRequest) (*types.QueryBaseFeeAtHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	record, found := k.GetBlockFeeRecord(ctx, req.Height)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "base fee of block %d is not kept in the fee history", req.Height)
+	}
+
+	return &types.QueryBaseFeeAtHeightResponse{
+		BaseFee: record.BaseFee,
+	}, nil
+}
+
+func (k Keeper) FeeHistory(c context.Context, req *types.QueryFeeHistoryRequest) (*types.QueryFeeHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	size := k.GetParams(ctx).FeeHistorySize
+	if req.BlockCount == 0 || req.BlockCount > size {
+		return nil, status.Errorf(codes.InvalidArgument, "block count %d should be between 1 and the fee history size %d", req.BlockCount, size)
+	}
+
+	lastHeight := req.LastHeight
+	if lastHeight <= 0 || lastHeight > ctx.BlockHeight() {
+		lastHeight = ctx.BlockHeight()
+	}
+
+	return &types.QueryFeeHistoryResponse{
+		Records: k.feeHistoryRecords(ctx, lastHeight, req.BlockCount),
+	}, nil
+}
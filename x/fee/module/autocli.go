@@ -17,6 +17,23 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Shows the parameters of the module",
 				},
+				{
+					RpcMethod: "BlockFees",
+					Use:       "block-fees",
+					Short:     "Shows the fees collected and distributed in the last block",
+				},
+				{
+					RpcMethod:      "BaseFeeAtHeight",
+					Use:            "base-fee-at-height [height]",
+					Short:          "Shows the base fee of a block kept in the fee history",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "height"}},
+				},
+				{
+					RpcMethod:      "FeeHistory",
+					Use:            "fee-history [block-count]",
+					Short:          "Shows the base fee and the gas of the latest blocks kept in the fee history",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "block_count"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
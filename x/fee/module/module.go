@@ -120,6 +120,14 @@ func NewAppModule(
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
+	}
 }
 
 // RegisterInvariants registers the invariants of the module. If an invariant deviates from its predicted value, the InvariantRegistry triggers appropriate logic (most often the chain will be halted)
@@ -143,7 +151,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // ConsensusVersion is a sequence number for state-breaking change of the module.
 // It should be incremented on each consensus-breaking change introduced by the module.
 // To avoid wrong/empty versions, the initial version should be set to 1.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 3 }
 
 // BeginBlock contains the logic that is automatically triggered at the beginning of each block.
 // The begin block implementation is optional.
@@ -202,6 +210,7 @@ func (am AppModule) EndBlock(ctx context.Context) error {
 	limitedGasWanted := sdkmath.LegacyNewDec(gasWanted.Int64()).Mul(minGasMultiplier)
 	updatedGasWanted := sdkmath.LegacyMaxDec(limitedGasWanted, sdkmath.LegacyNewDec(gasUsed.Int64())).TruncateInt().Uint64()
 	am.keeper.SetBlockGasWanted(ctx, updatedGasWanted)
+	am.keeper.RecordBlockFee(sdkCtx, updatedGasWanted, gasUsed.Uint64())
 
 	defer func() {
 		telemetry.SetGauge(float32(updatedGasWanted), "fee", "block_gas")
@@ -212,6 +221,17 @@ func (am AppModule) EndBlock(ctx context.Context) error {
 		sdk.NewAttribute("height", fmt.Sprintf("%d", sdkCtx.BlockHeight())),
 		sdk.NewAttribute("amount", fmt.Sprintf("%d", updatedGasWanted)),
 	))
+
+	fees := am.keeper.DistributeBlockFees(sdkCtx)
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventFeeDistribution{
+		Height:          fees.Height,
+		Burned:          fees.Burned.String(),
+		Treasury:        fees.Treasury.String(),
+		Proposer:        fees.Proposer.String(),
+		ProposerAddress: fees.ProposerAddress,
+	}); err != nil {
+		sdkCtx.Logger().Error("failed to emit fee distribution event", "error", err)
+	}
 	return nil
 }
 
@@ -243,6 +263,7 @@ type ModuleInputs struct {
 
 	AccountKeeper types.AccountKeeper
 	BankKeeper    types.BankKeeper
+	StakingKeeper types.StakingKeeper
 }
 
 type ModuleOutputs struct {
@@ -262,6 +283,8 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.Cdc,
 		in.StoreService,
 		in.TransientStoreService,
+		in.BankKeeper,
+		in.StakingKeeper,
 		in.Logger,
 		authority.String(),
 	)
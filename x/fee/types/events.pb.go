@@ -123,28 +123,175 @@ func (m *EventBlockGas) GetAmount() string {
 	return ""
 }
 
+// EventFeeDistribution is emitted at the end of each block when the collected fees are distributed
+type EventFeeDistribution struct {
+	// height of the block
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// burned is the amount of the base fees burned
+	Burned string `protobuf:"bytes,2,opt,name=burned,proto3" json:"burned,omitempty"`
+	// treasury is the amount of the fees sent to the treasury
+	Treasury string `protobuf:"bytes,3,opt,name=treasury,proto3" json:"treasury,omitempty"`
+	// proposer is the amount of the fees sent to the block proposer
+	Proposer string `protobuf:"bytes,4,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// proposer_address is the bech32 account address of the block proposer receiving the tips
+	ProposerAddress string `protobuf:"bytes,5,opt,name=proposer_address,json=proposerAddress,proto3" json:"proposer_address,omitempty"`
+}
+
+func (m *EventFeeDistribution) Reset()         { *m = EventFeeDistribution{} }
+func (m *EventFeeDistribution) String() string { return proto.CompactTextString(m) }
+func (*EventFeeDistribution) ProtoMessage()    {}
+func (*EventFeeDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c783d4d5d683e59, []int{2}
+}
+func (m *EventFeeDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFeeDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFeeDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFeeDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFeeDistribution.Merge(m, src)
+}
+func (m *EventFeeDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFeeDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFeeDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFeeDistribution proto.InternalMessageInfo
+
+func (m *EventFeeDistribution) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EventFeeDistribution) GetBurned() string {
+	if m != nil {
+		return m.Burned
+	}
+	return ""
+}
+
+func (m *EventFeeDistribution) GetTreasury() string {
+	if m != nil {
+		return m.Treasury
+	}
+	return ""
+}
+
+func (m *EventFeeDistribution) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *EventFeeDistribution) GetProposerAddress() string {
+	if m != nil {
+		return m.ProposerAddress
+	}
+	return ""
+}
+
+// EventFeeDistributionFailed is emitted when the fees collected in a block cannot be distributed as configured,
+// the fees failed to be distributed are kept in the fee collector
+type EventFeeDistributionFailed struct {
+	// height of the block
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// error is the reason of the failure
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *EventFeeDistributionFailed) Reset()         { *m = EventFeeDistributionFailed{} }
+func (m *EventFeeDistributionFailed) String() string { return proto.CompactTextString(m) }
+func (*EventFeeDistributionFailed) ProtoMessage()    {}
+func (*EventFeeDistributionFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c783d4d5d683e59, []int{3}
+}
+func (m *EventFeeDistributionFailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFeeDistributionFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFeeDistributionFailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFeeDistributionFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFeeDistributionFailed.Merge(m, src)
+}
+func (m *EventFeeDistributionFailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFeeDistributionFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFeeDistributionFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFeeDistributionFailed proto.InternalMessageInfo
+
+func (m *EventFeeDistributionFailed) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EventFeeDistributionFailed) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventFee)(nil), "artela.fee.EventFee")
 	proto.RegisterType((*EventBlockGas)(nil), "artela.fee.EventBlockGas")
+	proto.RegisterType((*EventFeeDistribution)(nil), "artela.fee.EventFeeDistribution")
+	proto.RegisterType((*EventFeeDistributionFailed)(nil), "artela.fee.EventFeeDistributionFailed")
 }
 
 func init() { proto.RegisterFile("artela/fee/events.proto", fileDescriptor_2c783d4d5d683e59) }
 
 var fileDescriptor_2c783d4d5d683e59 = []byte{
-	// 207 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4f, 0x2c, 0x2a, 0x49,
-	0xcd, 0x49, 0xd4, 0x4f, 0x4b, 0x4d, 0xd5, 0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0x29, 0xd6, 0x2b, 0x28,
-	0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x82, 0x48, 0xe8, 0xa5, 0xa5, 0xa6, 0x2a, 0xa9, 0x72, 0x71, 0xb8,
-	0x82, 0xe4, 0xdc, 0x52, 0x53, 0x85, 0x24, 0xb9, 0x38, 0x92, 0x12, 0x8b, 0x53, 0xe3, 0xd3, 0x52,
-	0x53, 0x25, 0x18, 0x15, 0x18, 0x35, 0x38, 0x83, 0xd8, 0x41, 0x7c, 0xb7, 0xd4, 0x54, 0x25, 0x7b,
-	0x2e, 0x5e, 0xb0, 0x32, 0xa7, 0x9c, 0xfc, 0xe4, 0x6c, 0xf7, 0xc4, 0x62, 0x21, 0x31, 0x2e, 0xb6,
-	0x8c, 0xd4, 0xcc, 0xf4, 0x8c, 0x12, 0xa8, 0x4a, 0x28, 0x0f, 0x24, 0x9e, 0x98, 0x9b, 0x5f, 0x9a,
-	0x57, 0x22, 0xc1, 0x04, 0x11, 0x87, 0xf0, 0x9c, 0xfc, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48,
-	0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1,
-	0x58, 0x8e, 0x21, 0xca, 0x24, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f,
-	0xe2, 0x30, 0xdd, 0xbc, 0xd4, 0x92, 0xf2, 0xfc, 0xa2, 0x6c, 0x18, 0xb7, 0x28, 0x3f, 0x27, 0x27,
-	0x3b, 0xb3, 0x44, 0xbf, 0x02, 0xec, 0x95, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x57,
-	0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x55, 0x49, 0x69, 0xa7, 0xe5, 0x00, 0x00, 0x00,
+	// 310 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xc1, 0x4a, 0xc3, 0x40,
+	0x18, 0x84, 0xbb, 0xd6, 0xd6, 0xba, 0x20, 0x4a, 0x28, 0x1a, 0x7b, 0x58, 0x24, 0x20, 0xe8, 0xc1,
+	0xe6, 0xa0, 0x77, 0xb1, 0x68, 0x05, 0x0f, 0x1e, 0x3c, 0x7a, 0x29, 0x9b, 0xe6, 0x6f, 0xbb, 0x34,
+	0xcd, 0x86, 0x7f, 0xff, 0xa8, 0x7d, 0x0b, 0x5f, 0xc3, 0x37, 0xf1, 0xd8, 0xa3, 0x47, 0x69, 0x5e,
+	0x44, 0x92, 0xcd, 0x8a, 0x88, 0xde, 0xf6, 0x9b, 0xf9, 0x99, 0x1d, 0x18, 0x7e, 0x20, 0x91, 0x20,
+	0x91, 0xe1, 0x04, 0x20, 0x84, 0x27, 0x48, 0xc9, 0xf4, 0x33, 0xd4, 0xa4, 0x3d, 0x6e, 0x8d, 0xfe,
+	0x04, 0x20, 0x38, 0xe6, 0x9d, 0x9b, 0xd2, 0x1b, 0x02, 0x78, 0x87, 0xbc, 0x13, 0x49, 0x03, 0xa3,
+	0x09, 0x80, 0xcf, 0x8e, 0xd8, 0xc9, 0xf6, 0xc3, 0x56, 0xc9, 0x43, 0x80, 0xe0, 0x92, 0xef, 0x54,
+	0x67, 0x83, 0x44, 0x8f, 0xe7, 0xb7, 0xd2, 0x78, 0xfb, 0xbc, 0x3d, 0x03, 0x35, 0x9d, 0x51, 0x7d,
+	0x59, 0x53, 0xa9, 0xcb, 0x85, 0xce, 0x53, 0xf2, 0x37, 0xac, 0x6e, 0x29, 0x78, 0x63, 0xbc, 0xeb,
+	0x3e, 0xba, 0x56, 0x86, 0x50, 0x45, 0x39, 0x29, 0x9d, 0xfe, 0x0a, 0x6a, 0xfe, 0x0c, 0x8a, 0x72,
+	0x4c, 0x21, 0x76, 0x41, 0x96, 0xbc, 0x1e, 0xef, 0x10, 0x82, 0x34, 0x39, 0x2e, 0xfd, 0x66, 0xe5,
+	0x7c, 0x73, 0xe9, 0x65, 0xa8, 0x33, 0x6d, 0x00, 0xfd, 0x4d, 0xeb, 0x39, 0xf6, 0x4e, 0xf9, 0x9e,
+	0x7b, 0x8f, 0x64, 0x1c, 0x23, 0x18, 0xe3, 0xb7, 0xaa, 0x9b, 0x5d, 0xa7, 0x5f, 0x59, 0x39, 0xb8,
+	0xe3, 0xbd, 0xbf, 0xaa, 0x0e, 0xa5, 0x4a, 0x20, 0xfe, 0xb7, 0x70, 0x97, 0xb7, 0x00, 0x51, 0x63,
+	0xdd, 0xd7, 0xc2, 0xe0, 0xfe, 0x7d, 0x2d, 0xd8, 0x6a, 0x2d, 0xd8, 0xe7, 0x5a, 0xb0, 0xd7, 0x42,
+	0x34, 0x56, 0x85, 0x68, 0x7c, 0x14, 0xa2, 0xf1, 0x78, 0x31, 0x55, 0x34, 0xcb, 0xa3, 0xfe, 0x58,
+	0x2f, 0x42, 0x3b, 0xc8, 0x59, 0x0a, 0xf4, 0xac, 0x71, 0xee, 0x10, 0x75, 0x92, 0xcc, 0x15, 0x85,
+	0x2f, 0xd5, 0x84, 0xb4, 0xcc, 0xc0, 0x44, 0xed, 0x6a, 0xc2, 0xf3, 0xaf, 0x01, 0x00, 0x31, 0x7c,
+	0x02, 0xb2, 0xdd, 0x01, 0x00, 0x00,
 }
 
 func (m *EventFee) Marshal() (dAtA []byte, err error) {
@@ -214,6 +361,97 @@ func (m *EventBlockGas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventFeeDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFeeDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFeeDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Treasury) > 0 {
+		i -= len(m.Treasury)
+		copy(dAtA[i:], m.Treasury)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Treasury)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Burned) > 0 {
+		i -= len(m.Burned)
+		copy(dAtA[i:], m.Burned)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Burned)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFeeDistributionFailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFeeDistributionFailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFeeDistributionFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -255,6 +493,50 @@ func (m *EventBlockGas) Size() (n int) {
 	return n
 }
 
+func (m *EventFeeDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovEvents(uint64(m.Height))
+	}
+	l = len(m.Burned)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Treasury)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventFeeDistributionFailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovEvents(uint64(m.Height))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -457,6 +739,304 @@ func (m *EventBlockGas) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventFeeDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFeeDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFeeDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burned = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Treasury = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFeeDistributionFailed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFeeDistributionFailed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFeeDistributionFailed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
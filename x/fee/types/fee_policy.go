@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Validate performs a basic validation of the fee policy.
+func (p FeePolicy) Validate() error {
+	if !common.IsHexAddress(p.Contract) {
+		return fmt.Errorf("invalid fee policy contract address %s", p.Contract)
+	}
+
+	if p.MinGasPrice.IsNil() || p.MinGasPrice.IsNegative() {
+		return fmt.Errorf("min gas price of contract %s cannot be negative: %s", p.Contract, p.MinGasPrice)
+	}
+
+	if p.AspectSurcharge.IsNil() || p.AspectSurcharge.IsNegative() {
+		return fmt.Errorf("aspect surcharge of contract %s cannot be negative: %s", p.Contract, p.AspectSurcharge)
+	}
+
+	if err := validateRatio(p.RebateRatio); err != nil {
+		return fmt.Errorf("invalid rebate ratio of contract %s: %w", p.Contract, err)
+	}
+
+	if p.Owner == "" {
+		if p.RebateRatio.IsPositive() {
+			return fmt.Errorf("owner of contract %s is required to credit the rebates", p.Contract)
+		}
+		return nil
+	}
+
+	if _, err := cosmos.AccAddressFromBech32(p.Owner); err != nil {
+		return fmt.Errorf("invalid owner %s of contract %s: %w", p.Owner, p.Contract, err)
+	}
+	return nil
+}
+
+// RequiredGasPrice returns the gas price floor of the txs calling the contract, which is the higher of the
+// global and the contract min gas prices, plus the surcharge if the contract is bound with aspects.
+func (p FeePolicy) RequiredGasPrice(globalMinGasPrice sdkmath.LegacyDec, aspectBound bool) sdkmath.LegacyDec {
+	gasPrice := sdkmath.LegacyMaxDec(globalMinGasPrice, p.MinGasPrice)
+	if aspectBound {
+		gasPrice = gasPrice.Add(p.AspectSurcharge)
+	}
+	return gasPrice
+}
+
+// Rebates returns the share of the fees credited to the contract owner, the results are truncated to integers.
+func (p FeePolicy) Rebates(fees cosmos.Coins) cosmos.Coins {
+	return mulCoinsTruncated(fees, p.RebateRatio)
+}
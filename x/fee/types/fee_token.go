@@ -0,0 +1,36 @@
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	cosmos "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs a basic validation of the fee token.
+func (t FeeToken) Validate() error {
+	if err := cosmos.ValidateDenom(t.Denom); err != nil {
+		return fmt.Errorf("invalid fee token denom %s: %w", t.Denom, err)
+	}
+
+	if t.ConversionRate.IsNil() || !t.ConversionRate.IsPositive() {
+		return fmt.Errorf("conversion rate of fee token %s must be positive: %s", t.Denom, t.ConversionRate)
+	}
+
+	if t.MinGasPrice.IsNil() || t.MinGasPrice.IsNegative() {
+		return fmt.Errorf("min gas price of fee token %s cannot be negative: %s", t.Denom, t.MinGasPrice)
+	}
+	return nil
+}
+
+// ConvertFees converts the fees in the evm denom to the fee token, rounded up so the converted
+// fees always cover the original ones.
+func (t FeeToken) ConvertFees(amount sdkmath.Int) sdkmath.Int {
+	return sdkmath.LegacyNewDecFromInt(amount).Mul(t.ConversionRate).Ceil().TruncateInt()
+}
+
+// ConvertRefund converts the refund in the evm denom to the fee token, truncated so the converted
+// refund never exceeds the fees paid.
+func (t FeeToken) ConvertRefund(amount sdkmath.Int) sdkmath.Int {
+	return sdkmath.LegacyNewDecFromInt(amount).Mul(t.ConversionRate).TruncateInt()
+}
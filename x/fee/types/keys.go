@@ -18,20 +18,26 @@ const (
 const (
 	prefixBlockGasWanted    = iota + 1
 	deprecatedPrefixBaseFee // unused
+	prefixBlockFees
+	prefixFeeHistory
 )
 
 const (
 	prefixTransientBlockGasUsed = iota + 1
+	prefixTransientBlockFees
 )
 
 // KVStore key prefixes
 var (
 	KeyPrefixBlockGasWanted = []byte{prefixBlockGasWanted}
+	KeyPrefixBlockFees      = []byte{prefixBlockFees}
+	KeyPrefixFeeHistory     = []byte{prefixFeeHistory}
 )
 
 // Transient Store key prefixes
 var (
 	KeyPrefixTransientBlockGasWanted = []byte{prefixTransientBlockGasUsed}
+	KeyPrefixTransientBlockFees      = []byte{prefixTransientBlockFees}
 )
 
 // fee module events
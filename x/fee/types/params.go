@@ -6,6 +6,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	cosmos "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/params"
 )
 
@@ -29,6 +30,16 @@ var (
 	DefaultEnableHeight = int64(0)
 	// DefaultNoBaseFee is false
 	DefaultNoBaseFee = false
+	// DefaultBaseFeeBurnRatio is 0 (i.e. no base fee is burned)
+	DefaultBaseFeeBurnRatio = sdkmath.LegacyZeroDec()
+	// DefaultTreasuryRatio is 0 (i.e. no treasury share)
+	DefaultTreasuryRatio = sdkmath.LegacyZeroDec()
+	// DefaultFeeHistorySize is 1024 blocks
+	DefaultFeeHistorySize = uint64(1024)
+	// MaxFeeHistorySize bounds the number of blocks kept in the fee history
+	MaxFeeHistorySize = uint64(65536)
+	// MaxFeePolicies bounds the number of contract fee policies
+	MaxFeePolicies = 256
 )
 
 // Parameter keys
@@ -40,6 +51,13 @@ var (
 	ParamStoreKeyEnableHeight             = []byte("EnableHeight")
 	ParamStoreKeyMinGasPrice              = []byte("MinGasPrice")
 	ParamStoreKeyMinGasMultiplier         = []byte("MinGasMultiplier")
+	ParamStoreKeyBaseFeeBurnRatio         = []byte("BaseFeeBurnRatio")
+	ParamStoreKeyTreasuryRatio            = []byte("TreasuryRatio")
+	ParamStoreKeyTreasuryAddress          = []byte("TreasuryAddress")
+	ParamStoreKeyTipRecipient             = []byte("TipRecipient")
+	ParamStoreKeyFeeHistorySize           = []byte("FeeHistorySize")
+	ParamStoreKeyFeeTokens                = []byte("FeeTokens")
+	ParamStoreKeyFeePolicies              = []byte("FeePolicies")
 )
 
 // ParamKeyTable the param key table for launch module
@@ -65,6 +83,9 @@ func NewParams(
 		EnableHeight:             enableHeight,
 		MinGasPrice:              minGasPrice,
 		MinGasMultiplier:         minGasPriceMultiplier,
+		BaseFeeBurnRatio:         DefaultBaseFeeBurnRatio,
+		TreasuryRatio:            DefaultTreasuryRatio,
+		FeeHistorySize:           DefaultFeeHistorySize,
 	}
 }
 
@@ -78,6 +99,10 @@ func DefaultParams() Params {
 		EnableHeight:             DefaultEnableHeight,
 		MinGasPrice:              DefaultMinGasPrice,
 		MinGasMultiplier:         DefaultMinGasMultiplier,
+		BaseFeeBurnRatio:         DefaultBaseFeeBurnRatio,
+		TreasuryRatio:            DefaultTreasuryRatio,
+		TipRecipient:             TipRecipient_TIP_RECIPIENT_FEE_COLLECTOR,
+		FeeHistorySize:           DefaultFeeHistorySize,
 	}
 }
 
@@ -91,6 +116,13 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableHeight, &p.EnableHeight, validateEnableHeight),
 		paramtypes.NewParamSetPair(ParamStoreKeyMinGasPrice, &p.MinGasPrice, validateMinGasPrice),
 		paramtypes.NewParamSetPair(ParamStoreKeyMinGasMultiplier, &p.MinGasMultiplier, validateMinGasPrice),
+		paramtypes.NewParamSetPair(ParamStoreKeyBaseFeeBurnRatio, &p.BaseFeeBurnRatio, validateRatio),
+		paramtypes.NewParamSetPair(ParamStoreKeyTreasuryRatio, &p.TreasuryRatio, validateRatio),
+		paramtypes.NewParamSetPair(ParamStoreKeyTreasuryAddress, &p.TreasuryAddress, validateTreasuryAddress),
+		paramtypes.NewParamSetPair(ParamStoreKeyTipRecipient, &p.TipRecipient, validateTipRecipient),
+		paramtypes.NewParamSetPair(ParamStoreKeyFeeHistorySize, &p.FeeHistorySize, validateFeeHistorySize),
+		paramtypes.NewParamSetPair(ParamStoreKeyFeeTokens, &p.FeeTokens, validateFeeTokens),
+		paramtypes.NewParamSetPair(ParamStoreKeyFeePolicies, &p.FeePolicies, validateFeePolicies),
 	}
 }
 
@@ -112,7 +144,53 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateMinGasPrice(p.MinGasPrice)
+	if err := validateMinGasPrice(p.MinGasPrice); err != nil {
+		return err
+	}
+
+	if err := validateFeeHistorySize(p.FeeHistorySize); err != nil {
+		return err
+	}
+
+	if err := validateFeeTokens(p.FeeTokens); err != nil {
+		return err
+	}
+
+	if err := validateFeePolicies(p.FeePolicies); err != nil {
+		return err
+	}
+
+	return p.validateFeeDistribution()
+}
+
+// validateFeeDistribution validates the params distributing the collected fees at the end of each block.
+func (p Params) validateFeeDistribution() error {
+	if err := validateRatio(p.BaseFeeBurnRatio); err != nil {
+		return fmt.Errorf("invalid base fee burn ratio: %w", err)
+	}
+
+	if err := validateRatio(p.TreasuryRatio); err != nil {
+		return fmt.Errorf("invalid treasury ratio: %w", err)
+	}
+
+	if p.BaseFeeBurnRatio.Add(p.TreasuryRatio).GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("base fee burn ratio and treasury ratio cannot sum over 1: %s + %s", p.BaseFeeBurnRatio, p.TreasuryRatio)
+	}
+
+	if err := validateTreasuryAddress(p.TreasuryAddress); err != nil {
+		return err
+	}
+
+	if err := validateTipRecipient(p.TipRecipient); err != nil {
+		return err
+	}
+
+	// the treasury address is required once anything is sent to the treasury
+	if p.TreasuryAddress == "" && (p.TreasuryRatio.IsPositive() || p.TipRecipient == TipRecipient_TIP_RECIPIENT_TREASURY) {
+		return fmt.Errorf("treasury address is required to send fees to the treasury")
+	}
+
+	return nil
 }
 
 func validateBool(i interface{}) error {
@@ -212,3 +290,166 @@ func validateMinGasMultiplier(i interface{}) error {
 	}
 	return nil
 }
+
+func validateRatio(i interface{}) error {
+	v, ok := i.(sdkmath.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("invalid parameter: nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("value cannot be negative: %s", v)
+	}
+
+	if v.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("value cannot be greater than 1: %s", v)
+	}
+	return nil
+}
+
+func validateTreasuryAddress(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == "" {
+		return nil
+	}
+
+	if _, err := cosmos.AccAddressFromBech32(v); err != nil {
+		return fmt.Errorf("invalid treasury address %s: %w", v, err)
+	}
+	return nil
+}
+
+func validateTipRecipient(i interface{}) error {
+	v, ok := i.(TipRecipient)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := TipRecipient_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid tip recipient: %d", v)
+	}
+	return nil
+}
+
+func validateFeeHistorySize(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > MaxFeeHistorySize {
+		return fmt.Errorf("fee history size cannot be greater than %d: %d", MaxFeeHistorySize, v)
+	}
+	return nil
+}
+
+func validateFeeTokens(i interface{}) error {
+	v, ok := i.([]FeeToken)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]struct{}, len(v))
+	for _, token := range v {
+		if err := token.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seen[token.Denom]; ok {
+			return fmt.Errorf("duplicated fee token %s", token.Denom)
+		}
+		seen[token.Denom] = struct{}{}
+	}
+	return nil
+}
+
+// GetFeeToken returns the whitelisted fee token of the given denom.
+func (p Params) GetFeeToken(denom string) (FeeToken, bool) {
+	for _, token := range p.FeeTokens {
+		if token.Denom == denom {
+			return token, true
+		}
+	}
+	return FeeToken{}, false
+}
+
+func validateFeePolicies(i interface{}) error {
+	v, ok := i.([]FeePolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if len(v) > MaxFeePolicies {
+		return fmt.Errorf("fee policies cannot be more than %d: %d", MaxFeePolicies, len(v))
+	}
+
+	seen := make(map[common.Address]struct{}, len(v))
+	for _, policy := range v {
+		if err := policy.Validate(); err != nil {
+			return err
+		}
+		contract := common.HexToAddress(policy.Contract)
+		if _, ok := seen[contract]; ok {
+			return fmt.Errorf("duplicated fee policy of contract %s", policy.Contract)
+		}
+		seen[contract] = struct{}{}
+	}
+	return nil
+}
+
+// GetFeePolicy returns the fee policy of the given contract.
+func (p Params) GetFeePolicy(contract common.Address) (FeePolicy, bool) {
+	for _, policy := range p.FeePolicies {
+		if common.HexToAddress(policy.Contract) == contract {
+			return policy, true
+		}
+	}
+	return FeePolicy{}, false
+}
+
+// SplitFees splits the base fees and the tips collected in a block with the fee distribution params,
+// the fees neither burned nor sent to the treasury or the proposer are kept in the fee collector.
+// The base fees paid in the fee tokens are not burned, since the fee tokens are usually IBC vouchers,
+// whose supply is backed by the escrowed tokens on their source chain.
+func (p Params) SplitFees(fees *BlockFees) {
+	burnable := cosmos.Coins{}
+	for _, coin := range fees.BaseFees {
+		if _, isFeeToken := p.GetFeeToken(coin.Denom); !isFeeToken {
+			burnable = burnable.Add(coin)
+		}
+	}
+
+	fees.Burned = mulCoinsTruncated(burnable, p.BaseFeeBurnRatio)
+	fees.Treasury = mulCoinsTruncated(fees.BaseFees, p.TreasuryRatio)
+	fees.Proposer = cosmos.Coins{}
+
+	switch p.TipRecipient {
+	case TipRecipient_TIP_RECIPIENT_PROPOSER:
+		fees.Proposer = fees.Tips
+	case TipRecipient_TIP_RECIPIENT_TREASURY:
+		fees.Treasury = fees.Treasury.Add(fees.Tips...)
+	}
+}
+
+// mulCoinsTruncated multiplies the coins with the given ratio, the results are truncated to integers.
+func mulCoinsTruncated(coins cosmos.Coins, ratio sdkmath.LegacyDec) cosmos.Coins {
+	result := cosmos.Coins{}
+	if ratio.IsNil() || !ratio.IsPositive() {
+		return result
+	}
+
+	for _, coin := range coins {
+		amount := sdkmath.LegacyNewDecFromInt(coin.Amount).Mul(ratio).TruncateInt()
+		if amount.IsPositive() {
+			result = result.Add(cosmos.NewCoin(coin.Denom, amount))
+		}
+	}
+	return result
+}
@@ -25,6 +25,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TipRecipient defines the recipient of the priority tips
+type TipRecipient int32
+
+const (
+	// TIP_RECIPIENT_FEE_COLLECTOR keeps the tips in the fee collector
+	TipRecipient_TIP_RECIPIENT_FEE_COLLECTOR TipRecipient = 0
+	// TIP_RECIPIENT_PROPOSER sends the tips to the block proposer
+	TipRecipient_TIP_RECIPIENT_PROPOSER TipRecipient = 1
+	// TIP_RECIPIENT_TREASURY sends the tips to the treasury
+	TipRecipient_TIP_RECIPIENT_TREASURY TipRecipient = 2
+)
+
+var TipRecipient_name = map[int32]string{
+	0: "TIP_RECIPIENT_FEE_COLLECTOR",
+	1: "TIP_RECIPIENT_PROPOSER",
+	2: "TIP_RECIPIENT_TREASURY",
+}
+
+var TipRecipient_value = map[string]int32{
+	"TIP_RECIPIENT_FEE_COLLECTOR": 0,
+	"TIP_RECIPIENT_PROPOSER":      1,
+	"TIP_RECIPIENT_TREASURY":      2,
+}
+
+func (x TipRecipient) String() string {
+	return proto.EnumName(TipRecipient_name, int32(x))
+}
+
+func (TipRecipient) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ab62087428e99368, []int{0}
+}
+
 // Params defines the parameters for the module.
 type Params struct {
 	// no_base_fee forces the EIP-1559 base fee to 0 (needed for 0 price calls)
@@ -44,6 +76,23 @@ type Params struct {
 	// min_gas_multiplier bounds the minimum gas used to be charged
 	// to senders based on gas limit
 	MinGasMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_multiplier"`
+	// base_fee_burn_ratio is the share of the base fees burned at the end of each block,
+	// the base fees paid in the fee tokens are never burned, their burn share is kept in the fee collector.
+	BaseFeeBurnRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=base_fee_burn_ratio,json=baseFeeBurnRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee_burn_ratio"`
+	// treasury_ratio is the share of the base fees sent to the treasury at the end of each block,
+	// the base fees neither burned nor sent to the treasury are kept in the fee collector.
+	TreasuryRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=treasury_ratio,json=treasuryRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"treasury_ratio"`
+	// treasury_address is the bech32 address of the treasury account, required if treasury_ratio is not zero.
+	TreasuryAddress string `protobuf:"bytes,10,opt,name=treasury_address,json=treasuryAddress,proto3" json:"treasury_address,omitempty"`
+	// tip_recipient defines where the priority tips are sent at the end of each block.
+	TipRecipient TipRecipient `protobuf:"varint,11,opt,name=tip_recipient,json=tipRecipient,proto3,enum=artela.fee.TipRecipient" json:"tip_recipient,omitempty"`
+	// fee_history_size is the number of the latest blocks whose base fee and gas are kept in the fee history,
+	// the history is disabled if it is zero.
+	FeeHistorySize uint64 `protobuf:"varint,12,opt,name=fee_history_size,json=feeHistorySize,proto3" json:"fee_history_size,omitempty"`
+	// fee_tokens are the non-native denoms whitelisted to pay the evm gas.
+	FeeTokens []FeeToken `protobuf:"bytes,13,rep,name=fee_tokens,json=feeTokens,proto3" json:"fee_tokens"`
+	// fee_policies are the fee policies of the contracts priced or subsidised differently from the global params.
+	FeePolicies []FeePolicy `protobuf:"bytes,14,rep,name=fee_policies,json=feePolicies,proto3" json:"fee_policies"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -107,41 +156,218 @@ func (m *Params) GetEnableHeight() int64 {
 	return 0
 }
 
+func (m *Params) GetTreasuryAddress() string {
+	if m != nil {
+		return m.TreasuryAddress
+	}
+	return ""
+}
+
+func (m *Params) GetTipRecipient() TipRecipient {
+	if m != nil {
+		return m.TipRecipient
+	}
+	return TipRecipient_TIP_RECIPIENT_FEE_COLLECTOR
+}
+
+func (m *Params) GetFeeHistorySize() uint64 {
+	if m != nil {
+		return m.FeeHistorySize
+	}
+	return 0
+}
+
+func (m *Params) GetFeeTokens() []FeeToken {
+	if m != nil {
+		return m.FeeTokens
+	}
+	return nil
+}
+
+func (m *Params) GetFeePolicies() []FeePolicy {
+	if m != nil {
+		return m.FeePolicies
+	}
+	return nil
+}
+
+// FeeToken defines a non-native denom paying the evm gas, the fees are converted from the evm denom
+// with the conversion rate.
+type FeeToken struct {
+	// denom of the fee token
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// conversion_rate is the amount of the fee token paying for one unit of the evm denom
+	ConversionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=conversion_rate,json=conversionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"conversion_rate"`
+	// min_gas_price is the minimum gas price in the fee token, the txs paying less are rejected
+	MinGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=min_gas_price,json=minGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_price"`
+}
+
+func (m *FeeToken) Reset()         { *m = FeeToken{} }
+func (m *FeeToken) String() string { return proto.CompactTextString(m) }
+func (*FeeToken) ProtoMessage()    {}
+func (*FeeToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab62087428e99368, []int{1}
+}
+func (m *FeeToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeToken.Merge(m, src)
+}
+func (m *FeeToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeToken proto.InternalMessageInfo
+
+func (m *FeeToken) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// FeePolicy defines the fee policy of the evm txs calling a contract
+type FeePolicy struct {
+	// contract is the hex address of the contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// min_gas_price is the gas price floor of the txs calling the contract, the global min_gas_price
+	// is applied if it is higher
+	MinGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=min_gas_price,json=minGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_price"`
+	// aspect_surcharge is the gas price added to the floor if the contract is bound with aspects
+	// executed along with its txs. Only the contract called by the tx is checked, the inner calls
+	// into other aspect bound contracts are not surcharged.
+	AspectSurcharge cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=aspect_surcharge,json=aspectSurcharge,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"aspect_surcharge"`
+	// rebate_ratio is the share of the fees paid for the used gas credited to the contract owner
+	RebateRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=rebate_ratio,json=rebateRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rebate_ratio"`
+	// owner is the bech32 address of the contract owner receiving the rebates, required if rebate_ratio is not zero
+	Owner string `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *FeePolicy) Reset()         { *m = FeePolicy{} }
+func (m *FeePolicy) String() string { return proto.CompactTextString(m) }
+func (*FeePolicy) ProtoMessage()    {}
+func (*FeePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab62087428e99368, []int{2}
+}
+func (m *FeePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeePolicy.Merge(m, src)
+}
+func (m *FeePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeePolicy proto.InternalMessageInfo
+
+func (m *FeePolicy) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *FeePolicy) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("artela.fee.TipRecipient", TipRecipient_name, TipRecipient_value)
 	proto.RegisterType((*Params)(nil), "artela.fee.Params")
+	proto.RegisterType((*FeeToken)(nil), "artela.fee.FeeToken")
+	proto.RegisterType((*FeePolicy)(nil), "artela.fee.FeePolicy")
 }
 
 func init() { proto.RegisterFile("artela/fee/params.proto", fileDescriptor_ab62087428e99368) }
 
 var fileDescriptor_ab62087428e99368 = []byte{
-	// 419 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0x63, 0x0a, 0xdd, 0xe6, 0x51, 0x09, 0xcc, 0x26, 0xa2, 0x0d, 0xd2, 0x88, 0x5d, 0x22,
-	0x24, 0x92, 0xc3, 0x38, 0x20, 0x24, 0x2e, 0x65, 0x62, 0x20, 0x01, 0x1a, 0x39, 0x72, 0xb1, 0x9c,
-	0xec, 0x35, 0xb1, 0x1a, 0xdb, 0x91, 0xed, 0x09, 0xfa, 0x15, 0x38, 0xf1, 0x11, 0xf8, 0x08, 0x5c,
-	0xf8, 0x0e, 0x3b, 0xee, 0x88, 0x38, 0x54, 0xa8, 0x3d, 0xc0, 0xc7, 0x40, 0xb5, 0xd3, 0x16, 0x89,
-	0x0b, 0x97, 0xe8, 0xe5, 0xfd, 0xdf, 0xef, 0xaf, 0xf7, 0xf4, 0x37, 0xbe, 0xcb, 0xb4, 0x85, 0x86,
-	0x65, 0x63, 0x80, 0xac, 0x65, 0x9a, 0x09, 0x93, 0xb6, 0x5a, 0x59, 0x45, 0xb0, 0x17, 0xd2, 0x31,
-	0xc0, 0xc1, 0x6d, 0x26, 0xb8, 0x54, 0x99, 0xfb, 0x7a, 0xf9, 0x60, 0xaf, 0x52, 0x95, 0x72, 0x65,
-	0xb6, 0xac, 0x7c, 0xf7, 0xc1, 0xb7, 0x1e, 0xee, 0x9f, 0x39, 0x17, 0x12, 0xe1, 0x5d, 0xa9, 0x68,
-	0xc1, 0x0c, 0xd0, 0x31, 0x40, 0x88, 0x62, 0x94, 0x6c, 0xe7, 0x3b, 0x52, 0x8d, 0x98, 0x81, 0x17,
-	0x00, 0xe4, 0x19, 0x3e, 0x5c, 0x89, 0xb4, 0xac, 0x99, 0xac, 0x80, 0x9e, 0x83, 0x54, 0x82, 0x4b,
-	0x66, 0x95, 0x0e, 0xaf, 0xc5, 0x28, 0x19, 0xe4, 0x61, 0xe1, 0xa7, 0x9f, 0xbb, 0x81, 0x93, 0x8d,
-	0x4e, 0x8e, 0xf1, 0x3e, 0x34, 0xcc, 0x58, 0x5e, 0x72, 0x3b, 0xa5, 0xe2, 0xa2, 0xb1, 0xbc, 0x6d,
-	0x38, 0xe8, 0xb0, 0xe7, 0xc0, 0xbd, 0x8d, 0xf8, 0x66, 0xad, 0x91, 0x23, 0x3c, 0x00, 0xc9, 0x8a,
-	0x06, 0x68, 0x0d, 0xbc, 0xaa, 0x6d, 0x78, 0x3d, 0x46, 0x49, 0x2f, 0xbf, 0xe9, 0x9b, 0x2f, 0x5d,
-	0x8f, 0x3c, 0xc1, 0xdb, 0xeb, 0xad, 0x6f, 0xc4, 0x28, 0xd9, 0x19, 0xdd, 0xbf, 0x9c, 0x0d, 0x83,
-	0x1f, 0xb3, 0xe1, 0x7e, 0xa9, 0x8c, 0x50, 0xc6, 0x9c, 0x4f, 0x52, 0xae, 0x32, 0xc1, 0x6c, 0x9d,
-	0xbe, 0x92, 0x36, 0xdf, 0xea, 0x96, 0x24, 0xa7, 0x78, 0x20, 0xb8, 0xa4, 0x15, 0x33, 0xb4, 0xd5,
-	0xbc, 0x84, 0xb0, 0xef, 0xf0, 0xa3, 0x0e, 0x3f, 0xfc, 0x17, 0x7f, 0x0d, 0x15, 0x2b, 0xa7, 0x27,
-	0x50, 0xe6, 0xbb, 0x82, 0xcb, 0x53, 0x66, 0xce, 0x96, 0x1c, 0x79, 0x87, 0xc9, 0xca, 0xe8, 0xaf,
-	0xcb, 0xb6, 0xfe, 0xdf, 0xed, 0x96, 0x77, 0xdb, 0x9c, 0xfe, 0xf4, 0xde, 0xef, 0x2f, 0x43, 0xf4,
-	0xe9, 0xd7, 0xd7, 0x87, 0x77, 0xba, 0xc0, 0x3f, 0xba, 0xc8, 0x7d, 0x58, 0xa3, 0xb7, 0x97, 0xf3,
-	0x08, 0x5d, 0xcd, 0x23, 0xf4, 0x73, 0x1e, 0xa1, 0xcf, 0x8b, 0x28, 0xb8, 0x5a, 0x44, 0xc1, 0xf7,
-	0x45, 0x14, 0xbc, 0x7f, 0x5c, 0x71, 0x5b, 0x5f, 0x14, 0x69, 0xa9, 0x44, 0xe6, 0xc9, 0x47, 0x12,
-	0xec, 0x07, 0xa5, 0x27, 0xab, 0x5f, 0xad, 0x9a, 0x66, 0xc2, 0x6d, 0x67, 0x68, 0xa7, 0x2d, 0x98,
-	0xa2, 0xef, 0x9e, 0xc3, 0xf1, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa9, 0xec, 0x18, 0xa3, 0x5e,
-	0x02, 0x00, 0x00,
+	// 805 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xf6, 0x3a, 0x1f, 0xb5, 0xc7, 0x1f, 0x31, 0x53, 0x07, 0x56, 0x09, 0x38, 0x56, 0x7a, 0x59,
+	0x22, 0x61, 0x4b, 0x2d, 0x07, 0x54, 0xa9, 0x48, 0xb5, 0xeb, 0xb4, 0x41, 0x21, 0x36, 0x63, 0x73,
+	0x80, 0xcb, 0x6a, 0xbc, 0x7e, 0xbd, 0x1e, 0x79, 0x77, 0x66, 0x35, 0x33, 0xa6, 0xb8, 0x3f, 0x81,
+	0x13, 0x3f, 0x81, 0x03, 0x07, 0x8e, 0xfd, 0x03, 0xdc, 0x7b, 0xec, 0x11, 0x71, 0xa8, 0x50, 0x72,
+	0x28, 0x27, 0x7e, 0x03, 0xda, 0x9d, 0x5d, 0xdb, 0x90, 0x1e, 0x92, 0x5e, 0xac, 0x7d, 0x9f, 0x67,
+	0x9e, 0x67, 0xe7, 0x9d, 0x79, 0x1f, 0x2f, 0xfa, 0x88, 0x4a, 0x0d, 0x01, 0x6d, 0x4f, 0x01, 0xda,
+	0x11, 0x95, 0x34, 0x54, 0xad, 0x48, 0x0a, 0x2d, 0x30, 0x32, 0x44, 0x6b, 0x0a, 0x70, 0xf0, 0x01,
+	0x0d, 0x19, 0x17, 0xed, 0xe4, 0xd7, 0xd0, 0x07, 0x75, 0x5f, 0xf8, 0x22, 0x79, 0x6c, 0xc7, 0x4f,
+	0x06, 0x3d, 0xfe, 0x67, 0x17, 0xed, 0x0e, 0x12, 0x17, 0xdc, 0x40, 0x25, 0x2e, 0xdc, 0x31, 0x55,
+	0xe0, 0x4e, 0x01, 0x6c, 0xab, 0x69, 0x39, 0x05, 0x52, 0xe4, 0xa2, 0x43, 0x15, 0x9c, 0x02, 0xe0,
+	0x47, 0xe8, 0x30, 0x23, 0x5d, 0x6f, 0x46, 0xb9, 0x0f, 0xee, 0x04, 0xb8, 0x08, 0x19, 0xa7, 0x5a,
+	0x48, 0x3b, 0xdf, 0xb4, 0x9c, 0x0a, 0xb1, 0xc7, 0x66, 0x75, 0x37, 0x59, 0xf0, 0x64, 0xcd, 0xe3,
+	0x07, 0x68, 0x1f, 0x02, 0xaa, 0x34, 0xf3, 0x98, 0x5e, 0xba, 0xe1, 0x22, 0xd0, 0x2c, 0x0a, 0x18,
+	0x48, 0x7b, 0x2b, 0x11, 0xd6, 0xd7, 0xe4, 0xd7, 0x2b, 0x0e, 0xdf, 0x43, 0x15, 0xe0, 0x74, 0x1c,
+	0x80, 0x3b, 0x03, 0xe6, 0xcf, 0xb4, 0xbd, 0xdd, 0xb4, 0x9c, 0x2d, 0x52, 0x36, 0xe0, 0xb3, 0x04,
+	0xc3, 0x5f, 0xa0, 0xc2, 0x6a, 0xd7, 0x3b, 0x4d, 0xcb, 0x29, 0x76, 0x3e, 0x79, 0xf5, 0xe6, 0x28,
+	0xf7, 0xe7, 0x9b, 0xa3, 0x7d, 0x4f, 0xa8, 0x50, 0x28, 0x35, 0x99, 0xb7, 0x98, 0x68, 0x87, 0x54,
+	0xcf, 0x5a, 0x67, 0x5c, 0x93, 0x3b, 0xe9, 0x26, 0xf1, 0x53, 0x54, 0x09, 0x19, 0x77, 0x7d, 0xaa,
+	0xdc, 0x48, 0x32, 0x0f, 0xec, 0xdd, 0x44, 0x7e, 0x2f, 0x95, 0x1f, 0x5e, 0x97, 0x9f, 0x83, 0x4f,
+	0xbd, 0xe5, 0x13, 0xf0, 0x48, 0x29, 0x64, 0xfc, 0x29, 0x55, 0x83, 0x58, 0x87, 0xbf, 0x41, 0x38,
+	0x33, 0xda, 0xe8, 0xec, 0xce, 0xcd, 0xdd, 0x6a, 0xc6, 0x6d, 0xa3, 0x75, 0x82, 0xee, 0xae, 0x8e,
+	0x7b, 0xbc, 0x90, 0xdc, 0x95, 0x54, 0x33, 0x61, 0x17, 0x6e, 0xe1, 0x99, 0xb6, 0xd9, 0x59, 0x48,
+	0x4e, 0x62, 0x31, 0xfe, 0x0a, 0x55, 0xb5, 0x04, 0xaa, 0x16, 0x72, 0x99, 0xda, 0x15, 0x6f, 0x6e,
+	0x57, 0xc9, 0xa4, 0xc6, 0xeb, 0x53, 0x54, 0x5b, 0x79, 0xd1, 0xc9, 0x44, 0x82, 0x52, 0x36, 0x8a,
+	0xdd, 0xc8, 0x5e, 0x86, 0x3f, 0x36, 0x30, 0x7e, 0x84, 0x2a, 0x9a, 0x45, 0xae, 0x04, 0x8f, 0x45,
+	0x0c, 0xb8, 0xb6, 0x4b, 0x4d, 0xcb, 0xa9, 0xde, 0xb7, 0x5b, 0xeb, 0x89, 0x6d, 0x8d, 0x58, 0x44,
+	0x32, 0x9e, 0x94, 0xf5, 0x46, 0x85, 0x1d, 0x54, 0x8b, 0x0f, 0x61, 0xc6, 0x94, 0x16, 0x72, 0xe9,
+	0x2a, 0xf6, 0x02, 0xec, 0x72, 0xd3, 0x72, 0xb6, 0x49, 0x75, 0x0a, 0xf0, 0xcc, 0xc0, 0x43, 0xf6,
+	0x02, 0xf0, 0x97, 0x08, 0xc5, 0x2b, 0xb5, 0x98, 0x03, 0x57, 0x76, 0xa5, 0xb9, 0xe5, 0x94, 0xee,
+	0xd7, 0x37, 0xdf, 0x72, 0x0a, 0x30, 0x8a, 0xc9, 0x4e, 0x31, 0xee, 0xf8, 0xb7, 0xb7, 0x2f, 0x4f,
+	0x2c, 0x52, 0x9c, 0xa6, 0xa0, 0xc2, 0x5d, 0x54, 0x8e, 0xf5, 0x91, 0x08, 0x98, 0xc7, 0x40, 0xd9,
+	0xd5, 0xc4, 0x61, 0xff, 0x7f, 0x0e, 0x83, 0x98, 0x5e, 0x6e, 0x5a, 0x94, 0xa6, 0x29, 0xca, 0x40,
+	0x3d, 0xfc, 0xf8, 0xef, 0x5f, 0x8e, 0xac, 0x9f, 0xde, 0xbe, 0x3c, 0xb9, 0x9b, 0x26, 0xf5, 0xc7,
+	0x24, 0xab, 0x26, 0x65, 0xc7, 0xbf, 0x5b, 0xa8, 0x90, 0xed, 0x02, 0xd7, 0xd1, 0x4e, 0x12, 0xa1,
+	0x24, 0x6c, 0x45, 0x62, 0x0a, 0x7c, 0x8e, 0xf6, 0x3c, 0xc1, 0x7f, 0x00, 0xa9, 0x98, 0x48, 0xae,
+	0x1d, 0xec, 0xfc, 0xcd, 0xaf, 0xa9, 0xba, 0xd6, 0x12, 0xaa, 0xdf, 0x31, 0xe3, 0x5b, 0xef, 0x37,
+	0xe3, 0x0f, 0xb7, 0xe3, 0xbe, 0x8e, 0x7f, 0xcd, 0xa3, 0xe2, 0xea, 0x0c, 0xf0, 0x01, 0x2a, 0x78,
+	0x82, 0x6b, 0x49, 0x3d, 0x9d, 0xf6, 0xb0, 0xaa, 0xaf, 0xbf, 0x38, 0xff, 0x9e, 0xe1, 0xba, 0x40,
+	0x35, 0xaa, 0x22, 0xf0, 0xb4, 0xab, 0x16, 0xd2, 0x9b, 0x51, 0xe9, 0xdf, 0xaa, 0x89, 0x3d, 0x23,
+	0x1e, 0x66, 0x5a, 0x7c, 0x8a, 0xca, 0x12, 0xc6, 0x54, 0x43, 0x9a, 0x81, 0xed, 0x5b, 0xec, 0xcb,
+	0x08, 0x4d, 0x02, 0xea, 0x68, 0x47, 0x3c, 0xe7, 0x20, 0xcd, 0x9f, 0x0e, 0x31, 0x85, 0x39, 0xa6,
+	0x13, 0x1f, 0x95, 0x37, 0x27, 0x1a, 0x1f, 0xa1, 0xc3, 0xd1, 0xd9, 0xc0, 0x25, 0xbd, 0xee, 0xd9,
+	0xe0, 0xac, 0x77, 0x31, 0x72, 0x4f, 0x7b, 0x3d, 0xb7, 0xdb, 0x3f, 0x3f, 0xef, 0x75, 0x47, 0x7d,
+	0x52, 0xcb, 0xe1, 0x03, 0xf4, 0xe1, 0x7f, 0x17, 0x0c, 0x48, 0x7f, 0xd0, 0x1f, 0xf6, 0x48, 0xcd,
+	0xba, 0xce, 0x8d, 0x48, 0xef, 0xf1, 0xf0, 0x5b, 0xf2, 0x5d, 0x2d, 0xdf, 0xb9, 0x78, 0x75, 0xd9,
+	0xb0, 0x5e, 0x5f, 0x36, 0xac, 0xbf, 0x2e, 0x1b, 0xd6, 0xcf, 0x57, 0x8d, 0xdc, 0xeb, 0xab, 0x46,
+	0xee, 0x8f, 0xab, 0x46, 0xee, 0xfb, 0xcf, 0x7d, 0xa6, 0x67, 0x8b, 0x71, 0xcb, 0x13, 0x61, 0xdb,
+	0x4c, 0xe2, 0x67, 0x1c, 0xf4, 0x73, 0x21, 0xe7, 0x59, 0x29, 0x45, 0x10, 0xcc, 0x99, 0x4e, 0x07,
+	0x54, 0x2f, 0x23, 0x50, 0xe3, 0xdd, 0xe4, 0xbb, 0xf0, 0xe0, 0xdf, 0x01, 0x00, 0x07, 0x9f, 0x1b,
+	0x6b, 0x67, 0x06, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -184,6 +410,103 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinGasMultiplier.Equal(that1.MinGasMultiplier) {
 		return false
 	}
+	if !this.BaseFeeBurnRatio.Equal(that1.BaseFeeBurnRatio) {
+		return false
+	}
+	if !this.TreasuryRatio.Equal(that1.TreasuryRatio) {
+		return false
+	}
+	if this.TreasuryAddress != that1.TreasuryAddress {
+		return false
+	}
+	if this.TipRecipient != that1.TipRecipient {
+		return false
+	}
+	if this.FeeHistorySize != that1.FeeHistorySize {
+		return false
+	}
+	if len(this.FeeTokens) != len(that1.FeeTokens) {
+		return false
+	}
+	for i := range this.FeeTokens {
+		if !this.FeeTokens[i].Equal(&that1.FeeTokens[i]) {
+			return false
+		}
+	}
+	if len(this.FeePolicies) != len(that1.FeePolicies) {
+		return false
+	}
+	for i := range this.FeePolicies {
+		if !this.FeePolicies[i].Equal(&that1.FeePolicies[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *FeeToken) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeToken)
+	if !ok {
+		that2, ok := that.(FeeToken)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.ConversionRate.Equal(that1.ConversionRate) {
+		return false
+	}
+	if !this.MinGasPrice.Equal(that1.MinGasPrice) {
+		return false
+	}
+	return true
+}
+func (this *FeePolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeePolicy)
+	if !ok {
+		that2, ok := that.(FeePolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	if !this.MinGasPrice.Equal(that1.MinGasPrice) {
+		return false
+	}
+	if !this.AspectSurcharge.Equal(that1.AspectSurcharge) {
+		return false
+	}
+	if !this.RebateRatio.Equal(that1.RebateRatio) {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -206,6 +529,71 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeePolicies) > 0 {
+		for iNdEx := len(m.FeePolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeePolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.FeeTokens) > 0 {
+		for iNdEx := len(m.FeeTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.FeeHistorySize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FeeHistorySize))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.TipRecipient != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TipRecipient))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.TreasuryAddress) > 0 {
+		i -= len(m.TreasuryAddress)
+		copy(dAtA[i:], m.TreasuryAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.TreasuryAddress)))
+		i--
+		dAtA[i] = 0x52
+	}
+	{
+		size := m.TreasuryRatio.Size()
+		i -= size
+		if _, err := m.TreasuryRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.BaseFeeBurnRatio.Size()
+		i -= size
+		if _, err := m.BaseFeeBurnRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	{
 		size := m.MinGasMultiplier.Size()
 		i -= size
@@ -264,42 +652,225 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *FeeToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *FeeToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NoBaseFee {
-		n += 2
+	{
+		size := m.MinGasPrice.Size()
+		i -= size
+		if _, err := m.MinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
-	if m.BaseFeeChangeDenominator != 0 {
-		n += 1 + sovParams(uint64(m.BaseFeeChangeDenominator))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ConversionRate.Size()
+		i -= size
+		if _, err := m.ConversionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
-	if m.ElasticityMultiplier != 0 {
-		n += 1 + sovParams(uint64(m.ElasticityMultiplier))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.EnableHeight != 0 {
-		n += 1 + sovParams(uint64(m.EnableHeight))
+	return len(dAtA) - i, nil
+}
+
+func (m *FeePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.BaseFee.Size()
-	n += 1 + l + sovParams(uint64(l))
-	l = m.MinGasPrice.Size()
-	n += 1 + l + sovParams(uint64(l))
-	l = m.MinGasMultiplier.Size()
-	n += 1 + l + sovParams(uint64(l))
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *FeePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.RebateRatio.Size()
+		i -= size
+		if _, err := m.RebateRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.AspectSurcharge.Size()
+		i -= size
+		if _, err := m.AspectSurcharge.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinGasPrice.Size()
+		i -= size
+		if _, err := m.MinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NoBaseFee {
+		n += 2
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		n += 1 + sovParams(uint64(m.BaseFeeChangeDenominator))
+	}
+	if m.ElasticityMultiplier != 0 {
+		n += 1 + sovParams(uint64(m.ElasticityMultiplier))
+	}
+	if m.EnableHeight != 0 {
+		n += 1 + sovParams(uint64(m.EnableHeight))
+	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MinGasMultiplier.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.BaseFeeBurnRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.TreasuryRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.TreasuryAddress)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.TipRecipient != 0 {
+		n += 1 + sovParams(uint64(m.TipRecipient))
+	}
+	if m.FeeHistorySize != 0 {
+		n += 1 + sovParams(uint64(m.FeeHistorySize))
+	}
+	if len(m.FeeTokens) > 0 {
+		for _, e := range m.FeeTokens {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.FeePolicies) > 0 {
+		for _, e := range m.FeePolicies {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.ConversionRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *FeePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.AspectSurcharge.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.RebateRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
 }
 
 func sovParams(x uint64) (n int) {
@@ -516,6 +1087,578 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeBurnRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFeeBurnRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TreasuryRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TreasuryAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TipRecipient", wireType)
+			}
+			m.TipRecipient = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TipRecipient |= TipRecipient(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeHistorySize", wireType)
+			}
+			m.FeeHistorySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeHistorySize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTokens = append(m.FeeTokens, FeeToken{})
+			if err := m.FeeTokens[len(m.FeeTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePolicies = append(m.FeePolicies, FeePolicy{})
+			if err := m.FeePolicies[len(m.FeePolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConversionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AspectSurcharge", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AspectSurcharge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebateRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RebateRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
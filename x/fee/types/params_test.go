@@ -0,0 +1,266 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/artela-network/artela-rollkit/x/fee/types"
+)
+
+const testTreasury = "cosmos1cwwv22j5ca08ggdv9c2uky355k908694z577tv"
+
+func TestParamsValidateFeeDistribution(t *testing.T) {
+	tests := []struct {
+		desc   string
+		modify func(p *types.Params)
+		valid  bool
+	}{
+		{
+			desc:   "default is valid",
+			modify: func(p *types.Params) {},
+			valid:  true,
+		},
+		{
+			desc: "burn and treasury shares",
+			modify: func(p *types.Params) {
+				p.BaseFeeBurnRatio = sdkmath.LegacyNewDecWithPrec(7, 1)
+				p.TreasuryRatio = sdkmath.LegacyNewDecWithPrec(3, 1)
+				p.TreasuryAddress = testTreasury
+			},
+			valid: true,
+		},
+		{
+			desc: "shares over 1",
+			modify: func(p *types.Params) {
+				p.BaseFeeBurnRatio = sdkmath.LegacyNewDecWithPrec(8, 1)
+				p.TreasuryRatio = sdkmath.LegacyNewDecWithPrec(3, 1)
+				p.TreasuryAddress = testTreasury
+			},
+			valid: false,
+		},
+		{
+			desc: "negative burn ratio",
+			modify: func(p *types.Params) {
+				p.BaseFeeBurnRatio = sdkmath.LegacyNewDec(-1)
+			},
+			valid: false,
+		},
+		{
+			desc: "treasury share without treasury address",
+			modify: func(p *types.Params) {
+				p.TreasuryRatio = sdkmath.LegacyNewDecWithPrec(1, 1)
+			},
+			valid: false,
+		},
+		{
+			desc: "tips to treasury without treasury address",
+			modify: func(p *types.Params) {
+				p.TipRecipient = types.TipRecipient_TIP_RECIPIENT_TREASURY
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid treasury address",
+			modify: func(p *types.Params) {
+				p.TreasuryAddress = "invalid"
+			},
+			valid: false,
+		},
+		{
+			desc: "fee history size over max",
+			modify: func(p *types.Params) {
+				p.FeeHistorySize = types.MaxFeeHistorySize + 1
+			},
+			valid: false,
+		},
+		{
+			desc: "fee tokens",
+			modify: func(p *types.Params) {
+				p.FeeTokens = []types.FeeToken{
+					{Denom: "ibc/usdc", ConversionRate: sdkmath.LegacyNewDecWithPrec(2, 12), MinGasPrice: sdkmath.LegacyZeroDec()},
+				}
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated fee tokens",
+			modify: func(p *types.Params) {
+				token := types.FeeToken{Denom: "ibc/usdc", ConversionRate: sdkmath.LegacyOneDec(), MinGasPrice: sdkmath.LegacyZeroDec()}
+				p.FeeTokens = []types.FeeToken{token, token}
+			},
+			valid: false,
+		},
+		{
+			desc: "fee token without conversion rate",
+			modify: func(p *types.Params) {
+				p.FeeTokens = []types.FeeToken{
+					{Denom: "ibc/usdc", ConversionRate: sdkmath.LegacyZeroDec(), MinGasPrice: sdkmath.LegacyZeroDec()},
+				}
+			},
+			valid: false,
+		},
+		{
+			desc: "fee token with negative min gas price",
+			modify: func(p *types.Params) {
+				p.FeeTokens = []types.FeeToken{
+					{Denom: "ibc/usdc", ConversionRate: sdkmath.LegacyOneDec(), MinGasPrice: sdkmath.LegacyNewDec(-1)},
+				}
+			},
+			valid: false,
+		},
+		{
+			desc: "fee policy with rebates",
+			modify: func(p *types.Params) {
+				p.FeePolicies = []types.FeePolicy{newTestFeePolicy()}
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated fee policies",
+			modify: func(p *types.Params) {
+				p.FeePolicies = []types.FeePolicy{newTestFeePolicy(), newTestFeePolicy()}
+			},
+			valid: false,
+		},
+		{
+			desc: "fee policy with invalid contract",
+			modify: func(p *types.Params) {
+				policy := newTestFeePolicy()
+				policy.Contract = "0x01"
+				p.FeePolicies = []types.FeePolicy{policy}
+			},
+			valid: false,
+		},
+		{
+			desc: "fee policy rebates without owner",
+			modify: func(p *types.Params) {
+				policy := newTestFeePolicy()
+				policy.Owner = ""
+				p.FeePolicies = []types.FeePolicy{policy}
+			},
+			valid: false,
+		},
+		{
+			desc: "fee policy rebate ratio over 1",
+			modify: func(p *types.Params) {
+				policy := newTestFeePolicy()
+				policy.RebateRatio = sdkmath.LegacyNewDecWithPrec(11, 1)
+				p.FeePolicies = []types.FeePolicy{policy}
+			},
+			valid: false,
+		},
+		{
+			desc: "unknown tip recipient",
+			modify: func(p *types.Params) {
+				p.TipRecipient = types.TipRecipient(10)
+			},
+			valid: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			params := types.DefaultParams()
+			tc.modify(&params)
+
+			err := params.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func newTestFeePolicy() types.FeePolicy {
+	return types.FeePolicy{
+		Contract:        "0x0000000000000000000000000000000000000abc",
+		MinGasPrice:     sdkmath.LegacyNewDec(100),
+		AspectSurcharge: sdkmath.LegacyNewDec(20),
+		RebateRatio:     sdkmath.LegacyNewDecWithPrec(1, 1),
+		Owner:           testTreasury,
+	}
+}
+
+func TestFeePolicy(t *testing.T) {
+	policy := newTestFeePolicy()
+
+	// the higher floor applies, and the surcharge is added for the aspect bound contracts
+	require.Equal(t, sdkmath.LegacyNewDec(100), policy.RequiredGasPrice(sdkmath.LegacyNewDec(50), false))
+	require.Equal(t, sdkmath.LegacyNewDec(120), policy.RequiredGasPrice(sdkmath.LegacyNewDec(50), true))
+	require.Equal(t, sdkmath.LegacyNewDec(220), policy.RequiredGasPrice(sdkmath.LegacyNewDec(200), true))
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("aart", 10)), policy.Rebates(sdk.NewCoins(sdk.NewInt64Coin("aart", 109))))
+
+	params := types.DefaultParams()
+	params.FeePolicies = []types.FeePolicy{policy}
+	found, ok := params.GetFeePolicy(common.HexToAddress("0xabc"))
+	require.True(t, ok)
+	require.Equal(t, policy, found)
+	_, ok = params.GetFeePolicy(common.HexToAddress("0xdef"))
+	require.False(t, ok)
+}
+
+func TestParamsSplitFees(t *testing.T) {
+	params := types.DefaultParams()
+	params.BaseFeeBurnRatio = sdkmath.LegacyNewDecWithPrec(5, 1)
+	params.TreasuryRatio = sdkmath.LegacyNewDecWithPrec(25, 2)
+	params.TreasuryAddress = testTreasury
+
+	newFees := func() *types.BlockFees {
+		return &types.BlockFees{
+			BaseFees: sdk.NewCoins(sdk.NewInt64Coin("aart", 1001)),
+			Tips:     sdk.NewCoins(sdk.NewInt64Coin("aart", 40)),
+		}
+	}
+
+	// the tips are kept in the fee collector by default, the shares are truncated
+	fees := newFees()
+	params.SplitFees(fees)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("aart", 500)), fees.Burned)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("aart", 250)), fees.Treasury)
+	require.True(t, fees.Proposer.IsZero())
+
+	params.TipRecipient = types.TipRecipient_TIP_RECIPIENT_PROPOSER
+	fees = newFees()
+	params.SplitFees(fees)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("aart", 250)), fees.Treasury)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("aart", 40)), fees.Proposer)
+
+	params.TipRecipient = types.TipRecipient_TIP_RECIPIENT_TREASURY
+	fees = newFees()
+	params.SplitFees(fees)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("aart", 290)), fees.Treasury)
+	require.True(t, fees.Proposer.IsZero())
+
+	// the base fees paid in the fee tokens are not burned, but still shared with the treasury
+	params.TipRecipient = types.TipRecipient_TIP_RECIPIENT_FEE_COLLECTOR
+	params.FeeTokens = []types.FeeToken{{Denom: "ibc/usdc", ConversionRate: sdkmath.LegacyOneDec(), MinGasPrice: sdkmath.LegacyZeroDec()}}
+	fees = newFees()
+	fees.BaseFees = fees.BaseFees.Add(sdk.NewInt64Coin("ibc/usdc", 100))
+	params.SplitFees(fees)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("aart", 500)), fees.Burned)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("aart", 250), sdk.NewInt64Coin("ibc/usdc", 25)), fees.Treasury)
+}
+
+func TestFeeTokenConvert(t *testing.T) {
+	token := types.FeeToken{Denom: "ibc/usdc", ConversionRate: sdkmath.LegacyNewDecWithPrec(15, 1), MinGasPrice: sdkmath.LegacyZeroDec()}
+
+	// the fees are rounded up and the refunds are truncated, so the refunds never exceed the fees
+	require.Equal(t, sdkmath.NewInt(15), token.ConvertFees(sdkmath.NewInt(10)))
+	require.Equal(t, sdkmath.NewInt(17), token.ConvertFees(sdkmath.NewInt(11)))
+	require.Equal(t, sdkmath.NewInt(16), token.ConvertRefund(sdkmath.NewInt(11)))
+	require.True(t, token.ConvertRefund(sdkmath.ZeroInt()).IsZero())
+
+	params := types.DefaultParams()
+	params.FeeTokens = []types.FeeToken{token}
+	found, ok := params.GetFeeToken("ibc/usdc")
+	require.True(t, ok)
+	require.Equal(t, token, found)
+	_, ok = params.GetFeeToken("uatom")
+	require.False(t, ok)
+}
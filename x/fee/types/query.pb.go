@@ -276,6 +276,275 @@ func (m *QueryBlockGasResponse) GetGas() int64 {
 	return 0
 }
 
+// QueryBlockFeesRequest defines the request type for querying the fees of the last block.
+type QueryBlockFeesRequest struct {
+}
+
+func (m *QueryBlockFeesRequest) Reset()         { *m = QueryBlockFeesRequest{} }
+func (m *QueryBlockFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockFeesRequest) ProtoMessage()    {}
+func (*QueryBlockFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a7a7e355ea2f665, []int{6}
+}
+func (m *QueryBlockFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockFeesRequest.Merge(m, src)
+}
+func (m *QueryBlockFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockFeesRequest proto.InternalMessageInfo
+
+// QueryBlockFeesResponse returns the fees collected in the last block and how they are distributed.
+type QueryBlockFeesResponse struct {
+	// fees is the fees of the last block
+	Fees BlockFees `protobuf:"bytes,1,opt,name=fees,proto3" json:"fees"`
+}
+
+func (m *QueryBlockFeesResponse) Reset()         { *m = QueryBlockFeesResponse{} }
+func (m *QueryBlockFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockFeesResponse) ProtoMessage()    {}
+func (*QueryBlockFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a7a7e355ea2f665, []int{7}
+}
+func (m *QueryBlockFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockFeesResponse.Merge(m, src)
+}
+func (m *QueryBlockFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockFeesResponse proto.InternalMessageInfo
+
+func (m *QueryBlockFeesResponse) GetFees() BlockFees {
+	if m != nil {
+		return m.Fees
+	}
+	return BlockFees{}
+}
+
+// QueryBaseFeeAtHeightRequest defines the request type for querying the base fee of a block.
+type QueryBaseFeeAtHeightRequest struct {
+	// height of the block
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryBaseFeeAtHeightRequest) Reset()         { *m = QueryBaseFeeAtHeightRequest{} }
+func (m *QueryBaseFeeAtHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeAtHeightRequest) ProtoMessage()    {}
+func (*QueryBaseFeeAtHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a7a7e355ea2f665, []int{8}
+}
+func (m *QueryBaseFeeAtHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeAtHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeAtHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeAtHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeAtHeightRequest.Merge(m, src)
+}
+func (m *QueryBaseFeeAtHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeAtHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeAtHeightRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeAtHeightRequest proto.InternalMessageInfo
+
+func (m *QueryBaseFeeAtHeightRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryBaseFeeAtHeightResponse returns the base fee of a block.
+type QueryBaseFeeAtHeightResponse struct {
+	// base_fee is the EIP1559 base fee of the block, empty if the base fee is disabled
+	BaseFee *cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.Int" json:"base_fee,omitempty"`
+}
+
+func (m *QueryBaseFeeAtHeightResponse) Reset()         { *m = QueryBaseFeeAtHeightResponse{} }
+func (m *QueryBaseFeeAtHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeAtHeightResponse) ProtoMessage()    {}
+func (*QueryBaseFeeAtHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a7a7e355ea2f665, []int{9}
+}
+func (m *QueryBaseFeeAtHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeAtHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeAtHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeAtHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeAtHeightResponse.Merge(m, src)
+}
+func (m *QueryBaseFeeAtHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeAtHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeAtHeightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeAtHeightResponse proto.InternalMessageInfo
+
+// QueryFeeHistoryRequest defines the request type for querying the fee history.
+type QueryFeeHistoryRequest struct {
+	// last_height is the height of the last block queried, the latest recorded block if zero
+	LastHeight int64 `protobuf:"varint,1,opt,name=last_height,json=lastHeight,proto3" json:"last_height,omitempty"`
+	// block_count is the number of blocks queried, bounded by the fee history size
+	BlockCount uint64 `protobuf:"varint,2,opt,name=block_count,json=blockCount,proto3" json:"block_count,omitempty"`
+}
+
+func (m *QueryFeeHistoryRequest) Reset()         { *m = QueryFeeHistoryRequest{} }
+func (m *QueryFeeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeHistoryRequest) ProtoMessage()    {}
+func (*QueryFeeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a7a7e355ea2f665, []int{10}
+}
+func (m *QueryFeeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeHistoryRequest.Merge(m, src)
+}
+func (m *QueryFeeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryFeeHistoryRequest) GetLastHeight() int64 {
+	if m != nil {
+		return m.LastHeight
+	}
+	return 0
+}
+
+func (m *QueryFeeHistoryRequest) GetBlockCount() uint64 {
+	if m != nil {
+		return m.BlockCount
+	}
+	return 0
+}
+
+// QueryFeeHistoryResponse returns the fee history of the consecutive blocks ending at the last block.
+type QueryFeeHistoryResponse struct {
+	// records are the fee records ordered by height, the blocks no longer kept in the history are left out
+	Records []BlockFeeRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+}
+
+func (m *QueryFeeHistoryResponse) Reset()         { *m = QueryFeeHistoryResponse{} }
+func (m *QueryFeeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeHistoryResponse) ProtoMessage()    {}
+func (*QueryFeeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a7a7e355ea2f665, []int{11}
+}
+func (m *QueryFeeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeHistoryResponse.Merge(m, src)
+}
+func (m *QueryFeeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryFeeHistoryResponse) GetRecords() []BlockFeeRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "artela.fee.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "artela.fee.QueryParamsResponse")
@@ -283,41 +552,62 @@ func init() {
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "artela.fee.QueryBaseFeeResponse")
 	proto.RegisterType((*QueryBlockGasRequest)(nil), "artela.fee.QueryBlockGasRequest")
 	proto.RegisterType((*QueryBlockGasResponse)(nil), "artela.fee.QueryBlockGasResponse")
+	proto.RegisterType((*QueryBlockFeesRequest)(nil), "artela.fee.QueryBlockFeesRequest")
+	proto.RegisterType((*QueryBlockFeesResponse)(nil), "artela.fee.QueryBlockFeesResponse")
+	proto.RegisterType((*QueryBaseFeeAtHeightRequest)(nil), "artela.fee.QueryBaseFeeAtHeightRequest")
+	proto.RegisterType((*QueryBaseFeeAtHeightResponse)(nil), "artela.fee.QueryBaseFeeAtHeightResponse")
+	proto.RegisterType((*QueryFeeHistoryRequest)(nil), "artela.fee.QueryFeeHistoryRequest")
+	proto.RegisterType((*QueryFeeHistoryResponse)(nil), "artela.fee.QueryFeeHistoryResponse")
 }
 
 func init() { proto.RegisterFile("artela/fee/query.proto", fileDescriptor_4a7a7e355ea2f665) }
 
 var fileDescriptor_4a7a7e355ea2f665 = []byte{
-	// 460 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcd, 0x6e, 0xd3, 0x40,
-	0x14, 0x85, 0xe3, 0x56, 0xa4, 0xed, 0xb0, 0x81, 0x21, 0xe9, 0x8f, 0x85, 0x9c, 0xe0, 0x15, 0x54,
-	0xc2, 0xa3, 0x94, 0xf2, 0x02, 0x59, 0x80, 0x90, 0x10, 0x82, 0x2c, 0xd9, 0x54, 0xd7, 0xe1, 0xc6,
-	0xb5, 0x62, 0xcf, 0x75, 0x3c, 0x93, 0x42, 0xb7, 0x3c, 0x01, 0x12, 0x2f, 0xc1, 0x92, 0xc7, 0xe8,
-	0x32, 0x12, 0x1b, 0xc4, 0xa2, 0x42, 0x09, 0x12, 0xaf, 0x81, 0x3c, 0x33, 0xa1, 0x71, 0x43, 0xd9,
-	0x44, 0x93, 0x33, 0xe7, 0x9e, 0xcf, 0xe7, 0xda, 0x6c, 0x17, 0x4a, 0x8d, 0x19, 0x88, 0x11, 0xa2,
-	0x98, 0x4c, 0xb1, 0x3c, 0x8f, 0x8a, 0x92, 0x34, 0x71, 0x66, 0xf5, 0x68, 0x84, 0xe8, 0xdf, 0x85,
-	0x3c, 0x95, 0x24, 0xcc, 0xaf, 0xbd, 0xf6, 0x5b, 0x09, 0x25, 0x64, 0x8e, 0xa2, 0x3a, 0x39, 0xf5,
-	0x7e, 0x42, 0x94, 0x64, 0x28, 0xa0, 0x48, 0x05, 0x48, 0x49, 0x1a, 0x74, 0x4a, 0x52, 0xb9, 0xdb,
-	0xc3, 0x21, 0xa9, 0x9c, 0x94, 0x88, 0x41, 0x39, 0x96, 0x38, 0xeb, 0xc5, 0xa8, 0xa1, 0x27, 0x0a,
-	0x48, 0x52, 0x69, 0xcc, 0xce, 0xbb, 0xb7, 0xf2, 0x58, 0x05, 0x94, 0x90, 0xbb, 0x90, 0xb0, 0xc5,
-	0xf8, 0x9b, 0x6a, 0xf4, 0xb5, 0x11, 0x07, 0x38, 0x99, 0xa2, 0xd2, 0xe1, 0x4b, 0x76, 0xaf, 0xa6,
-	0xaa, 0x82, 0xa4, 0x42, 0xfe, 0x94, 0x35, 0xed, 0xf0, 0xbe, 0xd7, 0xf5, 0x1e, 0xde, 0x3e, 0xe2,
-	0xd1, 0x55, 0xab, 0xc8, 0x7a, 0xfb, 0x3b, 0x17, 0x97, 0x9d, 0xc6, 0x97, 0xdf, 0x5f, 0x0f, 0xbd,
-	0x81, 0x33, 0x87, 0x6d, 0x97, 0xd6, 0x07, 0x85, 0xcf, 0x10, 0xaf, 0x20, 0xad, 0xba, 0xec, 0x28,
-	0xc7, 0x6c, 0xbb, 0xaa, 0x74, 0x32, 0x42, 0x34, 0x9c, 0x9d, 0xfe, 0xc1, 0x8f, 0xcb, 0x4e, 0xdb,
-	0xb6, 0x55, 0xef, 0xc6, 0x51, 0x4a, 0x22, 0x07, 0x7d, 0x1a, 0xbd, 0x90, 0x7a, 0xb0, 0x15, 0xdb,
-	0xe9, 0x70, 0x77, 0x99, 0x96, 0xd1, 0x70, 0xfc, 0x1c, 0xfe, 0x56, 0x79, 0xc4, 0xda, 0xd7, 0x74,
-	0x87, 0xb9, 0xc3, 0x36, 0x13, 0xb0, 0x4d, 0x36, 0x07, 0xd5, 0xf1, 0x68, 0xb6, 0xc1, 0x6e, 0x19,
-	0x2f, 0x47, 0xd6, 0xb4, 0x75, 0x78, 0xb0, 0x5a, 0x71, 0x7d, 0x53, 0x7e, 0xe7, 0xc6, 0x7b, 0x8b,
-	0x09, 0xfd, 0x8f, 0xdf, 0x7e, 0x7d, 0xde, 0x68, 0x71, 0x2e, 0xd6, 0x5e, 0x01, 0xcf, 0xd9, 0x96,
-	0x2b, 0xcf, 0xd7, 0x73, 0xea, 0xdb, 0xf2, 0xbb, 0x37, 0x1b, 0x1c, 0xa9, 0x63, 0x48, 0x07, 0x7c,
-	0x6f, 0x95, 0x74, 0xd6, 0x13, 0xcb, 0x65, 0xf2, 0x09, 0xdb, 0x5e, 0x6e, 0x81, 0xff, 0x23, 0xae,
-	0xbe, 0x38, 0xff, 0xc1, 0x7f, 0x1c, 0x8e, 0xd8, 0x35, 0x44, 0x9f, 0xef, 0x5f, 0x27, 0x56, 0xc6,
-	0x93, 0x04, 0x54, 0xff, 0xd5, 0xc5, 0x3c, 0xf0, 0x66, 0xf3, 0xc0, 0xfb, 0x39, 0x0f, 0xbc, 0x4f,
-	0x8b, 0xa0, 0x31, 0x5b, 0x04, 0x8d, 0xef, 0x8b, 0xa0, 0xf1, 0xf6, 0x38, 0x49, 0xf5, 0xe9, 0x34,
-	0x8e, 0x86, 0x94, 0xbb, 0xe9, 0xc7, 0x12, 0xf5, 0x7b, 0x2a, 0xc7, 0xcb, 0xbf, 0x25, 0x65, 0xd9,
-	0x38, 0xd5, 0xe2, 0x83, 0x89, 0xd5, 0xe7, 0x05, 0xaa, 0xb8, 0x69, 0xbe, 0xda, 0x27, 0x7f, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0x9d, 0x9d, 0x6e, 0xab, 0x67, 0x03, 0x00, 0x00,
+	// 693 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0x8d, 0xfb, 0x48, 0xdb, 0xdb, 0x05, 0x30, 0xe4, 0xd1, 0xba, 0x95, 0x93, 0x9a, 0x05, 0xa1,
+	0x12, 0x1e, 0xb5, 0xb4, 0x6b, 0x44, 0x90, 0x4a, 0x91, 0x50, 0x05, 0x11, 0xab, 0x6e, 0xa2, 0x49,
+	0x7a, 0xe3, 0x58, 0x49, 0x3c, 0xa9, 0x3d, 0x29, 0x44, 0x88, 0x0d, 0x1f, 0x80, 0x90, 0xf8, 0x09,
+	0x96, 0x7c, 0x46, 0x97, 0x95, 0xba, 0x41, 0x2c, 0x2a, 0xd4, 0x22, 0xf1, 0x1b, 0xc8, 0x33, 0xe3,
+	0xd4, 0x79, 0xb2, 0x60, 0x13, 0xd9, 0x77, 0xce, 0x9c, 0x73, 0xee, 0xf1, 0xbd, 0x81, 0x1c, 0x0b,
+	0x04, 0xb6, 0x19, 0x6d, 0x20, 0xd2, 0xd3, 0x1e, 0x06, 0x7d, 0xa7, 0x1b, 0x70, 0xc1, 0x09, 0xa8,
+	0xba, 0xd3, 0x40, 0x34, 0xef, 0xb1, 0x8e, 0xe7, 0x73, 0x2a, 0x7f, 0xd5, 0xb1, 0x99, 0x71, 0xb9,
+	0xcb, 0xe5, 0x23, 0x8d, 0x9e, 0x74, 0x75, 0xd3, 0xe5, 0xdc, 0x6d, 0x23, 0x65, 0x5d, 0x8f, 0x32,
+	0xdf, 0xe7, 0x82, 0x09, 0x8f, 0xfb, 0xa1, 0x3e, 0xdd, 0xae, 0xf3, 0xb0, 0xc3, 0x43, 0x5a, 0x63,
+	0xa1, 0xd6, 0xa2, 0x67, 0x3b, 0x35, 0x14, 0x6c, 0x87, 0x76, 0x99, 0xeb, 0xf9, 0x12, 0xac, 0xb1,
+	0xf9, 0x84, 0xad, 0x2e, 0x0b, 0x58, 0x27, 0x26, 0xc9, 0x24, 0x0e, 0x1a, 0x88, 0xaa, 0x6a, 0x67,
+	0x80, 0xbc, 0x89, 0x08, 0x5f, 0x4b, 0x68, 0x05, 0x4f, 0x7b, 0x18, 0x0a, 0xfb, 0x15, 0xdc, 0x1f,
+	0xaa, 0x86, 0x5d, 0xee, 0x87, 0x48, 0xf6, 0x21, 0xad, 0x28, 0xd7, 0x8c, 0xa2, 0x51, 0x5a, 0xdd,
+	0x25, 0xce, 0x6d, 0xaf, 0x8e, 0xc2, 0x96, 0x57, 0xce, 0xaf, 0x0a, 0xa9, 0x6f, 0x7f, 0xbe, 0x6f,
+	0x1b, 0x15, 0x0d, 0xb6, 0xb3, 0x9a, 0xad, 0xcc, 0x42, 0x3c, 0x40, 0xbc, 0x15, 0xc9, 0x0c, 0x97,
+	0xb5, 0xca, 0x1e, 0x2c, 0x47, 0x8d, 0x56, 0x1b, 0x88, 0x52, 0x67, 0xa5, 0xbc, 0xfe, 0xf3, 0xaa,
+	0x90, 0x55, 0x19, 0x84, 0x27, 0x2d, 0xc7, 0xe3, 0xb4, 0xc3, 0x44, 0xd3, 0x79, 0xe9, 0x8b, 0xca,
+	0x52, 0x4d, 0xdd, 0xb6, 0x73, 0x31, 0x5b, 0x9b, 0xd7, 0x5b, 0x2f, 0xd8, 0xa0, 0x95, 0x47, 0x90,
+	0x1d, 0xa9, 0x6b, 0x99, 0xbb, 0x30, 0xef, 0x32, 0xd5, 0xc9, 0x7c, 0x25, 0x7a, 0xb4, 0xf3, 0x49,
+	0xe8, 0x01, 0xe2, 0x80, 0xe3, 0x08, 0x72, 0xa3, 0x07, 0x03, 0xaf, 0x0b, 0x0d, 0xc4, 0x38, 0x8f,
+	0x6c, 0x32, 0x8f, 0x01, 0x38, 0x19, 0x89, 0x44, 0xdb, 0xfb, 0xb0, 0x91, 0xec, 0xfc, 0x99, 0x38,
+	0x44, 0xcf, 0x6d, 0x0a, 0x2d, 0x47, 0x72, 0x90, 0x6e, 0xca, 0x82, 0x36, 0xa7, 0xdf, 0xec, 0xb7,
+	0xb0, 0x39, 0xf9, 0xda, 0x7f, 0x05, 0x77, 0xac, 0x9b, 0x3b, 0x40, 0x3c, 0xf4, 0x42, 0xc1, 0x83,
+	0x7e, 0xec, 0xa3, 0x00, 0xab, 0x6d, 0x16, 0x8a, 0xea, 0x90, 0x19, 0x88, 0x4a, 0x4a, 0x38, 0x02,
+	0xd4, 0xa2, 0x2e, 0xab, 0x75, 0xde, 0xf3, 0xc5, 0xda, 0x5c, 0xd1, 0x28, 0x2d, 0x54, 0x40, 0x96,
+	0x9e, 0x47, 0x15, 0xfb, 0x18, 0xf2, 0x63, 0xdc, 0xda, 0xec, 0x53, 0x58, 0x0a, 0xb0, 0xce, 0x83,
+	0x93, 0x28, 0xbc, 0xf9, 0xd2, 0xea, 0xae, 0x39, 0x29, 0xbc, 0x8a, 0x84, 0x24, 0x13, 0x8c, 0x6f,
+	0xed, 0x5e, 0x2e, 0xc2, 0xa2, 0x24, 0x27, 0x08, 0x69, 0x35, 0x7c, 0xc4, 0x4a, 0x72, 0x8c, 0xcf,
+	0xb5, 0x59, 0x98, 0x7a, 0xae, 0x5c, 0xd9, 0xe6, 0xa7, 0xcb, 0xdf, 0x5f, 0xe7, 0x32, 0x84, 0xd0,
+	0xb1, 0x35, 0x22, 0x1d, 0x58, 0xd2, 0xc9, 0x93, 0x71, 0x9e, 0xe1, 0xd9, 0x36, 0x8b, 0xd3, 0x01,
+	0x5a, 0xa9, 0x20, 0x95, 0xd6, 0x49, 0x3e, 0xa9, 0x74, 0xb6, 0x43, 0xe3, 0x2f, 0x48, 0x4e, 0x61,
+	0x39, 0x9e, 0x59, 0x32, 0x81, 0x6e, 0x78, 0xcc, 0xcd, 0xad, 0x19, 0x08, 0xad, 0x58, 0x94, 0x8a,
+	0x26, 0x59, 0x1b, 0x55, 0x94, 0x9f, 0xd0, 0x65, 0x21, 0xe9, 0xc1, 0xca, 0x60, 0x6a, 0xc9, 0x14,
+	0xc6, 0xc4, 0x5e, 0x98, 0xf6, 0x2c, 0x88, 0x56, 0xdd, 0x92, 0xaa, 0x1b, 0x64, 0x7d, 0xa2, 0x6a,
+	0xb4, 0x0e, 0xe4, 0xb3, 0x01, 0x77, 0x46, 0x66, 0x9a, 0x3c, 0x9c, 0x16, 0xe0, 0xc8, 0xb2, 0x98,
+	0xa5, 0x7f, 0x03, 0xb5, 0x93, 0x92, 0x74, 0x62, 0x93, 0xe2, 0x94, 0xc4, 0xe9, 0x07, 0x35, 0xe8,
+	0x1f, 0x49, 0x1f, 0xe0, 0x76, 0x62, 0xc9, 0x78, 0x97, 0x63, 0xab, 0x62, 0x3e, 0x98, 0x89, 0xd1,
+	0x06, 0x6c, 0x69, 0x60, 0x93, 0x98, 0x23, 0x06, 0x1a, 0x88, 0xd5, 0xa6, 0xc2, 0x96, 0x8f, 0xce,
+	0xaf, 0x2d, 0xe3, 0xe2, 0xda, 0x32, 0x7e, 0x5d, 0x5b, 0xc6, 0x97, 0x1b, 0x2b, 0x75, 0x71, 0x63,
+	0xa5, 0x7e, 0xdc, 0x58, 0xa9, 0xe3, 0x3d, 0xd7, 0x13, 0xcd, 0x5e, 0xcd, 0xa9, 0xf3, 0x8e, 0xbe,
+	0xff, 0xd8, 0x47, 0xf1, 0x8e, 0x07, 0xad, 0xf8, 0x35, 0xe0, 0xed, 0x76, 0xcb, 0x13, 0xf4, 0xbd,
+	0x24, 0x16, 0xfd, 0x2e, 0x86, 0xb5, 0xb4, 0xfc, 0x9b, 0x7f, 0xf2, 0x77, 0x00, 0x96, 0x9f, 0xfc,
+	0x94, 0xae, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -338,6 +628,13 @@ type QueryClient interface {
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error)
+	// BlockFees queries the fees collected in the last block and how they are distributed,
+	// the fees of a historical block can be queried at its height.
+	BlockFees(ctx context.Context, in *QueryBlockFeesRequest, opts ...grpc.CallOption) (*QueryBlockFeesResponse, error)
+	// BaseFeeAtHeight queries the base fee of a block kept in the fee history.
+	BaseFeeAtHeight(ctx context.Context, in *QueryBaseFeeAtHeightRequest, opts ...grpc.CallOption) (*QueryBaseFeeAtHeightResponse, error)
+	// FeeHistory queries the base fee and the gas of the consecutive blocks kept in the fee history.
+	FeeHistory(ctx context.Context, in *QueryFeeHistoryRequest, opts ...grpc.CallOption) (*QueryFeeHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -375,6 +672,33 @@ func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, op
 	return out, nil
 }
 
+func (c *queryClient) BlockFees(ctx context.Context, in *QueryBlockFeesRequest, opts ...grpc.CallOption) (*QueryBlockFeesResponse, error) {
+	out := new(QueryBlockFeesResponse)
+	err := c.cc.Invoke(ctx, "/artela.fee.Query/BlockFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BaseFeeAtHeight(ctx context.Context, in *QueryBaseFeeAtHeightRequest, opts ...grpc.CallOption) (*QueryBaseFeeAtHeightResponse, error) {
+	out := new(QueryBaseFeeAtHeightResponse)
+	err := c.cc.Invoke(ctx, "/artela.fee.Query/BaseFeeAtHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeHistory(ctx context.Context, in *QueryFeeHistoryRequest, opts ...grpc.CallOption) (*QueryFeeHistoryResponse, error) {
+	out := new(QueryFeeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/artela.fee.Query/FeeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -383,6 +707,13 @@ type QueryServer interface {
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error)
+	// BlockFees queries the fees collected in the last block and how they are distributed,
+	// the fees of a historical block can be queried at its height.
+	BlockFees(context.Context, *QueryBlockFeesRequest) (*QueryBlockFeesResponse, error)
+	// BaseFeeAtHeight queries the base fee of a block kept in the fee history.
+	BaseFeeAtHeight(context.Context, *QueryBaseFeeAtHeightRequest) (*QueryBaseFeeAtHeightResponse, error)
+	// FeeHistory queries the base fee and the gas of the consecutive blocks kept in the fee history.
+	FeeHistory(context.Context, *QueryFeeHistoryRequest) (*QueryFeeHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -398,6 +729,15 @@ func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeR
 func (*UnimplementedQueryServer) BlockGas(ctx context.Context, req *QueryBlockGasRequest) (*QueryBlockGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockGas not implemented")
 }
+func (*UnimplementedQueryServer) BlockFees(ctx context.Context, req *QueryBlockFeesRequest) (*QueryBlockFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockFees not implemented")
+}
+func (*UnimplementedQueryServer) BaseFeeAtHeight(ctx context.Context, req *QueryBaseFeeAtHeightRequest) (*QueryBaseFeeAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseFeeAtHeight not implemented")
+}
+func (*UnimplementedQueryServer) FeeHistory(ctx context.Context, req *QueryFeeHistoryRequest) (*QueryFeeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -457,6 +797,60 @@ func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BlockFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artela.fee.Query/BlockFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockFees(ctx, req.(*QueryBlockFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BaseFeeAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseFeeAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseFeeAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artela.fee.Query/BaseFeeAtHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseFeeAtHeight(ctx, req.(*QueryBaseFeeAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artela.fee.Query/FeeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeHistory(ctx, req.(*QueryFeeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "artela.fee.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -473,6 +867,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BlockGas",
 			Handler:    _Query_BlockGas_Handler,
 		},
+		{
+			MethodName: "BlockFees",
+			Handler:    _Query_BlockFees_Handler,
+		},
+		{
+			MethodName: "BaseFeeAtHeight",
+			Handler:    _Query_BaseFeeAtHeight_Handler,
+		},
+		{
+			MethodName: "FeeHistory",
+			Handler:    _Query_FeeHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "artela/fee/query.proto",
@@ -643,87 +1049,739 @@ func (m *QueryBlockGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryBlockFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryBlockFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeAtHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeAtHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BaseFee != nil {
+		{
+			size := m.BaseFee.Size()
+			i -= size
+			if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LastHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseFee != nil {
+		l = m.BaseFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockGasRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBlockGasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		n += 1 + sovQuery(uint64(m.Gas))
+	}
+	return n
+}
+
+func (m *QueryBlockFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBlockFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fees.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBaseFeeAtHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryBaseFeeAtHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseFee != nil {
+		l = m.BaseFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastHeight))
+	}
+	if m.BlockCount != 0 {
+		n += 1 + sovQuery(uint64(m.BlockCount))
+	}
+	return n
+}
+
+func (m *QueryFeeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.BaseFee = &v
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
-
-func (m *QueryBaseFeeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBlockGasRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockGasRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockGasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryBaseFeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BaseFee != nil {
-		l = m.BaseFee.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryBlockGasRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockGasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryBlockGasResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Gas != 0 {
-		n += 1 + sovQuery(uint64(m.Gas))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBlockFeesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -746,10 +1804,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlockFeesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlockFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -773,7 +1831,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBlockFeesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -796,15 +1854,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlockFeesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlockFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -831,7 +1889,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -856,7 +1914,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBaseFeeAtHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -879,12 +1937,31 @@ func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBaseFeeAtHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBaseFeeAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -906,7 +1983,7 @@ func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBaseFeeAtHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -929,10 +2006,10 @@ func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBaseFeeAtHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBaseFeeAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -992,7 +2069,7 @@ func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlockGasRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1015,12 +2092,50 @@ func (m *QueryBlockGasRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlockGasRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlockGasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+			}
+			m.LastHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockCount", wireType)
+			}
+			m.BlockCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1042,7 +2157,7 @@ func (m *QueryBlockGasRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1065,17 +2180,17 @@ func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlockGasResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlockGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
-			m.Gas = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1085,11 +2200,26 @@ func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Gas |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, BlockFeeRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])